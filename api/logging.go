@@ -0,0 +1,95 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/njcx/libbeat_v6/logp"
+)
+
+// loggingRequest contains the changes requested by PUT /logging. Fields not set
+// are not changed, setting the level of a selector to null removes it.
+type loggingRequest struct {
+	Level          *logp.Level            `json:"level"`
+	Selectors      *[]string              `json:"selectors"`
+	SelectorLevels map[string]*logp.Level `json:"selector_levels"`
+}
+
+// loggingHandler reports the logging level and selectors on GET, and changes
+// them on PUT.
+func loggingHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		var req loggingRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request: %v", err))
+			return
+		}
+
+		levels := logp.Levels()
+		if req.Level != nil {
+			levels.Level = *req.Level
+		}
+		if req.Selectors != nil {
+			levels.Selectors = *req.Selectors
+		}
+		for selector, level := range req.SelectorLevels {
+			if level == nil {
+				delete(levels.SelectorLevels, selector)
+			} else {
+				levels.SelectorLevels[selector] = *level
+			}
+		}
+		logp.Info("Changing logging level to %v, selectors: %v, selector levels: %v",
+			levels.Level, levels.Selectors, levels.SelectorLevels)
+		logp.SetLevels(levels)
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %v not allowed", r.Method))
+		return
+	}
+
+	writeJSON(w, logp.Levels(), r)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}, r *http.Request) {
+	var data []byte
+	var err error
+	if _, pretty := r.URL.Query()["pretty"]; pretty {
+		data, err = json.MarshalIndent(v, "", "  ")
+	} else {
+		data, err = json.Marshal(v)
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Write(data)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+	data, _ := json.Marshal(map[string]string{"error": err.Error()})
+	w.Write(data)
+}
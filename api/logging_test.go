@@ -0,0 +1,82 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/logp"
+)
+
+func TestLoggingHandler(t *testing.T) {
+	require.NoError(t, logp.DevelopmentSetup(logp.ToObserverOutput()))
+	logp.SetLevels(logp.LevelConfig{
+		Level:          logp.InfoLevel,
+		SelectorLevels: map[string]logp.Level{"publish": logp.DebugLevel},
+	})
+
+	request := func(method, body string) (int, map[string]interface{}) {
+		rec := httptest.NewRecorder()
+		loggingHandler(rec, httptest.NewRequest(method, "/logging", strings.NewReader(body)))
+
+		var resp map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return rec.Code, resp
+	}
+
+	code, resp := request(http.MethodGet, "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "info", resp["level"])
+	assert.Equal(t, map[string]interface{}{"publish": "debug"}, resp["selector_levels"])
+
+	code, resp = request(http.MethodPut, `{
+		"level": "debug",
+		"selectors": ["output"],
+		"selector_levels": {"publish": null, "elasticsearch": "warn"}
+	}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "debug", resp["level"])
+	assert.Equal(t, []interface{}{"output"}, resp["selectors"])
+	assert.Equal(t, map[string]interface{}{"elasticsearch": "warning"}, resp["selector_levels"])
+	assert.Equal(t, logp.LevelConfig{
+		Level:          logp.DebugLevel,
+		Selectors:      []string{"output"},
+		SelectorLevels: map[string]logp.Level{"elasticsearch": logp.WarnLevel},
+	}, logp.Levels())
+
+	// Fields not set are not changed.
+	code, _ = request(http.MethodPut, `{"level": "error"}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, logp.ErrorLevel, logp.Levels().Level)
+	assert.Equal(t, []string{"output"}, logp.Levels().Selectors)
+
+	code, resp = request(http.MethodPut, `{"level": "verbose"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, resp["error"], "invalid level")
+	assert.Equal(t, logp.ErrorLevel, logp.Levels().Level)
+
+	code, _ = request(http.MethodPost, `{}`)
+	assert.Equal(t, http.StatusMethodNotAllowed, code)
+}
@@ -33,6 +33,9 @@ type Config struct {
 	SyncPeriod     time.Duration `config:"sync_period"`
 	CleanupTimeout time.Duration `config:"cleanup_timeout"`
 
+	// Resource is the kind of kubernetes object to discover, one of pod, node or service
+	Resource string `config:"resource"`
+
 	Prefix       string                  `config:"prefix"`
 	HintsEnabled bool                    `config:"hints.enabled"`
 	Builders     []*common.Config        `config:"builders"`
@@ -45,6 +48,7 @@ func defaultConfig() *Config {
 		InCluster:      true,
 		SyncPeriod:     1 * time.Second,
 		CleanupTimeout: 60 * time.Second,
+		Resource:       "pod",
 		Prefix:         "co.elastic",
 	}
 }
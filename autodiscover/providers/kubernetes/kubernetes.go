@@ -19,6 +19,8 @@ package kubernetes
 
 import (
 	"fmt"
+	"reflect"
+	"sync"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -48,6 +50,19 @@ type Provider struct {
 	templates template.Mapper
 	builders  autodiscover.Builders
 	appenders autodiscover.Appenders
+
+	// states holds the last state of the nodes and services by uid, to skip
+	// updates that don't change the generated configurations.
+	statesMu sync.Mutex
+	states   map[string]*resourceState
+}
+
+// resourceState is the part of a node or a service used in the emitted events.
+type resourceState struct {
+	host        string
+	ports       []int32
+	labels      map[string]string
+	annotations map[string]string
 }
 
 // AutodiscoverBuilder builds and returns an autodiscover provider
@@ -69,15 +84,30 @@ func AutodiscoverBuilder(bus bus.Bus, uuid uuid.UUID, c *common.Config) (autodis
 		return nil, err
 	}
 
-	config.Host = kubernetes.DiscoverKubernetesNode(config.Host, config.InCluster, client)
-
-	watcher, err := kubernetes.NewWatcher(client, &kubernetes.Pod{}, kubernetes.WatchOptions{
+	options := kubernetes.WatchOptions{
 		SyncTimeout: config.SyncPeriod,
-		Node:        config.Host,
 		Namespace:   config.Namespace,
-	})
+	}
+
+	var resource kubernetes.Resource
+	switch config.Resource {
+	case "pod":
+		config.Host = kubernetes.DiscoverKubernetesNode(config.Host, config.InCluster, client)
+		options.Node = config.Host
+		resource = &kubernetes.Pod{}
+	case "node":
+		// Nodes are not namespaced, watch all of them
+		options.Namespace = ""
+		resource = &kubernetes.Node{}
+	case "service":
+		resource = &kubernetes.Service{}
+	default:
+		return nil, fmt.Errorf("unsupported autodiscover resource %s", config.Resource)
+	}
+
+	watcher, err := kubernetes.NewWatcher(client, resource, options)
 	if err != nil {
-		logp.Err("kubernetes: Couldn't create watcher for %t", &kubernetes.Pod{})
+		logp.Err("kubernetes: Couldn't create watcher for %T", resource)
 		return nil, err
 	}
 
@@ -107,33 +137,85 @@ func AutodiscoverBuilder(bus bus.Bus, uuid uuid.UUID, c *common.Config) (autodis
 		watcher:   watcher,
 	}
 
-	watcher.AddEventHandler(kubernetes.ResourceEventHandlerFuncs{
+	watcher.AddEventHandler(p.eventHandler())
+
+	return p, nil
+}
+
+// Start for Runner interface.
+func (p *Provider) Start() {
+	if err := p.watcher.Start(); err != nil {
+		logp.Err("Error starting kubernetes autodiscover provider: %s", err)
+	}
+}
+
+func (p *Provider) eventHandler() kubernetes.ResourceEventHandler {
+	return kubernetes.ResourceEventHandlerFuncs{
 		AddFunc: func(obj kubernetes.Resource) {
-			logp.Debug("kubernetes", "Watcher Pod add: %+v", obj)
-			p.emit(obj.(*kubernetes.Pod), "start")
+			logp.Debug("kubernetes", "Watcher %s add: %+v", p.config.Resource, obj)
+			p.updated(obj)
+			p.emit(obj, "start")
 		},
 		UpdateFunc: func(obj kubernetes.Resource) {
-			logp.Debug("kubernetes", "Watcher Pod update: %+v", obj)
-			p.emit(obj.(*kubernetes.Pod), "stop")
-			p.emit(obj.(*kubernetes.Pod), "start")
+			logp.Debug("kubernetes", "Watcher %s update: %+v", p.config.Resource, obj)
+			if !p.updated(obj) {
+				return
+			}
+			p.emit(obj, "stop")
+			p.emit(obj, "start")
 		},
 		DeleteFunc: func(obj kubernetes.Resource) {
-			logp.Debug("kubernetes", "Watcher Pod delete: %+v", obj)
-			time.AfterFunc(config.CleanupTimeout, func() { p.emit(obj.(*kubernetes.Pod), "stop") })
+			logp.Debug("kubernetes", "Watcher %s delete: %+v", p.config.Resource, obj)
+			p.forget(obj)
+			time.AfterFunc(p.config.CleanupTimeout, func() { p.emit(obj, "stop") })
 		},
-	})
+	}
+}
 
-	return p, nil
+// updated records the state of a node or a service and returns false if it
+// didn't change since the last event. Pods are always considered updated.
+func (p *Provider) updated(obj kubernetes.Resource) bool {
+	state := getResourceState(obj)
+	if state == nil {
+		return true
+	}
+
+	uid := obj.GetMetadata().GetUid()
+
+	p.statesMu.Lock()
+	defer p.statesMu.Unlock()
+
+	if last, found := p.states[uid]; found && reflect.DeepEqual(last, state) {
+		return false
+	}
+	if p.states == nil {
+		p.states = map[string]*resourceState{}
+	}
+	p.states[uid] = state
+	return true
 }
 
-// Start for Runner interface.
-func (p *Provider) Start() {
-	if err := p.watcher.Start(); err != nil {
-		logp.Err("Error starting kubernetes autodiscover provider: %s", err)
+// forget removes the state of a deleted node or service.
+func (p *Provider) forget(obj kubernetes.Resource) {
+	p.statesMu.Lock()
+	defer p.statesMu.Unlock()
+	delete(p.states, obj.GetMetadata().GetUid())
+}
+
+func (p *Provider) emit(obj kubernetes.Resource, flag string) {
+	switch o := obj.(type) {
+	case *kubernetes.Pod:
+		p.emitPod(o, flag)
+	case *kubernetes.Node:
+		p.emitNode(o, flag)
+	case *kubernetes.Service:
+		p.emitService(o, flag)
+	default:
+		logp.Err("kubernetes: Unexpected resource type %T in autodiscover", obj)
 	}
 }
 
-func (p *Provider) emit(pod *kubernetes.Pod, flag string) {
+func (p *Provider) emitPod(pod *kubernetes.Pod, flag string) {
 	// Emit events for all containers
 	p.emitEvents(pod, flag, pod.Spec.Containers, pod.Status.ContainerStatuses)
 
@@ -190,11 +272,7 @@ func (p *Provider) emitEvents(pod *kubernetes.Pod, flag string, containers []*ku
 		kubemeta["container"] = cmeta
 
 		// Pass annotations to all events so that it can be used in templating and by annotation builders.
-		annotations := common.MapStr{}
-		for k, v := range pod.GetMetadata().Annotations {
-			safemapstr.Put(annotations, k, v)
-		}
-		kubemeta["annotations"] = annotations
+		kubemeta["annotations"] = getAnnotations(pod)
 
 		// Without this check there would be overlapping configurations with and without ports.
 		if len(c.Ports) == 0 {
@@ -228,6 +306,140 @@ func (p *Provider) emitEvents(pod *kubernetes.Pod, flag string, containers []*ku
 	}
 }
 
+func (p *Provider) emitNode(node *kubernetes.Node, flag string) {
+	host := getNodeHost(node)
+
+	// Nodes without a reachable address cannot be used in configs, an update
+	// will arrive when the address is known. Always emit stop events to ensure cleanup.
+	if host == "" && flag != "stop" {
+		return
+	}
+
+	meta := p.metagen.ResourceMetadata(node)
+	safemapstr.Put(meta, "node.name", node.GetMetadata().GetName())
+	safemapstr.Put(meta, "node.uid", node.GetMetadata().GetUid())
+
+	kubemeta := meta.Clone()
+	kubemeta["annotations"] = getAnnotations(node)
+
+	event := bus.Event{
+		"provider":   p.uuid,
+		"id":         node.GetMetadata().GetUid(),
+		flag:         true,
+		"host":       host,
+		"kubernetes": kubemeta,
+		"meta": common.MapStr{
+			"kubernetes": meta,
+		},
+	}
+
+	// Expose the kubelet port, so node level checks can be templated
+	if port := node.GetStatus().GetDaemonEndpoints().GetKubeletEndpoint().GetPort(); port != 0 {
+		event["port"] = port
+	}
+
+	p.publish(event)
+}
+
+func (p *Provider) emitService(svc *kubernetes.Service, flag string) {
+	host := svc.GetSpec().GetClusterIP()
+
+	// Headless services don't have a cluster IP, there is nothing to connect to.
+	if host == "None" {
+		host = ""
+	}
+	if host == "" && flag != "stop" {
+		return
+	}
+
+	meta := p.metagen.ResourceMetadata(svc)
+	safemapstr.Put(meta, "service.name", svc.GetMetadata().GetName())
+	safemapstr.Put(meta, "service.uid", svc.GetMetadata().GetUid())
+
+	kubemeta := meta.Clone()
+	kubemeta["annotations"] = getAnnotations(svc)
+
+	eventID := svc.GetMetadata().GetUid()
+
+	// Without this check there would be overlapping configurations with and without ports.
+	if len(svc.GetSpec().GetPorts()) == 0 {
+		event := bus.Event{
+			"provider":   p.uuid,
+			"id":         eventID,
+			flag:         true,
+			"host":       host,
+			"kubernetes": kubemeta,
+			"meta": common.MapStr{
+				"kubernetes": meta,
+			},
+		}
+		p.publish(event)
+	}
+
+	for _, port := range svc.GetSpec().GetPorts() {
+		event := bus.Event{
+			"provider":   p.uuid,
+			"id":         eventID,
+			flag:         true,
+			"host":       host,
+			"port":       port.GetPort(),
+			"kubernetes": kubemeta,
+			"meta": common.MapStr{
+				"kubernetes": meta,
+			},
+		}
+		p.publish(event)
+	}
+}
+
+// getResourceState returns the state of a node or a service, or nil for other
+// resources.
+func getResourceState(obj kubernetes.Resource) *resourceState {
+	var state resourceState
+	switch o := obj.(type) {
+	case *kubernetes.Node:
+		state.host = getNodeHost(o)
+		if port := o.GetStatus().GetDaemonEndpoints().GetKubeletEndpoint().GetPort(); port != 0 {
+			state.ports = []int32{port}
+		}
+	case *kubernetes.Service:
+		state.host = o.GetSpec().GetClusterIP()
+		for _, port := range o.GetSpec().GetPorts() {
+			state.ports = append(state.ports, port.GetPort())
+		}
+	default:
+		return nil
+	}
+
+	state.labels = obj.GetMetadata().GetLabels()
+	state.annotations = obj.GetMetadata().GetAnnotations()
+	return &state
+}
+
+// getNodeHost returns the internal IP of the node, falling back to any other address.
+func getNodeHost(node *kubernetes.Node) string {
+	var host string
+	for _, address := range node.GetStatus().GetAddresses() {
+		if address.GetType() == "InternalIP" {
+			return address.GetAddress()
+		}
+		if host == "" {
+			host = address.GetAddress()
+		}
+	}
+	return host
+}
+
+// getAnnotations returns the annotations of the object, so they can be used in
+// templating and by annotation builders.
+func getAnnotations(obj kubernetes.Resource) common.MapStr {
+	annotations := common.MapStr{}
+	for k, v := range obj.GetMetadata().GetAnnotations() {
+		safemapstr.Put(annotations, k, v)
+	}
+	return annotations
+}
+
 func (p *Provider) publish(event bus.Event) {
 	// Try to match a config
 	if config := p.templates.GetConfig(event); config != nil {
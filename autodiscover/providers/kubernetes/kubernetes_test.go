@@ -484,3 +484,291 @@ func getNestedAnnotations(in common.MapStr) common.MapStr {
 	}
 	return out
 }
+
+func TestEmitNodeAndServiceEvents(t *testing.T) {
+	name := "metricbeat"
+	namespace := "default"
+	uid := "005f3b90-4b9d-12f8-acf0-31020a840133"
+	nodeIP := "10.0.0.1"
+	internalIP := "InternalIP"
+	externalIP := "ExternalIP"
+	publicIP := "1.2.3.4"
+	clusterIP := "10.96.0.10"
+	kubeletPort := int32(10250)
+	svcPort := int32(9090)
+	UUID, err := uuid.NewV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Message  string
+		Resource string
+		Object   kubernetes.Resource
+		Expected bus.Event
+	}{
+		{
+			Message:  "Test node start",
+			Resource: "node",
+			Object: &v1.Node{
+				Metadata: &metav1.ObjectMeta{
+					Name:        &name,
+					Uid:         &uid,
+					Labels:      map[string]string{},
+					Annotations: map[string]string{},
+				},
+				Status: &v1.NodeStatus{
+					Addresses: []*v1.NodeAddress{
+						{Type: &externalIP, Address: &publicIP},
+						{Type: &internalIP, Address: &nodeIP},
+					},
+					DaemonEndpoints: &v1.NodeDaemonEndpoints{
+						KubeletEndpoint: &v1.DaemonEndpoint{Port: &kubeletPort},
+					},
+				},
+			},
+			Expected: bus.Event{
+				"start":    true,
+				"host":     "10.0.0.1",
+				"port":     int32(10250),
+				"id":       uid,
+				"provider": UUID,
+				"kubernetes": common.MapStr{
+					"node": common.MapStr{
+						"name": "metricbeat",
+						"uid":  uid,
+					},
+					"annotations": common.MapStr{},
+				},
+				"meta": common.MapStr{
+					"kubernetes": common.MapStr{
+						"node": common.MapStr{
+							"name": "metricbeat",
+							"uid":  uid,
+						},
+					},
+				},
+				"config": []*common.Config{},
+			},
+		},
+		{
+			Message:  "Test node without address",
+			Resource: "node",
+			Object: &v1.Node{
+				Metadata: &metav1.ObjectMeta{
+					Name: &name,
+					Uid:  &uid,
+				},
+				Status: &v1.NodeStatus{},
+			},
+			Expected: nil,
+		},
+		{
+			Message:  "Test service start",
+			Resource: "service",
+			Object: &v1.Service{
+				Metadata: &metav1.ObjectMeta{
+					Name:      &name,
+					Uid:       &uid,
+					Namespace: &namespace,
+					Labels:    map[string]string{},
+					Annotations: map[string]string{
+						"co.elastic.metrics/module": "prometheus",
+					},
+				},
+				Spec: &v1.ServiceSpec{
+					ClusterIP: &clusterIP,
+					Ports: []*v1.ServicePort{
+						{Port: &svcPort},
+					},
+				},
+			},
+			Expected: bus.Event{
+				"start":    true,
+				"host":     "10.96.0.10",
+				"port":     int32(9090),
+				"id":       uid,
+				"provider": UUID,
+				"kubernetes": common.MapStr{
+					"service": common.MapStr{
+						"name": "metricbeat",
+						"uid":  uid,
+					},
+					"namespace": "default",
+					"annotations": getNestedAnnotations(common.MapStr{
+						"co.elastic.metrics/module": "prometheus",
+					}),
+				},
+				"meta": common.MapStr{
+					"kubernetes": common.MapStr{
+						"namespace": "default",
+						"service": common.MapStr{
+							"name": "metricbeat",
+							"uid":  uid,
+						},
+					},
+				},
+				"config": []*common.Config{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Message, func(t *testing.T) {
+			mapper, err := template.NewConfigMapper(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			metaGen, err := kubernetes.NewMetaGenerator(common.NewConfig())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			config := defaultConfig()
+			config.Resource = test.Resource
+			watcher := &fakeWatcher{}
+			p := &Provider{
+				config:    config,
+				bus:       bus.New("test"),
+				metagen:   metaGen,
+				templates: mapper,
+				uuid:      UUID,
+				watcher:   watcher,
+			}
+			watcher.AddEventHandler(p.eventHandler())
+
+			listener := p.bus.Subscribe()
+
+			watcher.handler.OnAdd(test.Object)
+
+			select {
+			case event := <-listener.Events():
+				assert.Equal(t, test.Expected, event)
+			case <-time.After(2 * time.Second):
+				if test.Expected != nil {
+					t.Fatal("Timeout while waiting for event")
+				}
+			}
+		})
+	}
+}
+
+func TestNodeAndServiceUpdates(t *testing.T) {
+	name := "metricbeat"
+	uid := "005f3b90-4b9d-12f8-acf0-31020a840133"
+	clusterIP := "10.96.0.10"
+	svcPort := int32(9090)
+	UUID, err := uuid.NewV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mapper, err := template.NewConfigMapper(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	metaGen, err := kubernetes.NewMetaGenerator(common.NewConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := defaultConfig()
+	config.Resource = "service"
+	watcher := &fakeWatcher{}
+	p := &Provider{
+		config:    config,
+		bus:       bus.New("test"),
+		metagen:   metaGen,
+		templates: mapper,
+		uuid:      UUID,
+		watcher:   watcher,
+	}
+	watcher.AddEventHandler(p.eventHandler())
+
+	listener := p.bus.Subscribe()
+
+	service := func(labels map[string]string) *v1.Service {
+		return &v1.Service{
+			Metadata: &metav1.ObjectMeta{
+				Name:   &name,
+				Uid:    &uid,
+				Labels: labels,
+			},
+			Spec: &v1.ServiceSpec{
+				ClusterIP: &clusterIP,
+				Ports:     []*v1.ServicePort{{Port: &svcPort}},
+			},
+		}
+	}
+
+	nextFlags := func(n int) []string {
+		var flags []string
+		for i := 0; i < n; i++ {
+			select {
+			case event := <-listener.Events():
+				for _, flag := range []string{"start", "stop"} {
+					if _, found := event[flag]; found {
+						flags = append(flags, flag)
+					}
+				}
+			case <-time.After(100 * time.Millisecond):
+				return flags
+			}
+		}
+		return flags
+	}
+
+	watcher.handler.OnAdd(service(map[string]string{"app": "metricbeat"}))
+	assert.Equal(t, []string{"start"}, nextFlags(1))
+
+	// Periodic resyncs don't restart the configurations.
+	watcher.handler.OnUpdate(service(map[string]string{"app": "metricbeat"}))
+	assert.Empty(t, nextFlags(1))
+
+	watcher.handler.OnUpdate(service(map[string]string{"app": "prometheus"}))
+	assert.Equal(t, []string{"stop", "start"}, nextFlags(2))
+}
+
+func TestGenerateHintsForService(t *testing.T) {
+	p := Provider{
+		config: defaultConfig(),
+	}
+
+	event := bus.Event{
+		"host": "10.96.0.10",
+		"port": int32(9090),
+		"kubernetes": common.MapStr{
+			"service": common.MapStr{
+				"name": "prometheus",
+			},
+			"annotations": getNestedAnnotations(common.MapStr{
+				"co.elastic.metrics/module": "prometheus",
+				"co.elastic.metrics/hosts":  "${data.host}:${data.port}",
+			}),
+		},
+	}
+
+	hints := p.generateHints(event)
+	assert.Equal(t, common.MapStr{
+		"metrics": common.MapStr{
+			"module": "prometheus",
+			"hosts":  "${data.host}:${data.port}",
+		},
+	}, hints["hints"])
+	assert.Equal(t, "10.96.0.10", hints["host"])
+	assert.Equal(t, int32(9090), hints["port"])
+}
+
+// fakeWatcher allows tests to trigger resource events without a kubernetes API server
+type fakeWatcher struct {
+	handler kubernetes.ResourceEventHandler
+}
+
+func (w *fakeWatcher) Start() error { return nil }
+
+func (w *fakeWatcher) Stop() {}
+
+func (w *fakeWatcher) AddEventHandler(h kubernetes.ResourceEventHandler) {
+	w.handler = h
+}
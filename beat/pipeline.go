@@ -133,6 +133,15 @@ type Processor interface {
 	Run(in *Event) (event *Event, err error)
 }
 
+// MultiProcessor is a Processor that can return any number of events for one
+// input event, e.g. one event per element of an array. Processor chains call
+// RunMulti instead of Run. The publisher pipeline ACKs the input event once
+// all returned events have been ACKed.
+type MultiProcessor interface {
+	Processor
+	RunMulti(in *Event) (events []*Event, err error)
+}
+
 // PublishMode enum sets some requirements on the client connection to the beats
 // publisher pipeline
 type PublishMode uint8
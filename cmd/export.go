@@ -27,13 +27,15 @@ import (
 func genExportCmd(settings instance.Settings, name, idxPrefix, beatVersion string) *cobra.Command {
 	exportCmd := &cobra.Command{
 		Use:   "export",
-		Short: "Export current config or index template",
+		Short: "Export current config, index template, index pattern or fields",
 	}
 
 	exportCmd.AddCommand(export.GenExportConfigCmd(settings, name, idxPrefix, beatVersion))
 	exportCmd.AddCommand(export.GenTemplateConfigCmd(settings, name, idxPrefix, beatVersion))
 	exportCmd.AddCommand(export.GenDashboardCmd(name, idxPrefix, beatVersion))
-	exportCmd.AddCommand(export.GenGetILMPolicyCmd())
+	exportCmd.AddCommand(export.GenGetILMPolicyCmd(settings, name, idxPrefix, beatVersion))
+	exportCmd.AddCommand(export.GenIndexPatternConfigCmd(settings, name, idxPrefix, beatVersion))
+	exportCmd.AddCommand(export.GenFieldsCmd(settings, name, idxPrefix, beatVersion))
 
 	return exportCmd
 }
@@ -0,0 +1,93 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/njcx/libbeat_v6/cmd/instance"
+	"github.com/njcx/libbeat_v6/generator/fields"
+	"github.com/njcx/libbeat_v6/paths"
+	"github.com/njcx/libbeat_v6/template"
+)
+
+// GenFieldsCmd is the command used to export the fields documentation.
+func GenFieldsCmd(settings instance.Settings, name, idxPrefix, beatVersion string) *cobra.Command {
+	genFieldsCmd := &cobra.Command{
+		Use:   "fields",
+		Short: "Export fields documentation to stdout",
+		Run: func(cmd *cobra.Command, args []string) {
+			format, _ := cmd.Flags().GetString("format")
+
+			b, err := instance.NewBeat(name, idxPrefix, beatVersion)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error initializing beat: %s\n", err)
+				os.Exit(1)
+			}
+			err = b.InitWithSettings(settings)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error initializing beat: %s\n", err)
+				os.Exit(1)
+			}
+
+			data, err := loadFields(b)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error loading fields: %+v\n", err)
+				os.Exit(1)
+			}
+
+			sections, err := fields.LoadSections(data)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading fields: %+v\n", err)
+				os.Exit(1)
+			}
+
+			err = fields.WriteDocs(os.Stdout, sections, format)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error writing fields documentation: %+v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	genFieldsCmd.Flags().String("format", "asciidoc", "Output format ("+strings.Join(fields.Formats, ", ")+")")
+
+	return genFieldsCmd
+}
+
+// loadFields returns the content of the fields.yml used by the beat. The
+// fields file configured in setup.template.fields has precedence over the
+// fields embedded in the beat.
+func loadFields(b *instance.Beat) ([]byte, error) {
+	cfg := template.DefaultConfig
+	if b.Config.Template.Enabled() {
+		if err := b.Config.Template.Unpack(&cfg); err != nil {
+			return nil, fmt.Errorf("error getting template settings: %v", err)
+		}
+	}
+
+	if cfg.Fields == "" {
+		return b.Fields, nil
+	}
+	return ioutil.ReadFile(paths.Resolve(paths.Config, cfg.Fields))
+}
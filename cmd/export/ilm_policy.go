@@ -19,19 +19,44 @@ package export
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
 	"github.com/njcx/libbeat_v6/cmd/instance"
+	"github.com/njcx/libbeat_v6/ilm"
 )
 
 // GenGetILMPolicyCmd is the command used to export the ilm policy.
-func GenGetILMPolicyCmd() *cobra.Command {
+func GenGetILMPolicyCmd(settings instance.Settings, name, idxPrefix, beatVersion string) *cobra.Command {
 	genTemplateConfigCmd := &cobra.Command{
 		Use:   "ilm-policy",
 		Short: "Export ILM policy",
 		Run: func(cmd *cobra.Command, args []string) {
-			fmt.Println(instance.ILMPolicy.StringToPrint())
+			b, err := instance.NewBeat(name, idxPrefix, beatVersion)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error initializing beat: %s\n", err)
+				os.Exit(1)
+			}
+			err = b.InitWithSettings(settings)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error initializing beat: %s\n", err)
+				os.Exit(1)
+			}
+
+			cfg, err := ilm.NewConfig(b.Info, b.Config.ILM)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error getting ILM settings: %+v\n", err)
+				os.Exit(1)
+			}
+
+			policy, err := ilm.LoadPolicy(cfg)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error loading ILM policy: %+v\n", err)
+				os.Exit(1)
+			}
+
+			fmt.Println(policy.Body.StringToPrint())
 		},
 	}
 
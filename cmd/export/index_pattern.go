@@ -0,0 +1,86 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/njcx/libbeat_v6/cmd/instance"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/kibana"
+)
+
+// GenIndexPatternConfigCmd is the command used to export the Kibana index pattern.
+func GenIndexPatternConfigCmd(settings instance.Settings, name, idxPrefix, beatVersion string) *cobra.Command {
+	genIndexPatternConfigCmd := &cobra.Command{
+		Use:   "index-pattern",
+		Short: "Export kibana index pattern to stdout",
+		Run: func(cmd *cobra.Command, args []string) {
+			version, _ := cmd.Flags().GetString("kibana.version")
+			index, _ := cmd.Flags().GetString("index")
+
+			b, err := instance.NewBeat(name, idxPrefix, beatVersion)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error initializing beat: %s\n", err)
+				os.Exit(1)
+			}
+			err = b.InitWithSettings(settings)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error initializing beat: %s\n", err)
+				os.Exit(1)
+			}
+
+			if version == "" {
+				version = b.Info.Version
+			}
+
+			kibanaVersion, err := common.NewVersion(version)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Invalid Kibana version: %s\n", err)
+				os.Exit(1)
+			}
+
+			data, err := loadFields(b)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error loading fields: %+v\n", err)
+				os.Exit(1)
+			}
+
+			generator := kibana.NewGeneratorFromBytes(index+"-*", data, b.Info.Version, *kibanaVersion)
+			pattern, err := generator.Build()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error generating index pattern: %+v\n", err)
+				os.Exit(1)
+			}
+
+			_, err = os.Stdout.WriteString(pattern.StringToPrint() + "\n")
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error writing index pattern: %+v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	genIndexPatternConfigCmd.Flags().String("kibana.version", beatVersion, "Kibana version")
+	genIndexPatternConfigCmd.Flags().String("index", idxPrefix, "Base index name")
+
+	return genIndexPatternConfigCmd
+}
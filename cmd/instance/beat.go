@@ -47,9 +47,11 @@ import (
 	"github.com/njcx/libbeat_v6/common/reload"
 	"github.com/njcx/libbeat_v6/common/seccomp"
 	"github.com/njcx/libbeat_v6/dashboards"
+	"github.com/njcx/libbeat_v6/ilm"
 	"github.com/njcx/libbeat_v6/keystore"
 	"github.com/njcx/libbeat_v6/logp"
 	"github.com/njcx/libbeat_v6/logp/configure"
+	"github.com/njcx/libbeat_v6/logp/logship"
 	"github.com/njcx/libbeat_v6/management"
 	"github.com/njcx/libbeat_v6/metric/system/host"
 	"github.com/njcx/libbeat_v6/monitoring"
@@ -74,6 +76,8 @@ type Beat struct {
 	Config    beatConfig
 	RawConfig *common.Config // Raw config that can be unpacked to get Beat specific config data.
 	keystore  keystore.Keystore
+
+	logShipper *logship.Shipper
 }
 
 type beatConfig struct {
@@ -325,6 +329,15 @@ func (b *Beat) createBeater(bt beat.Creator) (beat.Beater, error) {
 
 	reload.Register.MustRegister("output", pipeline.OutputReloader())
 
+	if cfg, enabled := logp.PipelineLogging(); enabled {
+		shipper, err := logship.New(b.Info, pipeline, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("error publishing logs to the pipeline: %+v", err)
+		}
+		logp.SetPipelineCore(shipper)
+		b.logShipper = shipper
+	}
+
 	// TODO: some beats race on shutdown with publisher.Stop -> do not call Stop yet,
 	//       but refine publisher to disconnect clients on stop automatically
 	// defer pipeline.Close()
@@ -332,6 +345,7 @@ func (b *Beat) createBeater(bt beat.Creator) (beat.Beater, error) {
 	b.Publisher = pipeline
 	beater, err := bt(&b.Beat, sub)
 	if err != nil {
+		b.closeLogShipper()
 		return nil, err
 	}
 
@@ -358,6 +372,7 @@ func (b *Beat) launch(settings Settings, bt beat.Creator) error {
 	if err != nil {
 		return err
 	}
+	defer b.closeLogShipper()
 
 	if b.Config.Monitoring.Enabled() {
 		settings := report.Settings{
@@ -412,6 +427,20 @@ func (b *Beat) launch(settings Settings, bt beat.Creator) error {
 	return beater.Run(&b.Beat)
 }
 
+// closeLogShipper detaches the log shipper from the logger and stops publishing
+// the log entries to the pipeline.
+func (b *Beat) closeLogShipper() {
+	if b.logShipper == nil {
+		return
+	}
+
+	logp.SetPipelineCore(nil)
+	if err := b.logShipper.Close(); err != nil {
+		logp.Warn("Failed to close the log shipper: %v", err)
+	}
+	b.logShipper = nil
+}
+
 // TestConfig check all settings are ok and the beat can be run
 func (b *Beat) TestConfig(bt beat.Creator) error {
 	return handleError(func() error {
@@ -463,7 +492,12 @@ func (b *Beat) Setup(bt beat.Creator, template, setupDashboards, machineLearning
 					return err
 				}
 
-				err = b.prepareILMTemplate(ilmCfg)
+				dynamic, err := ilmDynamicIndex(outCfg.Config())
+				if err != nil {
+					return err
+				}
+
+				err = b.prepareILMTemplate(ilmCfg, dynamic)
 				if err != nil {
 					return err
 				}
@@ -526,10 +560,15 @@ func (b *Beat) Setup(bt beat.Creator, template, setupDashboards, machineLearning
 		}
 
 		if policy {
-			if err := b.loadILMPolicy(); err != nil {
+			loaded, err := b.loadILMPolicy()
+			if err != nil {
 				return err
 			}
-			fmt.Println("Loaded Index Lifecycle Management (ILM) policy")
+			if loaded {
+				fmt.Println("Loaded Index Lifecycle Management (ILM) policy")
+			} else {
+				fmt.Println("Index Lifecycle Management (ILM) policy already exists, set ilm.overwrite to replace it")
+			}
 		}
 
 		return nil
@@ -568,7 +607,11 @@ func (b *Beat) configure(settings Settings) error {
 
 	// We have to initialize the keystore before any unpack or merging the cloud
 	// options.
-	store, err := LoadKeystore(cfg, b.Info.Beat)
+	password := settings.KeystorePassword
+	if password == nil {
+		password = keystore.PasswordFromEnv()
+	}
+	store, err := LoadKeystoreWithPassword(cfg, b.Info.Beat, password)
 	if err != nil {
 		return fmt.Errorf("could not initialize the keystore: %v", err)
 	}
@@ -792,25 +835,32 @@ func (b *Beat) registerTemplateLoading() error {
 				return err
 			}
 
-			err = b.prepareILMTemplate(ilmCfg)
+			dynamic, err := ilmDynamicIndex(b.Config.Output.Config())
 			if err != nil {
 				return err
 			}
 
-			// Set the ingestion index to the rollover alias
-			logp.Info("Set output.elasticsearch.index to '%s' as ILM is enabled.", ilmCfg.RolloverAlias)
-			esCfg.Index = ilmCfg.RolloverAlias
-			err = b.Config.Output.Config().SetString("index", -1, ilmCfg.RolloverAlias)
+			err = b.prepareILMTemplate(ilmCfg, dynamic)
 			if err != nil {
-				return errw.Wrap(err, "error setting output.elasticsearch.index")
+				return err
+			}
+
+			// Set the ingestion index to the rollover alias. With dynamic indices,
+			// the rollover alias is only used for events not matching any index.
+			if !dynamic || !b.Config.Output.Config().HasField("index") {
+				logp.Info("Set output.elasticsearch.index to '%s' as ILM is enabled.", ilmCfg.RolloverAlias)
+				esCfg.Index = ilmCfg.RolloverAlias
+				err = b.Config.Output.Config().SetString("index", -1, ilmCfg.RolloverAlias)
+				if err != nil {
+					return errw.Wrap(err, "error setting output.elasticsearch.index")
+				}
 			}
 
-			writeAliasCallback, err := b.writeAliasLoadingCallback()
+			ilmCallback, err := b.ilmLoadingCallback(dynamic)
 			if err != nil {
 				return err
 			}
 
-			// Load write alias already on
 			esConfig := b.Config.Output.Config()
 
 			// Check that ILM is enabled and the right elasticsearch version exists
@@ -819,24 +869,26 @@ func (b *Beat) registerTemplateLoading() error {
 				return err
 			}
 
-			err = checkElasticsearchVersionIlm(esClient)
-			if err != nil {
-				return err
-			}
-
-			err = checkILMFeatureEnabled(esClient)
+			err = ilm.CheckSupported(esClient)
 			if err != nil {
 				return err
 			}
 
-			elasticsearch.RegisterConnectCallback(writeAliasCallback)
+			elasticsearch.RegisterConnectCallback(ilmCallback)
 		}
 	}
 
 	return nil
 }
 
-func (b *Beat) prepareILMTemplate(ilmCfg *ilmConfig) error {
+func (b *Beat) prepareILMTemplate(ilmCfg ilm.Config, dynamic bool) error {
+	// With dynamic indices, the ILM settings are set by a template per rollover
+	// alias, created by the output on first use.
+	if dynamic {
+		logp.Info("ILM is enabled with dynamic indices, setup.template.name and setup.template.pattern are not modified.")
+		return nil
+	}
+
 	// In case no template settings are set, config must be created
 	if b.Config.Template == nil {
 		b.Config.Template = common.NewConfig()
@@ -860,8 +912,8 @@ func (b *Beat) prepareILMTemplate(ilmCfg *ilmConfig) error {
 	if err != nil {
 		return errw.Wrap(err, "error setting settings.index.lifecycle.rollover_alias")
 	}
-	logp.Info("Set settings.index.lifecycle.name in template to %s as ILM is enabled.", ILMPolicyName)
-	err = b.Config.Template.SetString("settings.index.lifecycle.name", -1, ILMPolicyName)
+	logp.Info("Set settings.index.lifecycle.name in template to %s as ILM is enabled.", ilmCfg.PolicyName)
+	err = b.Config.Template.SetString("settings.index.lifecycle.name", -1, ilmCfg.PolicyName)
 	if err != nil {
 		return errw.Wrap(err, "error setting settings.index.lifecycle.name")
 	}
@@ -1000,9 +1052,15 @@ func obfuscateConfigOpts() []ucfg.Option {
 
 // LoadKeystore returns the appropriate keystore based on the configuration.
 func LoadKeystore(cfg *common.Config, name string) (keystore.Keystore, error) {
+	return LoadKeystoreWithPassword(cfg, name, keystore.PasswordFromEnv())
+}
+
+// LoadKeystoreWithPassword returns the appropriate keystore based on the configuration,
+// decrypted with the given password.
+func LoadKeystoreWithPassword(cfg *common.Config, name string, password *keystore.SecureString) (keystore.Keystore, error) {
 	keystoreCfg, _ := cfg.Child("keystore", -1)
 	defaultPathConfig := paths.Resolve(paths.Data, fmt.Sprintf("%s.keystore", name))
-	return keystore.Factory(keystoreCfg, defaultPathConfig)
+	return keystore.FactoryWithPassword(keystoreCfg, defaultPathConfig, password)
 }
 
 func initPaths(cfg *common.Config) error {
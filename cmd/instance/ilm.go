@@ -18,118 +18,66 @@
 package instance
 
 import (
-	"encoding/json"
 	"fmt"
-	"net/url"
-
-	"github.com/pkg/errors"
 
 	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/ilm"
 	"github.com/njcx/libbeat_v6/logp"
 	"github.com/njcx/libbeat_v6/outputs/elasticsearch"
+	"github.com/njcx/libbeat_v6/outputs/outil"
 )
 
-type ilmConfig struct {
-	RolloverAlias string `config:"ilm.rollover_alias" `
-	Pattern       string `config:"ilm.pattern"`
-}
-
-// ILMPolicy contains the default policy
-var ILMPolicy = common.MapStr{
-	"policy": common.MapStr{
-		"phases": common.MapStr{
-			"hot": common.MapStr{
-				"actions": common.MapStr{
-					"rollover": common.MapStr{
-						"max_size": "50gb",
-						"max_age":  "30d",
-					},
-				},
-			},
-		},
-	},
-}
+// Build and return a callback loading the ILM policy and, if the index is not
+// dynamic, the write alias.
+func (b *Beat) ilmLoadingCallback(dynamic bool) (func(esClient *elasticsearch.Client) error, error) {
+	config, err := getILMConfig(b)
+	if err != nil {
+		return nil, err
+	}
 
-const (
-	// ILMPolicyName is the default policy name
-	ILMPolicyName = "beats-default-policy"
-	// ILMDefaultPattern is the default pattern
-	ILMDefaultPattern = "{now/d}-000001"
-)
+	policy, err := ilm.LoadPolicy(config)
+	if err != nil {
+		return nil, err
+	}
 
-// Build and return a callback to load ILM write alias
-func (b *Beat) writeAliasLoadingCallback() (func(esClient *elasticsearch.Client) error, error) {
 	callback := func(esClient *elasticsearch.Client) error {
-		if b.Config.ILM == nil {
-			b.Config.ILM = common.NewConfig()
-		}
-
-		config, err := getILMConfig(b)
-		if err != nil {
+		if _, err := ilm.EnsurePolicy(esClient, policy, config.Overwrite); err != nil {
 			return err
 		}
 
-		// Escaping because of date pattern
-		pattern := url.PathEscape(config.Pattern)
-		// This always assume it's a date pattern by sourrounding it by <...>
-		firstIndex := fmt.Sprintf("%%3C%s-%s%%3E", config.RolloverAlias, pattern)
-
-		// Check if alias already exists
-		status, b, err := esClient.Request("HEAD", "/_alias/"+config.RolloverAlias, "", nil, nil)
-		if err != nil && status != 404 {
-			logp.Err("Failed to check for alias: %s: %+v", err, string(b))
-			return errors.Wrap(err, "failed to check for alias")
-		}
-		if status == 200 {
-			logp.Info("Write alias already exists")
+		// The aliases of dynamic indices are created by the output on first use.
+		if dynamic {
 			return nil
 		}
-
-		body := common.MapStr{
-			"aliases": common.MapStr{
-				config.RolloverAlias: common.MapStr{
-					"is_write_index": true,
-				},
-			},
-		}
-
-		// Create alias with write index
-		code, res, err := esClient.Request("PUT", "/"+firstIndex, "", nil, body)
-		if code == 400 {
-			logp.Err("Error creating alias with write index. As return code is 400, assuming already exists: %s, %s", err, string(res))
-			return nil
-
-		} else if err != nil {
-			logp.Err("Error creating alias with write index: %s, %s", err, string(res))
-			return errors.Wrap(err, "failed to create write alias: "+string(res))
-		}
-
-		logp.Info("Alias with write index created: %s", firstIndex)
-
-		return nil
+		return ilm.CreateAlias(esClient, config, config.RolloverAlias)
 	}
 
 	return callback, nil
 }
 
-func (b *Beat) loadILMPolicy() error {
-	esClient, err := getElasticsearchClient(b)
+// loadILMPolicy loads the policy. An existing policy is only replaced if
+// ilm.overwrite is set. It returns true if the policy was loaded.
+func (b *Beat) loadILMPolicy() (bool, error) {
+	config, err := getILMConfig(b)
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	err = checkElasticsearchVersionIlm(esClient)
+	policy, err := ilm.LoadPolicy(config)
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	err = checkILMFeatureEnabled(esClient)
+	esClient, err := getElasticsearchClient(b)
 	if err != nil {
-		return err
+		return false, err
+	}
+
+	if err := ilm.CheckSupported(esClient); err != nil {
+		return false, err
 	}
 
-	_, _, err = esClient.Request("PUT", "/_ilm/policy/"+ILMPolicyName, "", nil, ILMPolicy)
-	return err
+	return ilm.EnsurePolicy(esClient, policy, config.Overwrite)
 }
 
 func getElasticsearchClient(b *Beat) (*elasticsearch.Client, error) {
@@ -143,78 +91,34 @@ func getElasticsearchClient(b *Beat) (*elasticsearch.Client, error) {
 	return elasticsearch.NewConnectedClient(esConfig)
 }
 
-func loadConfigWithDefaults(config *ilmConfig, b *Beat) {
-	if config.RolloverAlias == "" {
-		config.RolloverAlias = fmt.Sprintf("%s-%s", b.Info.Beat, b.Info.Version)
+// ilmDynamicIndex returns true if the Elasticsearch output selects the index
+// per event, using `indices` or a format string in `index`. Each index gets its
+// own rollover alias then.
+func ilmDynamicIndex(outCfg *common.Config) (bool, error) {
+	if outCfg.HasField("indices") {
+		return true, nil
 	}
-
-	if config.Pattern == "" {
-		config.Pattern = ILMDefaultPattern
-	}
-}
-
-func checkElasticsearchVersionIlm(client *elasticsearch.Client) error {
-	esVersion := client.GetVersion()
-	if !esVersion.IsValid() {
-		return errors.New("Unknown Elasticsearch version")
+	if !outCfg.HasField("index") {
+		return false, nil
 	}
 
-	requiredVersion, err := common.NewVersion("6.6.0")
+	index, err := outil.BuildSelectorFromConfig(outCfg, outil.Settings{
+		Key:              "index",
+		MultiKey:         "indices",
+		EnableSingleOnly: true,
+		FailEmpty:        true,
+	})
 	if err != nil {
-		return err
+		return false, err
 	}
-
-	if esVersion.LessThan(requiredVersion) {
-		return fmt.Errorf("ILM requires at least Elasticsearch 6.6.0. Used version: %s", esVersion.String())
-	}
-
-	return nil
+	return !index.IsConst(), nil
 }
 
-func checkILMFeatureEnabled(client *elasticsearch.Client) error {
-	code, body, err := client.Request("GET", "/_xpack", "", nil, nil)
-
-	// If we get a 400, it's assumed to be the OSS version of Elasticsearch
-	if code == 400 {
-		return fmt.Errorf("ILM feature is not available in this Elasticsearch version")
-	}
+func getILMConfig(b *Beat) (ilm.Config, error) {
+	config, err := ilm.NewConfig(b.Info, b.Config.ILM)
 	if err != nil {
-		return err
-	}
-
-	var response struct {
-		Features struct {
-			ILM struct {
-				Available bool `json:"available"`
-				Enabled   bool `json:"enabled"`
-			} `json:"ilm"`
-		} `json:"features"`
+		return ilm.Config{}, err
 	}
-
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		return fmt.Errorf("failed to parse JSON response: %v", err)
-	}
-
-	if !response.Features.ILM.Available {
-		return fmt.Errorf("ILM feature is not available in Elasticsearch")
-	}
-
-	if !response.Features.ILM.Enabled {
-		return fmt.Errorf("ILM feature is not enabled in Elasticsearch")
-	}
-
-	return nil
-}
-
-func getILMConfig(b *Beat) (*ilmConfig, error) {
-	config := &ilmConfig{}
-	err := b.Config.Output.Config().Unpack(config)
-	if err != nil {
-		return nil, errors.Wrap(err, "problem unpacking ilm configs")
-	}
-
-	loadConfigWithDefaults(config, b)
-
+	logp.Debug("ilm", "ILM rollover alias: %s, policy: %s", config.RolloverAlias, config.PolicyName)
 	return config, nil
 }
@@ -23,14 +23,23 @@ import (
 	_ "github.com/njcx/libbeat_v6/autodiscover/providers/jolokia"
 	_ "github.com/njcx/libbeat_v6/autodiscover/providers/kubernetes"
 	_ "github.com/njcx/libbeat_v6/monitoring/report/elasticsearch" // Register default monitoring reporting
-	_ "github.com/njcx/libbeat_v6/processors/actions"              // Register default processors.
+	_ "github.com/njcx/libbeat_v6/monitoring/report/otlp"
+	_ "github.com/njcx/libbeat_v6/processors/actions" // Register default processors.
 	_ "github.com/njcx/libbeat_v6/processors/add_cloud_metadata"
 	_ "github.com/njcx/libbeat_v6/processors/add_docker_metadata"
 	_ "github.com/njcx/libbeat_v6/processors/add_host_metadata"
 	_ "github.com/njcx/libbeat_v6/processors/add_kubernetes_metadata"
 	_ "github.com/njcx/libbeat_v6/processors/add_locale"
 	_ "github.com/njcx/libbeat_v6/processors/add_process_metadata"
+	_ "github.com/njcx/libbeat_v6/processors/dedupe"
 	_ "github.com/njcx/libbeat_v6/processors/dissect"
 	_ "github.com/njcx/libbeat_v6/processors/dns"
+	_ "github.com/njcx/libbeat_v6/processors/fingerprint"
+	_ "github.com/njcx/libbeat_v6/processors/geoip"
+	_ "github.com/njcx/libbeat_v6/processors/grok"
+	_ "github.com/njcx/libbeat_v6/processors/lookup"
+	_ "github.com/njcx/libbeat_v6/processors/parse_url"
+	_ "github.com/njcx/libbeat_v6/processors/sample"
+	_ "github.com/njcx/libbeat_v6/processors/user_agent"
 	_ "github.com/njcx/libbeat_v6/publisher/includes" // Register publisher pipeline modules
 )
@@ -21,6 +21,7 @@ import (
 	"github.com/spf13/pflag"
 
 	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/keystore"
 	"github.com/njcx/libbeat_v6/monitoring/report"
 )
 
@@ -33,4 +34,8 @@ type Settings struct {
 	RunFlags              *pflag.FlagSet
 	ConfigOverrides       *common.Config
 	DisableConfigResolver bool
+
+	// KeystorePassword is the password used to decrypt the keystore, it defaults to the
+	// KEYSTORE_PASSWORD environment variable.
+	KeystorePassword *keystore.SecureString
 }
@@ -36,26 +36,59 @@ import (
 	"github.com/njcx/libbeat_v6/keystore"
 )
 
-func getKeystore(name, version string) (keystore.Keystore, error) {
+func getKeystore(name, version string, password *keystore.SecureString) (keystore.Keystore, error) {
 	b, err := instance.NewBeat(name, "", version)
 
 	if err != nil {
 		return nil, fmt.Errorf("error initializing beat: %s", err)
 	}
 
-	if err = b.Init(); err != nil {
+	if err = b.InitWithSettings(instance.Settings{KeystorePassword: password}); err != nil {
 		return nil, fmt.Errorf("error initializing beat: %s", err)
 	}
 
 	return b.Keystore(), nil
 }
 
+// keystorePassword reads the keystore password from the source given with the --password flag,
+// or from the KEYSTORE_PASSWORD environment variable. When isNew is true and the password is
+// read from stdin, the user has to confirm it.
+func keystorePassword(cmd *cobra.Command, isNew bool) (*keystore.SecureString, error) {
+	def, _ := cmd.Flags().GetString("password")
+	if def == "" {
+		return keystore.PasswordFromEnv(), nil
+	}
+
+	read := cli.ReadPassword
+	if isNew {
+		read = cli.ReadNewPassword
+	}
+
+	password, err := read(def)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the keystore password: %v", err)
+	}
+	return keystore.NewSecureString([]byte(password)), nil
+}
+
+// openKeystore returns the keystore of the beat, decrypted with the password given by the user.
+func openKeystore(cmd *cobra.Command, name, version string) (keystore.Keystore, error) {
+	password, err := keystorePassword(cmd, false)
+	if err != nil {
+		return nil, err
+	}
+	return getKeystore(name, version, password)
+}
+
 // genKeystoreCmd initialize the Keystore command to manage the Keystore
 // with the following subcommands:
 //  - create
 //  - add
 //  - remove
 //  - list
+//  - change-password
+//  - export
+//  - import
 func genKeystoreCmd(
 	name, idxPrefix, version string,
 	runFlags *pflag.FlagSet,
@@ -69,6 +102,14 @@ func genKeystoreCmd(
 	keystoreCmd.AddCommand(genAddKeystoreCmd(name, version))
 	keystoreCmd.AddCommand(genRemoveKeystoreCmd(name, version))
 	keystoreCmd.AddCommand(genListKeystoreCmd(name, version))
+	keystoreCmd.AddCommand(genChangePasswordKeystoreCmd(name, version))
+	keystoreCmd.AddCommand(genExportKeystoreCmd(name, version))
+	keystoreCmd.AddCommand(genImportKeystoreCmd(name, version))
+
+	keystoreCmd.PersistentFlags().String("password", "",
+		"Read the keystore password from stdin or from an environment variable (env:VAR_NAME), "+
+			"defaults to the "+keystore.PasswordEnvVar+" environment variable")
+	keystoreCmd.PersistentFlags().Lookup("password").NoOptDefVal = "stdin"
 
 	return &keystoreCmd
 }
@@ -79,7 +120,11 @@ func genCreateKeystoreCmd(name, version string) *cobra.Command {
 		Use:   "create",
 		Short: "Create keystore",
 		Run: cli.RunWith(func(cmd *cobra.Command, args []string) error {
-			return createKeystore(name, version, flagForce)
+			password, err := keystorePassword(cmd, true)
+			if err != nil {
+				return err
+			}
+			return createKeystore(name, version, password, flagForce)
 		}),
 	}
 	command.Flags().BoolVar(&flagForce, "force", false, "override the existing keystore")
@@ -93,7 +138,7 @@ func genAddKeystoreCmd(name, version string) *cobra.Command {
 		Use:   "add",
 		Short: "Add secret",
 		Run: cli.RunWith(func(cmd *cobra.Command, args []string) error {
-			store, err := getKeystore(name, version)
+			store, err := openKeystore(cmd, name, version)
 			if err != nil {
 				return err
 			}
@@ -110,7 +155,7 @@ func genRemoveKeystoreCmd(name, version string) *cobra.Command {
 		Use:   "remove",
 		Short: "remove secret",
 		Run: cli.RunWith(func(cmd *cobra.Command, args []string) error {
-			store, err := getKeystore(name, version)
+			store, err := openKeystore(cmd, name, version)
 			if err != nil {
 				return err
 			}
@@ -124,7 +169,7 @@ func genListKeystoreCmd(name, version string) *cobra.Command {
 		Use:   "list",
 		Short: "List keystore",
 		Run: cli.RunWith(func(cmd *cobra.Command, args []string) error {
-			store, err := getKeystore(name, version)
+			store, err := openKeystore(cmd, name, version)
 			if err != nil {
 				return err
 			}
@@ -133,8 +178,106 @@ func genListKeystoreCmd(name, version string) *cobra.Command {
 	}
 }
 
-func createKeystore(name, version string, force bool) error {
-	store, err := getKeystore(name, version)
+func genChangePasswordKeystoreCmd(name, version string) *cobra.Command {
+	var flagNewPassword string
+	command := &cobra.Command{
+		Use:   "change-password",
+		Short: "Change the password of the keystore",
+		Run: cli.RunWith(func(cmd *cobra.Command, args []string) error {
+			store, err := openKeystore(cmd, name, version)
+			if err != nil {
+				return err
+			}
+			return changePassword(store, flagNewPassword)
+		}),
+	}
+	command.Flags().StringVar(&flagNewPassword, "new-password", "stdin",
+		"Read the new password from stdin or from an environment variable (env:VAR_NAME)")
+	return command
+}
+
+func genExportKeystoreCmd(name, version string) *cobra.Command {
+	var flagForce bool
+	var flagExportPassword string
+	command := &cobra.Command{
+		Use:   "export FILE",
+		Short: "Export the secrets to an encrypted file",
+		Run: cli.RunWith(func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("you must supply the path of the export file")
+			}
+
+			store, err := openKeystore(cmd, name, version)
+			if err != nil {
+				return err
+			}
+
+			password, err := cli.ReadNewPassword(flagExportPassword)
+			if err != nil {
+				return fmt.Errorf("could not read the export password: %v", err)
+			}
+
+			n, err := keystore.Export(store, args[0], keystore.NewSecureString([]byte(password)), flagForce)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Exported %d keys to %s\n", n, args[0])
+			return nil
+		}),
+	}
+	command.Flags().BoolVar(&flagForce, "force", false, "Override the existing export file")
+	command.Flags().StringVar(&flagExportPassword, "export-password", "stdin",
+		"Read the password used to encrypt the export from stdin or from an environment variable (env:VAR_NAME)")
+	return command
+}
+
+func genImportKeystoreCmd(name, version string) *cobra.Command {
+	var flagForce bool
+	var flagExportPassword string
+	command := &cobra.Command{
+		Use:   "import FILE",
+		Short: "Import the secrets of an exported file",
+		Run: cli.RunWith(func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("you must supply the path of the export file")
+			}
+
+			store, err := openKeystore(cmd, name, version)
+			if err != nil {
+				return err
+			}
+
+			if store.IsPersisted() == false {
+				if err := store.Create(true); err != nil {
+					return fmt.Errorf("could not create keystore, error: %s", err)
+				}
+				fmt.Println("Created keystore")
+			}
+
+			password, err := cli.ReadPassword(flagExportPassword)
+			if err != nil {
+				return fmt.Errorf("could not read the export password: %v", err)
+			}
+
+			n, err := keystore.Import(store, args[0], keystore.NewSecureString([]byte(password)), flagForce)
+			if err != nil {
+				if !flagForce {
+					return fmt.Errorf("%v, use `--force` to replace the existing keys", err)
+				}
+				return err
+			}
+			fmt.Printf("Imported %d keys from %s\n", n, args[0])
+			return nil
+		}),
+	}
+	command.Flags().BoolVar(&flagForce, "force", false, "Override the existing keys")
+	command.Flags().StringVar(&flagExportPassword, "export-password", "stdin",
+		"Read the password used to decrypt the export from stdin or from an environment variable (env:VAR_NAME)")
+	return command
+}
+
+func createKeystore(name, version string, password *keystore.SecureString, force bool) error {
+	store, err := getKeystore(name, version, password)
 	if err != nil {
 		return err
 	}
@@ -258,3 +401,25 @@ func list(store keystore.Keystore) error {
 	}
 	return nil
 }
+
+func changePassword(store keystore.Keystore, newPasswordDef string) error {
+	changer, ok := store.(keystore.PasswordChanger)
+	if !ok {
+		return errors.New("the configured keystore doesn't support changing the password")
+	}
+
+	if store.IsPersisted() == false {
+		return errors.New("the keystore doesn't exist. Use the 'create' command to create one")
+	}
+
+	password, err := cli.ReadNewPassword(newPasswordDef)
+	if err != nil {
+		return fmt.Errorf("could not read the new password: %v", err)
+	}
+
+	if err := changer.ChangePassword(keystore.NewSecureString([]byte(password))); err != nil {
+		return fmt.Errorf("could not change the keystore password: %v", err)
+	}
+	fmt.Println("Successfully changed the keystore password")
+	return nil
+}
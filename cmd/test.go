@@ -32,6 +32,7 @@ func genTestCmd(name, beatVersion string, beatCreator beat.Creator) *cobra.Comma
 
 	exportCmd.AddCommand(test.GenTestConfigCmd(name, beatVersion, beatCreator))
 	exportCmd.AddCommand(test.GenTestOutputCmd(name, beatVersion))
+	exportCmd.AddCommand(test.GenTestProcessorsCmd(name, beatVersion))
 
 	return exportCmd
 }
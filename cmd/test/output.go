@@ -29,10 +29,12 @@ import (
 )
 
 func GenTestOutputCmd(name, beatVersion string) *cobra.Command {
-	return &cobra.Command{
+	outputTestCmd := &cobra.Command{
 		Use:   "output",
 		Short: "Test " + name + " can connect to the output by using the current settings",
 		Run: func(cmd *cobra.Command, args []string) {
+			jsonOutput, _ := cmd.Flags().GetBool("json")
+
 			b, err := instance.NewBeat(name, "", beatVersion)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error initializing beat: %s\n", err)
@@ -51,16 +53,37 @@ func GenTestOutputCmd(name, beatVersion string) *cobra.Command {
 				os.Exit(1)
 			}
 
+			var driver testing.Driver = testing.NewConsoleDriver(os.Stdout)
+			var jsonDriver *testing.JSONDriver
+			if jsonOutput {
+				jsonDriver = testing.NewJSONDriver(os.Stdout)
+				driver = jsonDriver
+			}
+
 			for _, client := range output.Clients {
 				tClient, ok := client.(testing.Testable)
 				if !ok {
-					fmt.Printf("%s output doesn't support testing\n", b.Config.Output.Name())
-					os.Exit(1)
+					driver.Fatal("output", fmt.Errorf("%s output doesn't support testing", b.Config.Output.Name()))
+					continue
 				}
 
 				// Perform test:
-				tClient.Test(testing.NewConsoleDriver(os.Stdout))
+				tClient.Test(driver)
+			}
+
+			if jsonDriver != nil {
+				if err := jsonDriver.Flush(); err != nil {
+					fmt.Fprintf(os.Stderr, "Error writing test results: %s\n", err)
+					os.Exit(1)
+				}
+				if jsonDriver.Report().Status == testing.StatusError {
+					os.Exit(1)
+				}
 			}
 		},
 	}
+
+	outputTestCmd.Flags().Bool("json", false, "Write the test results as JSON")
+
+	return outputTestCmd
 }
@@ -0,0 +1,270 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/cmd/instance"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/jsontransform"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+// GenTestProcessorsCmd is the command used to run sample events through the
+// configured processors.
+func GenTestProcessorsCmd(name, beatVersion string) *cobra.Command {
+	processorsTestCmd := cobra.Command{
+		Use:   "processors [FILE]",
+		Short: "Run NDJSON events from stdin or a file through the configured processors",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			b, err := instance.NewBeat(name, "", beatVersion)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error initializing beat: %s\n", err)
+				os.Exit(1)
+			}
+
+			err = b.Init()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error initializing beat: %s\n", err)
+				os.Exit(1)
+			}
+
+			procs, err := processors.New(b.Config.Pipeline.Processors)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error initializing processors: %s\n", err)
+				os.Exit(1)
+			}
+			procs.SetBeatInfo(b.Info)
+
+			in := io.Reader(os.Stdin)
+			if len(args) == 1 && args[0] != "-" {
+				f, err := os.Open(args[0])
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error opening events file: %s\n", err)
+					os.Exit(1)
+				}
+				defer f.Close()
+				in = f
+			}
+
+			stats, err := runProcessors(procs, in, os.Stdout)
+			procs.Close()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading events: %s\n", err)
+				os.Exit(1)
+			}
+			if stats.Errors > 0 || stats.Invalid > 0 {
+				os.Exit(1)
+			}
+		},
+	}
+
+	return &processorsTestCmd
+}
+
+// processorStats summarizes a test run of the processors.
+type processorStats struct {
+	Events  int // Number of events read.
+	Dropped int // Number of events dropped by a processor.
+	Split   int // Number of additional events created by splitting events.
+	Errors  int // Number of processor errors.
+	Invalid int // Number of lines that are not valid events.
+}
+
+// runProcessors reads one JSON event per line from in, runs it through the
+// processors and writes the changes to out. Processors are run one by one, so
+// that errors can be reported for each processor. As in the pipeline, an error
+// does not stop the processing of the event. The changes of events split by a
+// processor are reported per resulting event.
+func runProcessors(procs *processors.Processors, in io.Reader, out io.Writer) (processorStats, error) {
+	var stats processorStats
+
+	fmt.Fprintf(out, "processors: %d\n", len(procs.List))
+	for i, p := range procs.List {
+		fmt.Fprintf(out, "  %d: %s\n", i+1, p)
+	}
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(out, "\nevent %d (line %d):\n", stats.Events+stats.Invalid+1, line)
+		event, err := parseEvent(data)
+		if err != nil {
+			stats.Invalid++
+			fmt.Fprintf(out, "  invalid event: %v\n", err)
+			continue
+		}
+		stats.Events++
+
+		before := flattenEvent(event)
+		events := []*beat.Event{event}
+		for i, p := range procs.List {
+			var next []*beat.Event
+			for _, event := range events {
+				results, err := processors.RunMulti(p, event)
+				if err != nil {
+					stats.Errors++
+					fmt.Fprintf(out, "  error in processor %d (%s): %v\n", i+1, p, err)
+				}
+				switch {
+				case len(results) == 0:
+					stats.Dropped++
+					fmt.Fprintf(out, "  dropped by processor %d (%s)\n", i+1, p)
+				case len(results) > 1:
+					stats.Split += len(results) - 1
+					fmt.Fprintf(out, "  split into %d events by processor %d (%s)\n", len(results), i+1, p)
+				}
+				next = append(next, results...)
+			}
+			events = next
+			if len(events) == 0 {
+				break
+			}
+		}
+
+		for i, event := range events {
+			indent := "  "
+			if len(events) > 1 {
+				fmt.Fprintf(out, "  event %d.%d:\n", stats.Events, i+1)
+				indent = "    "
+			}
+
+			diff := diffEvents(before, flattenEvent(event))
+			if len(diff) == 0 {
+				fmt.Fprintf(out, "%sunchanged\n", indent)
+			}
+			for _, d := range diff {
+				fmt.Fprintf(out, "%s%s\n", indent, d)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return stats, err
+	}
+
+	fmt.Fprintf(out, "\nevents: %d, dropped: %d, errors: %d, invalid: %d\n",
+		stats.Events, stats.Dropped, stats.Errors, stats.Invalid)
+	if stats.Split > 0 {
+		fmt.Fprintf(out, "split: %d additional events\n", stats.Split)
+	}
+	return stats, nil
+}
+
+// parseEvent creates an event from a JSON object. The @timestamp and
+// @metadata fields are set as timestamp and metadata of the event.
+func parseEvent(data []byte) (*beat.Event, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var fields common.MapStr
+	if err := dec.Decode(&fields); err != nil {
+		return nil, err
+	}
+	if fields == nil {
+		return nil, fmt.Errorf("event must be a JSON object")
+	}
+	jsontransform.TransformNumbers(fields)
+
+	event := &beat.Event{Timestamp: time.Now(), Fields: fields}
+	if ts, found := fields["@timestamp"]; found {
+		s, ok := ts.(string)
+		if !ok {
+			return nil, fmt.Errorf("@timestamp must be a string")
+		}
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid @timestamp: %v", err)
+		}
+		event.Timestamp = t
+		delete(fields, "@timestamp")
+	}
+	if meta, found := fields["@metadata"]; found {
+		m, ok := meta.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("@metadata must be an object")
+		}
+		event.Meta = common.MapStr(m)
+		delete(fields, "@metadata")
+	}
+	return event, nil
+}
+
+// flattenEvent returns the JSON encoded values of all fields of the event.
+// The values are encoded so that later changes by the processors don't
+// modify them.
+func flattenEvent(event *beat.Event) map[string]string {
+	all := common.MapStr{}
+	for k, v := range event.Fields {
+		all[k] = v
+	}
+	all["@timestamp"] = event.Timestamp.UTC().Format(time.RFC3339Nano)
+	if len(event.Meta) > 0 {
+		all["@metadata"] = event.Meta
+	}
+
+	out := map[string]string{}
+	for k, v := range all.Flatten() {
+		b, err := json.Marshal(v)
+		if err != nil {
+			b = []byte(fmt.Sprintf("%v", v))
+		}
+		out[k] = string(b)
+	}
+	return out
+}
+
+// diffEvents returns the added (+), removed (-) and changed (~) fields
+// sorted by name.
+func diffEvents(before, after map[string]string) []string {
+	var diff []string
+	for k, v := range after {
+		old, found := before[k]
+		switch {
+		case !found:
+			diff = append(diff, fmt.Sprintf("+ %s: %s", k, v))
+		case old != v:
+			diff = append(diff, fmt.Sprintf("~ %s: %s -> %s", k, old, v))
+		}
+	}
+	for k, v := range before {
+		if _, found := after[k]; !found {
+			diff = append(diff, fmt.Sprintf("- %s: %s", k, v))
+		}
+	}
+	sort.Slice(diff, func(i, j int) bool { return diff[i][2:] < diff[j][2:] })
+	return diff
+}
@@ -0,0 +1,122 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/processors"
+	_ "github.com/njcx/libbeat_v6/processors/actions"
+)
+
+func TestRunProcessors(t *testing.T) {
+	cfg, err := common.NewConfigFrom(`
+processors:
+  - rename:
+      fields:
+        - from: a
+          to: b
+  - drop_event:
+      when.equals.drop: true
+`)
+	require.NoError(t, err)
+
+	var config struct {
+		Processors processors.PluginConfig `config:"processors"`
+	}
+	require.NoError(t, cfg.Unpack(&config))
+	procs, err := processors.New(config.Processors)
+	require.NoError(t, err)
+
+	in := strings.Join([]string{
+		`{"@timestamp": "2019-01-02T03:04:05Z", "a": 1, "c": {"d": "x"}}`,
+		`{"@timestamp": "2019-01-02T03:04:05Z", "c": 2}`,
+		``,
+		`{"a": 1, "drop": true}`,
+		`not json`,
+	}, "\n")
+
+	var out bytes.Buffer
+	stats, err := runProcessors(procs, strings.NewReader(in), &out)
+	require.NoError(t, err)
+
+	assert.Equal(t, processorStats{Events: 3, Dropped: 1, Errors: 1, Invalid: 1}, stats)
+
+	output := out.String()
+	assert.Contains(t, output, "processors: 2\n")
+	assert.Contains(t, output, "event 1 (line 1):\n  - a: 1\n  + b: 1\n")
+	assert.Contains(t, output, "event 2 (line 2):\n  error in processor 1 (rename=")
+	assert.Contains(t, output, "unchanged\n")
+	assert.Contains(t, output, "event 3 (line 4):\n  dropped by processor 2 (drop_event")
+	assert.Contains(t, output, "event 4 (line 5):\n  invalid event:")
+	assert.Contains(t, output, "events: 3, dropped: 1, errors: 1, invalid: 1\n")
+}
+
+func TestRunProcessorsSplit(t *testing.T) {
+	cfg, err := common.NewConfigFrom(`
+processors:
+  - split:
+      field: records
+      target: record
+  - drop_event:
+      when.equals.record: 2
+`)
+	require.NoError(t, err)
+
+	var config struct {
+		Processors processors.PluginConfig `config:"processors"`
+	}
+	require.NoError(t, cfg.Unpack(&config))
+	procs, err := processors.New(config.Processors)
+	require.NoError(t, err)
+
+	in := `{"@timestamp": "2019-01-02T03:04:05Z", "records": [1, 2, 3]}`
+
+	var out bytes.Buffer
+	stats, err := runProcessors(procs, strings.NewReader(in), &out)
+	require.NoError(t, err)
+
+	assert.Equal(t, processorStats{Events: 1, Dropped: 1, Split: 2}, stats)
+
+	output := out.String()
+	assert.Contains(t, output, "  split into 3 events by processor 1 (split=")
+	assert.Contains(t, output, "  dropped by processor 2 (drop_event")
+	assert.Contains(t, output, "  event 1.1:\n    + record: 1\n")
+	assert.Contains(t, output, "  event 1.2:\n    + record: 3\n")
+	assert.Contains(t, output, "split: 2 additional events\n")
+}
+
+func TestParseEvent(t *testing.T) {
+	event, err := parseEvent([]byte(`{"@timestamp": "2019-01-02T03:04:05Z", "@metadata": {"pipeline": "p"}, "n": 1, "f": 1.5}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, 2019, event.Timestamp.Year())
+	assert.Equal(t, common.MapStr{"pipeline": "p"}, event.Meta)
+	assert.Equal(t, common.MapStr{"n": int64(1), "f": 1.5}, event.Fields)
+
+	for _, data := range []string{`[1]`, `null`, `{"@timestamp": 1}`, `{"@timestamp": "now"}`, `{"@metadata": 1}`} {
+		_, err := parseEvent([]byte(data))
+		assert.Error(t, err, data)
+	}
+}
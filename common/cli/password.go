@@ -60,8 +60,35 @@ func ReadPassword(def string) (string, error) {
 	return m(params)
 }
 
+// ReadNewPassword reads a new password using the same definitions as ReadPassword. When the
+// password is read from stdin the user has to type it twice to confirm it.
+func ReadNewPassword(def string) (string, error) {
+	if strings.ToLower(def) != "stdin" {
+		return ReadPassword(def)
+	}
+
+	password, err := promptPassword("Enter new password: ")
+	if err != nil {
+		return "", err
+	}
+
+	confirmation, err := promptPassword("Confirm new password: ")
+	if err != nil {
+		return "", err
+	}
+
+	if password != confirmation {
+		return "", errors.New("passwords do not match")
+	}
+	return password, nil
+}
+
 func stdin(p string) (string, error) {
-	fmt.Print("Enter password: ")
+	return promptPassword("Enter password: ")
+}
+
+func promptPassword(prompt string) (string, error) {
+	fmt.Print(prompt)
 	bytePassword, err := terminal.ReadPassword(int(syscall.Stdin))
 	if err != nil {
 		return "", errors.Wrap(err, "reading password input")
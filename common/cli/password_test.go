@@ -68,3 +68,12 @@ func TestReadPassword(t *testing.T) {
 		})
 	}
 }
+
+func TestReadNewPasswordFromEnv(t *testing.T) {
+	os.Setenv("NEW_PASSWORD", "changeme")
+	defer os.Unsetenv("NEW_PASSWORD")
+
+	password, err := ReadNewPassword("env:NEW_PASSWORD")
+	assert.NoError(t, err)
+	assert.Equal(t, "changeme", password)
+}
@@ -20,6 +20,8 @@ package common
 import (
 	"bytes"
 	"encoding/csv"
+	"errors"
+	"io"
 	"strings"
 )
 
@@ -49,3 +51,87 @@ func DumpInCSVFormat(fields []string, rows [][]string) string {
 	csv := buf.String()
 	return csv
 }
+
+// ReadCSVWithHeader reads CSV records from r. The first record names the
+// columns, every following record is passed to fn with its values keyed by
+// column name. Records are read one at a time, so large files don't need to
+// fit into memory. Lines starting with '#' are ignored. If comma is 0, ','
+// is used as separator.
+func ReadCSVWithHeader(r io.Reader, comma rune, fn func(row map[string]string) error) error {
+	reader := csv.NewReader(r)
+	if comma != 0 {
+		reader.Comma = comma
+	}
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// All records must have the same number of fields as the header.
+	reader.FieldsPerRecord = len(header)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		row := make(map[string]string, len(header))
+		for i, column := range header {
+			row[column] = record[i]
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+}
+
+// CSVOptions configures how ParseCSVLine reads a record.
+type CSVOptions struct {
+	// Comma is the field separator, ',' is used if it is 0.
+	Comma rune
+
+	// LazyQuotes allows quotes to appear in unquoted fields and non-doubled
+	// quotes to appear in quoted fields.
+	LazyQuotes bool
+
+	// TrimLeadingSpace ignores leading white space in a field.
+	TrimLeadingSpace bool
+}
+
+// ParseCSVLine parses a single CSV record. Quoted values can span multiple
+// lines, but an error is returned if line contains more than one record. An
+// empty line returns no values.
+func ParseCSVLine(line string, opts CSVOptions) ([]string, error) {
+	reader := csv.NewReader(strings.NewReader(line))
+	if opts.Comma != 0 {
+		reader.Comma = opts.Comma
+	}
+	reader.LazyQuotes = opts.LazyQuotes
+	reader.TrimLeadingSpace = opts.TrimLeadingSpace
+	reader.FieldsPerRecord = -1
+
+	record, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := reader.Read(); err != io.EOF {
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.New("multiple CSV records found")
+	}
+	return record, nil
+}
@@ -20,6 +20,8 @@
 package common
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -63,3 +65,62 @@ func Test_CSVDump(t *testing.T) {
 		assert.Equal(t, test.Output, DumpInCSVFormat(test.Fields, test.Rows))
 	}
 }
+
+func TestReadCSVWithHeader(t *testing.T) {
+	input := "# comment\nkey;value\na; 1\n\"b;c\";2\n"
+
+	var rows []map[string]string
+	err := ReadCSVWithHeader(strings.NewReader(input), ';', func(row map[string]string) error {
+		rows = append(rows, row)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]string{
+		{"key": "a", "value": "1"},
+		{"key": "b;c", "value": "2"},
+	}, rows)
+
+	// records must have as many fields as the header
+	err = ReadCSVWithHeader(strings.NewReader("a,b\n1\n"), 0, func(map[string]string) error {
+		return nil
+	})
+	assert.Error(t, err)
+
+	// errors from the callback stop reading
+	stop := errors.New("stop")
+	count := 0
+	err = ReadCSVWithHeader(strings.NewReader("a\n1\n2\n"), 0, func(map[string]string) error {
+		count++
+		return stop
+	})
+	assert.Equal(t, stop, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestParseCSVLine(t *testing.T) {
+	// quotes must start the field unless leading spaces are trimmed
+	_, err := ParseCSVLine(`a, "b,c",d`, CSVOptions{})
+	assert.Error(t, err)
+
+	values, err := ParseCSVLine(`a, "b,c",d`, CSVOptions{TrimLeadingSpace: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b,c", "d"}, values)
+
+	values, err = ParseCSVLine("a;\"multi\nline\";", CSVOptions{Comma: ';'})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "multi\nline", ""}, values)
+
+	values, err = ParseCSVLine("", CSVOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, values)
+
+	_, err = ParseCSVLine(`a,b"c`, CSVOptions{})
+	assert.Error(t, err)
+
+	values, err = ParseCSVLine(`a,b"c`, CSVOptions{LazyQuotes: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", `b"c`}, values)
+
+	_, err = ParseCSVLine("a,b\nc,d", CSVOptions{})
+	assert.Error(t, err)
+}
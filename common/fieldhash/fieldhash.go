@@ -0,0 +1,212 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package fieldhash writes event fields to a hash using an unambiguous,
+// type-tagged encoding. Processors computing keys from a set of fields use it,
+// so that events only get the same key if the field names, the kinds of the
+// values and the values are equal. Numbers are compared by value, 1 and 1.0
+// have the same encoding, but 1 and "1" do not.
+package fieldhash
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+)
+
+// Type tags prefixed to the encoded values.
+const (
+	tagNil    = 'z'
+	tagBool   = 'b'
+	tagNumber = 'n'
+	tagString = 's'
+	tagBytes  = 'y'
+	tagTime   = 't'
+	tagMap    = 'm'
+	tagArray  = 'a'
+	tagOther  = 'v'
+)
+
+// NormalizeFields returns the fields sorted and without duplicates, so the
+// keys written by WriteFields don't depend on the order of the configuration.
+func NormalizeFields(fields []string) []string {
+	set := common.MakeStringSet(fields...)
+	normalized := make([]string, 0, set.Count())
+	for field := range set {
+		normalized = append(normalized, field)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+// WriteFields writes the given fields of the event to w. Missing fields are
+// skipped if ignoreMissing is set, otherwise an error is returned for the
+// first missing field. It returns the number of fields written.
+func WriteFields(w io.Writer, event *beat.Event, fields []string, ignoreMissing bool) (int, error) {
+	n := 0
+	for _, field := range fields {
+		v, err := event.GetValue(field)
+		if err != nil {
+			if ignoreMissing {
+				continue
+			}
+			return n, errors.Wrapf(err, "field %v", field)
+		}
+
+		WriteField(w, field, v)
+		n++
+	}
+	return n, nil
+}
+
+// WriteField writes the name and the value of a field to w. The name is
+// included so values can't be moved between fields without changing the hash.
+func WriteField(w io.Writer, name string, v interface{}) {
+	buf := appendString(nil, tagString, name)
+	w.Write(appendValue(buf, v))
+}
+
+// WriteValue writes the encoding of v to w.
+func WriteValue(w io.Writer, v interface{}) {
+	w.Write(appendValue(nil, v))
+}
+
+func appendValue(buf []byte, v interface{}) []byte {
+	switch t := v.(type) {
+	case nil:
+		return append(buf, tagNil)
+	case bool:
+		if t {
+			return append(buf, tagBool, '1')
+		}
+		return append(buf, tagBool, '0')
+	case string:
+		return appendString(buf, tagString, t)
+	case []byte:
+		return appendString(buf, tagBytes, string(t))
+	case time.Time:
+		return appendString(buf, tagTime, t.UTC().Format(time.RFC3339Nano))
+	case common.Time:
+		return appendString(buf, tagTime, time.Time(t).UTC().Format(time.RFC3339Nano))
+	case common.MapStr:
+		return appendMap(buf, t)
+	case map[string]interface{}:
+		return appendMap(buf, t)
+	case []interface{}:
+		buf = appendLength(append(buf, tagArray), len(t))
+		for _, elem := range t {
+			buf = appendValue(buf, elem)
+		}
+		return buf
+	case []string:
+		buf = appendLength(append(buf, tagArray), len(t))
+		for _, elem := range t {
+			buf = appendString(buf, tagString, elem)
+		}
+		return buf
+	}
+
+	return appendReflect(buf, reflect.ValueOf(v))
+}
+
+func appendReflect(buf []byte, v reflect.Value) []byte {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return appendString(buf, tagNumber, strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return appendString(buf, tagNumber, strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		return appendString(buf, tagNumber, formatFloat(v.Float()))
+	case reflect.String:
+		return appendString(buf, tagString, v.String())
+	case reflect.Bool:
+		return appendValue(buf, v.Bool())
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return append(buf, tagNil)
+		}
+		return appendValue(buf, v.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return append(buf, tagNil)
+		}
+		buf = appendLength(append(buf, tagArray), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			buf = appendValue(buf, v.Index(i).Interface())
+		}
+		return buf
+	case reflect.Map:
+		if v.Type().Key().Kind() == reflect.String {
+			m := make(map[string]interface{}, v.Len())
+			for _, key := range v.MapKeys() {
+				m[key.String()] = v.MapIndex(key).Interface()
+			}
+			return appendMap(buf, m)
+		}
+	}
+
+	// Other types are compared by their type and their formatted value, fmt
+	// prints maps with sorted keys.
+	buf = appendString(append(buf, tagOther), 0, v.Type().String())
+	return appendString(buf, 0, fmt.Sprint(v.Interface()))
+}
+
+func appendMap(buf []byte, m map[string]interface{}) []byte {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	buf = appendLength(append(buf, tagMap), len(keys))
+	for _, key := range keys {
+		buf = appendString(buf, tagString, key)
+		buf = appendValue(buf, m[key])
+	}
+	return buf
+}
+
+// formatFloat formats integral values like integers, so the encoding does
+// not depend on the decoder used to parse a number.
+func formatFloat(f float64) string {
+	if f == math.Trunc(f) && math.Abs(f) < 1<<53 {
+		return strconv.FormatInt(int64(f), 10)
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// appendString appends the tag and the length prefixed string. The tag is
+// omitted if 0.
+func appendString(buf []byte, tag byte, s string) []byte {
+	if tag != 0 {
+		buf = append(buf, tag)
+	}
+	return append(appendLength(buf, len(s)), s...)
+}
+
+func appendLength(buf []byte, n int) []byte {
+	return append(strconv.AppendInt(buf, int64(n), 10), ':')
+}
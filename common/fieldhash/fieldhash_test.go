@@ -0,0 +1,114 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fieldhash
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+)
+
+func TestWriteValue(t *testing.T) {
+	ts := time.Date(2019, 1, 2, 3, 4, 5, 6, time.UTC)
+
+	equal := map[string][2]interface{}{
+		"int and int64":      {1, int64(1)},
+		"int and float":      {1, 1.0},
+		"uint and int":       {uint8(3), 3},
+		"map key order":      {common.MapStr{"a": 1, "b": 2}, map[string]interface{}{"b": 2, "a": 1}},
+		"nested maps":        {common.MapStr{"a": common.MapStr{"b": "c"}}, common.MapStr{"a": map[string]interface{}{"b": "c"}}},
+		"typed maps":         {map[string]string{"a": "b"}, common.MapStr{"a": "b"}},
+		"string arrays":      {[]string{"a", "b"}, []interface{}{"a", "b"}},
+		"time zones":         {ts, ts.In(time.FixedZone("CET", 3600))},
+		"common.Time":        {ts, common.Time(ts)},
+		"pointers":           {"a", &[]string{"a"}[0]},
+		"nil pointer":        {nil, (*string)(nil)},
+		"typed string array": {[2]string{"a", "b"}, []string{"a", "b"}},
+	}
+	for name, values := range equal {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, encode(values[0]), encode(values[1]))
+		})
+	}
+
+	different := map[string][2]interface{}{
+		"number and string": {1, "1"},
+		"bool and string":   {true, "true"},
+		"nil and string":    {nil, "<nil>"},
+		"string and bytes":  {"a", []byte("a")},
+		"float precision":   {1.5, 1},
+		"array boundaries":  {[]string{"ab", "c"}, []string{"a", "bc"}},
+		"array and string":  {[]string{"a"}, "a"},
+		"map values":        {common.MapStr{"a": 1}, common.MapStr{"a": "1"}},
+		"map keys":          {common.MapStr{"a": 1}, common.MapStr{"b": 1}},
+		"empty map":         {common.MapStr{}, []string{}},
+	}
+	for name, values := range different {
+		t.Run(name, func(t *testing.T) {
+			assert.NotEqual(t, encode(values[0]), encode(values[1]))
+		})
+	}
+}
+
+func TestWriteField(t *testing.T) {
+	field := func(name string, v interface{}) []byte {
+		var buf bytes.Buffer
+		WriteField(&buf, name, v)
+		return buf.Bytes()
+	}
+
+	assert.Equal(t, field("a", "b"), field("a", "b"))
+	assert.NotEqual(t, field("a", "b"), field("b", "a"))
+	assert.NotEqual(t, field("ab", "c"), field("a", "bc"))
+}
+
+func TestNormalizeFields(t *testing.T) {
+	assert.Equal(t, []string{"a", "b.c", "d"}, NormalizeFields([]string{"d", "b.c", "a", "d"}))
+	assert.Empty(t, NormalizeFields(nil))
+}
+
+func TestWriteFields(t *testing.T) {
+	event := &beat.Event{
+		Fields: common.MapStr{"a": "foo", "b": 1},
+	}
+
+	var all, ignored bytes.Buffer
+	n, err := WriteFields(&all, event, []string{"a", "b"}, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	n, err = WriteFields(&ignored, event, []string{"a", "missing", "b"}, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, all.Bytes(), ignored.Bytes())
+
+	_, err = WriteFields(&bytes.Buffer{}, event, []string{"a", "missing"}, false)
+	assert.Error(t, err)
+}
+
+func encode(v interface{}) string {
+	var buf bytes.Buffer
+	WriteValue(&buf, v)
+	return buf.String()
+}
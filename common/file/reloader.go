@@ -0,0 +1,115 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package file
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+// Reloader watches a set of files and calls a reload function from a
+// background goroutine when one of them changes. Users of the data loaded
+// from the files never wait for a check or a reload, they only need to swap
+// the reloaded data in under a short lock.
+type Reloader struct {
+	paths []string
+	state []fileState
+
+	done chan struct{}
+	wg   sync.WaitGroup
+	once sync.Once
+}
+
+// fileState identifies a version of a file. Files that can not be read have
+// the zero state.
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// NewReloader returns a Reloader for the given files. The current state of the
+// files is recorded, so changes made after NewReloader returns are detected,
+// even if they happen before Start is called. Create the Reloader before
+// loading the files for the first time.
+func NewReloader(paths ...string) *Reloader {
+	r := &Reloader{
+		paths: paths,
+		done:  make(chan struct{}),
+	}
+	r.state = r.stat()
+	return r
+}
+
+// Start checks the files every period and calls reload if any of them
+// changed. Files are compared by modification time and size. Nothing is done
+// if period is not positive.
+func (r *Reloader) Start(period time.Duration, reload func()) {
+	if period <= 0 {
+		return
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(period)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-r.done:
+				return
+			case <-ticker.C:
+				if r.changed() {
+					reload()
+				}
+			}
+		}
+	}()
+}
+
+// Close stops the background goroutine. It waits for a running reload to
+// finish, so the data can be released safely afterwards.
+func (r *Reloader) Close() {
+	r.once.Do(func() { close(r.done) })
+	r.wg.Wait()
+}
+
+// changed returns true if any file changed since the last check.
+func (r *Reloader) changed() bool {
+	state := r.stat()
+	changed := false
+	for i := range state {
+		if state[i] != r.state[i] {
+			changed = true
+		}
+	}
+	r.state = state
+	return changed
+}
+
+func (r *Reloader) stat() []fileState {
+	state := make([]fileState, len(r.paths))
+	for i, path := range r.paths {
+		if info, err := os.Stat(path); err == nil {
+			state[i] = fileState{modTime: info.ModTime(), size: info.Size()}
+		}
+	}
+	return state
+}
@@ -0,0 +1,125 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package file
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "reloader")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "data")
+	missing := filepath.Join(dir, "missing")
+	require.NoError(t, ioutil.WriteFile(path, []byte("a"), 0600))
+
+	reloads := make(chan struct{}, 10)
+	r := NewReloader(path, missing)
+	r.Start(10*time.Millisecond, func() { reloads <- struct{}{} })
+	defer r.Close()
+
+	waitReload := func() {
+		select {
+		case <-reloads:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for reload")
+		}
+	}
+	noReload := func() {
+		select {
+		case <-reloads:
+			t.Fatal("unexpected reload")
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	noReload()
+
+	// same size, different modification time
+	require.NoError(t, ioutil.WriteFile(path, []byte("b"), 0600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Hour)))
+	waitReload()
+	noReload()
+
+	// created file
+	require.NoError(t, ioutil.WriteFile(missing, []byte("c"), 0600))
+	waitReload()
+
+	// removed file
+	require.NoError(t, os.Remove(missing))
+	waitReload()
+	noReload()
+}
+
+func TestReloaderChangeBeforeStart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "reloader")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "data")
+	require.NoError(t, ioutil.WriteFile(path, []byte("a"), 0600))
+
+	r := NewReloader(path)
+	require.NoError(t, ioutil.WriteFile(path, []byte("ab"), 0600))
+
+	reloaded := make(chan struct{})
+	r.Start(10*time.Millisecond, func() { close(reloaded) })
+	defer r.Close()
+
+	select {
+	case <-reloaded:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for reload")
+	}
+}
+
+func TestReloaderClose(t *testing.T) {
+	// Close waits for a running reload
+	dir, err := ioutil.TempDir("", "reloader")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "data")
+	r := NewReloader(path)
+
+	started := make(chan struct{})
+	finished := false
+	r.Start(10*time.Millisecond, func() {
+		close(started)
+		time.Sleep(50 * time.Millisecond)
+		finished = true
+	})
+	require.NoError(t, ioutil.WriteFile(path, []byte("a"), 0600))
+
+	<-started
+	r.Close()
+	assert.True(t, finished)
+
+	// Close can be called again, also without Start
+	r.Close()
+	NewReloader(path).Close()
+}
@@ -72,6 +72,8 @@ type ReplicaSet = extv1.ReplicaSet
 // StatefulSet data
 type StatefulSet = appsv1.StatefulSet
 
+type Service = v1.Service
+
 // Time extracts time from k8s.Time type
 func Time(t *metav1.Time) time.Time {
 	return time.Unix(t.GetSeconds(), int64(t.GetNanos()))
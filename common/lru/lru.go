@@ -0,0 +1,143 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package lru provides a size bounded cache of the most recently used results
+// of lookups, like parsed strings or database records. Processors use it to
+// avoid repeating expensive lookups for frequent values.
+package lru
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/monitoring"
+)
+
+// Cache keeps up to maxSize results. When the cache is full, the least
+// recently used result is evicted. A nil value can be cached for lookups
+// without a result.
+//
+// Values are shared by all users of the cache, they must not be modified.
+type Cache struct {
+	mu      sync.Mutex
+	items   map[string]*list.Element
+	order   *list.List
+	maxSize int
+	gen     uint64 // incremented by Clear
+
+	hits   *monitoring.Int
+	misses *monitoring.Int
+}
+
+type entry struct {
+	key   string
+	value common.MapStr
+}
+
+// New creates a cache for up to maxSize results. The cache is disabled if
+// maxSize is not positive. The number of hits and misses are reported to reg.
+func New(reg *monitoring.Registry, maxSize int) *Cache {
+	if maxSize < 0 {
+		maxSize = 0
+	}
+	return &Cache{
+		items:   make(map[string]*list.Element, maxSize),
+		order:   list.New(),
+		maxSize: maxSize,
+		hits:    monitoring.NewInt(reg, "hits"),
+		misses:  monitoring.NewInt(reg, "misses"),
+	}
+}
+
+// Get returns the value cached for key.
+func (c *Cache) Get(key string) (common.MapStr, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, found := c.items[key]
+	if !found {
+		c.misses.Inc()
+		return nil, false
+	}
+
+	c.hits.Inc()
+	c.order.MoveToFront(elem)
+	return elem.Value.(*entry).value, true
+}
+
+// Generation returns the current generation of the cache. Read it before a
+// lookup and pass it to Set, so results of lookups started before the cache
+// was cleared are not stored.
+func (c *Cache) Generation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.gen
+}
+
+// Set stores value for key, unless the cache was cleared since gen was read.
+func (c *Cache) Set(key string, value common.MapStr, gen uint64) {
+	if c.maxSize == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if gen != c.gen {
+		return
+	}
+
+	if elem, found := c.items[key]; found {
+		elem.Value.(*entry).value = value
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	if c.order.Len() >= c.maxSize {
+		c.evict()
+	}
+	c.items[key] = c.order.PushFront(&entry{key: key, value: value})
+}
+
+// evict removes the least recently used entry.
+func (c *Cache) evict() {
+	elem := c.order.Back()
+	if elem == nil {
+		return
+	}
+	c.order.Remove(elem)
+	delete(c.items, elem.Value.(*entry).key)
+}
+
+// Clear removes all entries, for example after the source of the cached
+// results was reloaded.
+func (c *Cache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items = make(map[string]*list.Element, c.maxSize)
+	c.order.Init()
+	c.gen++
+}
+
+// Len returns the number of cached results.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
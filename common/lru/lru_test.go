@@ -0,0 +1,106 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package lru
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/monitoring"
+)
+
+func TestCache(t *testing.T) {
+	reg := monitoring.NewRegistry()
+	c := New(reg, 2)
+
+	c.Set("a", common.MapStr{"v": "a"}, c.Generation())
+	c.Set("b", common.MapStr{"v": "b"}, c.Generation())
+
+	// touch a, so b is the least recently used entry
+	v, found := c.Get("a")
+	assert.True(t, found)
+	assert.Equal(t, common.MapStr{"v": "a"}, v)
+
+	c.Set("c", nil, c.Generation())
+	assert.Equal(t, 2, c.Len())
+
+	_, found = c.Get("b")
+	assert.False(t, found)
+
+	v, found = c.Get("c")
+	assert.True(t, found)
+	assert.Nil(t, v)
+
+	assert.Equal(t, int64(2), reg.Get("hits").(*monitoring.Int).Get())
+	assert.Equal(t, int64(1), reg.Get("misses").(*monitoring.Int).Get())
+
+	c.Clear()
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestCacheDisabled(t *testing.T) {
+	c := New(monitoring.NewRegistry(), 0)
+	c.Set("a", common.MapStr{}, c.Generation())
+	_, found := c.Get("a")
+	assert.False(t, found)
+}
+
+func TestCacheClearDuringLookup(t *testing.T) {
+	c := New(monitoring.NewRegistry(), 2)
+
+	gen := c.Generation()
+	c.Clear()
+
+	// results of lookups started before Clear are not cached
+	c.Set("a", common.MapStr{"v": "old"}, gen)
+	_, found := c.Get("a")
+	assert.False(t, found)
+
+	c.Set("a", common.MapStr{"v": "new"}, c.Generation())
+	v, found := c.Get("a")
+	assert.True(t, found)
+	assert.Equal(t, common.MapStr{"v": "new"}, v)
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	c := New(monitoring.NewRegistry(), 10)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				key := strconv.Itoa((i + j) % 20)
+				if _, found := c.Get(key); !found {
+					c.Set(key, common.MapStr{"key": key}, c.Generation())
+				}
+				if j%100 == 0 {
+					c.Clear()
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.True(t, c.Len() <= 10)
+}
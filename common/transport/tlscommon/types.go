@@ -243,6 +243,12 @@ func (cs *tlsCipherSuite) Unpack(s string) error {
 	return nil
 }
 
+// CipherSuiteName returns the name of the cipher suite as used in the
+// configuration, or "unknown" if the cipher suite is not supported.
+func CipherSuiteName(id uint16) string {
+	return tlsCipherSuite(id).String()
+}
+
 func (cs tlsCipherSuite) String() string {
 	if s, found := tlsCipherSuitesInverse[cs]; found {
 		return s
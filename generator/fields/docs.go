@@ -0,0 +1,232 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fields
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/elastic/go-ucfg/yaml"
+
+	"github.com/njcx/libbeat_v6/common"
+)
+
+// Formats contains the supported formats of the fields documentation.
+var Formats = []string{"asciidoc", "markdown", "json", "csv"}
+
+// Section is a top-level key of a fields.yml file.
+type Section struct {
+	Key         string        `config:"key" json:"key"`
+	Title       string        `config:"title" json:"title"`
+	Description string        `config:"description" json:"description,omitempty"`
+	Fields      common.Fields `config:"fields" json:"-"`
+}
+
+// DocField is a field of a section with its full path.
+type DocField struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Format      string `json:"format,omitempty"`
+	Description string `json:"description,omitempty"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+}
+
+// LoadSections reads the sections of the given fields.yml content.
+func LoadSections(data []byte) ([]Section, error) {
+	cfg, err := yaml.NewConfig(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var sections []Section
+	if err := cfg.Unpack(&sections); err != nil {
+		return nil, err
+	}
+	return sections, nil
+}
+
+// Flatten returns all fields of the section with their full path. Groups are
+// resolved and the multi fields are listed after their parent field.
+func (s Section) Flatten() []DocField {
+	return flattenFields(s.Fields, "")
+}
+
+func flattenFields(fields common.Fields, path string) []DocField {
+	var docs []DocField
+	for _, f := range fields {
+		name := f.Name
+		if path != "" {
+			name = path + "." + f.Name
+		}
+
+		if f.Type == "group" {
+			docs = append(docs, flattenFields(f.Fields, name)...)
+			continue
+		}
+
+		docs = append(docs, DocField{
+			Name:        name,
+			Type:        fieldType(f.Type),
+			Format:      f.Format,
+			Description: strings.TrimSpace(f.Description),
+			Enabled:     f.Enabled,
+		})
+		for _, mf := range f.MultiFields {
+			docs = append(docs, DocField{
+				Name:        name + "." + mf.Name,
+				Type:        fieldType(mf.Type),
+				Description: strings.TrimSpace(mf.Description),
+			})
+		}
+	}
+	return docs
+}
+
+func fieldType(typ string) string {
+	if typ == "" {
+		return "keyword"
+	}
+	return typ
+}
+
+// WriteDocs writes the documentation of the sections in the given format.
+func WriteDocs(w io.Writer, sections []Section, format string) error {
+	switch format {
+	case "asciidoc":
+		return writeAsciidoc(w, sections)
+	case "markdown":
+		return writeMarkdown(w, sections)
+	case "json":
+		return writeJSON(w, sections)
+	case "csv":
+		return writeCSV(w, sections)
+	default:
+		return fmt.Errorf("unknown format '%s', supported formats are: %s",
+			format, strings.Join(Formats, ", "))
+	}
+}
+
+func writeAsciidoc(w io.Writer, sections []Section) error {
+	ew := &errWriter{w: w}
+	ew.printf("\n[[exported-fields]]\n= Exported fields\n\n")
+	ew.printf("This document describes the fields that are exported. They are grouped in the\nfollowing categories:\n\n")
+	for _, s := range sorted(sections) {
+		ew.printf("* <<exported-fields-%s>>\n", s.Key)
+	}
+	ew.printf("\n")
+
+	for _, s := range sorted(sections) {
+		ew.printf("[[exported-fields-%s]]\n== %s fields\n\n", s.Key, s.Title)
+		if desc := strings.TrimSpace(s.Description); desc != "" {
+			ew.printf("%s\n\n", desc)
+		}
+
+		for _, f := range s.Flatten() {
+			ew.printf("*`%s`*::\n+\n--\ntype: %s\n\n", f.Name, f.Type)
+			if f.Format != "" {
+				ew.printf("format: %s\n\n", f.Format)
+			}
+			if f.Description != "" {
+				ew.printf("%s\n\n", f.Description)
+			}
+			if f.Enabled != nil && !*f.Enabled {
+				ew.printf("Field is not indexed.\n\n")
+			}
+			ew.printf("--\n\n")
+		}
+	}
+	return ew.err
+}
+
+func writeMarkdown(w io.Writer, sections []Section) error {
+	ew := &errWriter{w: w}
+	ew.printf("# Exported fields\n\n")
+	for _, s := range sorted(sections) {
+		ew.printf("## %s fields\n\n", s.Title)
+		if desc := strings.TrimSpace(s.Description); desc != "" {
+			ew.printf("%s\n\n", desc)
+		}
+
+		ew.printf("| Field | Type | Description |\n| --- | --- | --- |\n")
+		for _, f := range s.Flatten() {
+			ew.printf("| `%s` | %s | %s |\n", f.Name, f.Type, markdownCell(f.Description))
+		}
+		ew.printf("\n")
+	}
+	return ew.err
+}
+
+func writeJSON(w io.Writer, sections []Section) error {
+	type jsonSection struct {
+		Section
+		Fields []DocField `json:"fields"`
+	}
+
+	out := make([]jsonSection, 0, len(sections))
+	for _, s := range sorted(sections) {
+		out = append(out, jsonSection{Section: s, Fields: s.Flatten()})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+func writeCSV(w io.Writer, sections []Section) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"section", "field", "type", "format", "description"})
+	for _, s := range sorted(sections) {
+		for _, f := range s.Flatten() {
+			cw.Write([]string{s.Key, f.Name, f.Type, f.Format, f.Description})
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// sorted returns the sections ordered by key, so that the output does not
+// depend on the order in which the fields.yml files were collected.
+func sorted(sections []Section) []Section {
+	out := make([]Section, len(sections))
+	copy(out, sections)
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
+func markdownCell(s string) string {
+	s = strings.Replace(s, "|", "\\|", -1)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// errWriter keeps the first error, so that the writes don't need to be
+// checked one by one.
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ew *errWriter) printf(format string, args ...interface{}) {
+	if ew.err != nil {
+		return
+	}
+	_, ew.err = fmt.Fprintf(ew.w, format, args...)
+}
@@ -0,0 +1,106 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fields
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testFieldsYml = []byte(`
+- key: web
+  title: Web
+  description: Fields of the web module.
+  fields:
+    - name: web
+      type: group
+      fields:
+        - name: url
+          description: The requested URL.
+          multi_fields:
+            - name: text
+              type: text
+        - name: bytes
+          type: long
+          format: bytes
+          description: Size of the response | in bytes.
+- key: base
+  title: Base
+  fields:
+    - name: message
+      type: text
+`)
+
+func TestLoadSections(t *testing.T) {
+	sections, err := LoadSections(testFieldsYml)
+	require.NoError(t, err)
+	require.Len(t, sections, 2)
+
+	assert.Equal(t, "web", sections[0].Key)
+	assert.Equal(t, []DocField{
+		{Name: "web.url", Type: "keyword", Description: "The requested URL."},
+		{Name: "web.url.text", Type: "text"},
+		{Name: "web.bytes", Type: "long", Format: "bytes", Description: "Size of the response | in bytes."},
+	}, sections[0].Flatten())
+}
+
+func TestWriteDocs(t *testing.T) {
+	sections, err := LoadSections(testFieldsYml)
+	require.NoError(t, err)
+
+	tests := map[string][]string{
+		"asciidoc": {
+			"* <<exported-fields-base>>\n* <<exported-fields-web>>\n",
+			"[[exported-fields-web]]\n== Web fields\n\nFields of the web module.\n",
+			"*`web.bytes`*::\n+\n--\ntype: long\n\nformat: bytes\n",
+		},
+		"markdown": {
+			"## Base fields\n",
+			"| `web.bytes` | long | Size of the response \\| in bytes. |\n",
+		},
+		"csv": {
+			"section,field,type,format,description\nbase,message,text,,\n",
+			"web,web.url.text,text,,\n",
+		},
+	}
+
+	for format, expected := range tests {
+		var buf bytes.Buffer
+		require.NoError(t, WriteDocs(&buf, sections, format), format)
+		for _, s := range expected {
+			assert.Contains(t, buf.String(), s, format)
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteDocs(&buf, sections, "json"))
+	var out []struct {
+		Key    string     `json:"key"`
+		Fields []DocField `json:"fields"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	require.Len(t, out, 2)
+	assert.Equal(t, "base", out[0].Key)
+	assert.Equal(t, "message", out[0].Fields[0].Name)
+
+	assert.Error(t, WriteDocs(&buf, sections, "html"))
+}
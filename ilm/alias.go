@@ -0,0 +1,169 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilm
+
+import (
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/logp"
+)
+
+// CreateAlias bootstraps the rollover alias, by creating the first index with
+// the alias as write index. The index is named after the alias and the
+// ilm.pattern. Nothing is done if the alias already exists.
+func CreateAlias(client ESClient, cfg Config, alias string) error {
+	exists, err := aliasExists(client, alias)
+	if err != nil || exists {
+		return err
+	}
+
+	// Escaping because of date pattern
+	pattern := url.PathEscape(cfg.Pattern)
+	// This always assume it's a date pattern by sourrounding it by <...>
+	firstIndex := fmt.Sprintf("%%3C%s-%s%%3E", url.PathEscape(alias), pattern)
+
+	body := common.MapStr{
+		"aliases": common.MapStr{
+			alias: common.MapStr{
+				"is_write_index": true,
+			},
+		},
+	}
+
+	// Create alias with write index
+	code, res, err := client.Request("PUT", "/"+firstIndex, "", nil, body)
+	if code == 400 {
+		logp.Err("Error creating alias with write index. As return code is 400, assuming already exists: %s, %s", err, string(res))
+		return nil
+	} else if err != nil {
+		logp.Err("Error creating alias with write index: %s, %s", err, string(res))
+		return fmt.Errorf("failed to create write alias: %v: %s", err, res)
+	}
+
+	logp.Info("Alias with write index created: %s", firstIndex)
+	return nil
+}
+
+func aliasExists(client ESClient, alias string) (bool, error) {
+	status, b, err := client.Request("HEAD", "/_alias/"+url.PathEscape(alias), "", nil, nil)
+	if err != nil && status != 404 {
+		logp.Err("Failed to check for alias: %s: %+v", err, string(b))
+		return false, fmt.Errorf("failed to check for alias: %v", err)
+	}
+	return status == 200, nil
+}
+
+// AliasManager bootstraps a rollover alias per index when the index selected by
+// the output is dynamic. Each alias is bootstrapped lazily on the first write to
+// the index.
+type AliasManager struct {
+	config Config
+
+	mu      sync.Mutex
+	ready   map[string]struct{}
+	pending map[string]*aliasBootstrap
+}
+
+// aliasBootstrap is a running bootstrap of an alias. Clients writing to the
+// same alias wait for it, instead of bootstrapping the alias again.
+type aliasBootstrap struct {
+	done chan struct{}
+	err  error
+}
+
+// NewAliasManager creates an AliasManager using the policy and pattern of the
+// configuration.
+func NewAliasManager(cfg Config) *AliasManager {
+	return &AliasManager{
+		config:  cfg,
+		ready:   map[string]struct{}{},
+		pending: map[string]*aliasBootstrap{},
+	}
+}
+
+// Ensure bootstraps the alias if this was not done yet. Besides creating the
+// first index, a template for the indices of the alias is loaded, so the
+// indices created on rollover use the policy and the alias.
+// No lock is held while talking to Elasticsearch, only calls for the same
+// alias wait for each other. Failed bootstraps are tried again by the next
+// call.
+func (m *AliasManager) Ensure(client ESClient, alias string) error {
+	m.mu.Lock()
+	if _, ready := m.ready[alias]; ready {
+		m.mu.Unlock()
+		return nil
+	}
+	if b, running := m.pending[alias]; running {
+		m.mu.Unlock()
+		<-b.done
+		return b.err
+	}
+	b := &aliasBootstrap{done: make(chan struct{})}
+	m.pending[alias] = b
+	m.mu.Unlock()
+
+	b.err = m.bootstrap(client, alias)
+
+	m.mu.Lock()
+	delete(m.pending, alias)
+	if b.err == nil {
+		m.ready[alias] = struct{}{}
+	}
+	m.mu.Unlock()
+
+	close(b.done)
+	return b.err
+}
+
+func (m *AliasManager) bootstrap(client ESClient, alias string) error {
+	if err := m.loadAliasTemplate(client, alias); err != nil {
+		return err
+	}
+	return CreateAlias(client, m.config, alias)
+}
+
+// loadAliasTemplate loads a template setting the policy and the rollover alias
+// of the indices of the alias. The template only contains the ILM settings, the
+// mappings are set by the beat's template. Aliases are often prefixes of each
+// other, so the template of the longer alias gets the higher order, as it's the
+// more specific one.
+func (m *AliasManager) loadAliasTemplate(client ESClient, alias string) error {
+	name := alias + "-ilm"
+	template := common.MapStr{
+		"index_patterns": []string{alias + "-*"},
+		"order":          1 + len(alias),
+		"settings": common.MapStr{
+			"index": common.MapStr{
+				"lifecycle": common.MapStr{
+					"name":           m.config.PolicyName,
+					"rollover_alias": alias,
+				},
+			},
+		},
+	}
+
+	_, body, err := client.Request("PUT", "/_template/"+url.PathEscape(name), "", nil, template)
+	if err != nil {
+		return fmt.Errorf("failed to load ILM template %s: %v: %s", name, err, body)
+	}
+	logp.Info("ILM template %s for alias %s loaded.", name, alias)
+	return nil
+}
@@ -0,0 +1,157 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilm
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/common"
+)
+
+func TestCreateAlias(t *testing.T) {
+	client := newStubClient(nil)
+	require.NoError(t, CreateAlias(client, DefaultConfig, "testbeat"))
+	assert.Equal(t, []string{
+		"HEAD /_alias/testbeat",
+		"PUT /%3Ctestbeat-%7Bnow%2Fd%7D-000001%3E",
+	}, client.paths())
+	assert.Equal(t, common.MapStr{
+		"aliases": common.MapStr{
+			"testbeat": common.MapStr{"is_write_index": true},
+		},
+	}, client.requests[1].body)
+
+	// Existing aliases are not modified.
+	client = newStubClient(map[string]response{"HEAD /_alias/testbeat": {200, ""}})
+	require.NoError(t, CreateAlias(client, DefaultConfig, "testbeat"))
+	assert.Equal(t, []string{"HEAD /_alias/testbeat"}, client.paths())
+}
+
+func TestAliasManager(t *testing.T) {
+	cfg := DefaultConfig
+	cfg.PolicyName = "custom"
+	m := NewAliasManager(cfg)
+	client := newStubClient(nil)
+
+	require.NoError(t, m.Ensure(client, "logs-app"))
+	require.NoError(t, m.Ensure(client, "logs-app"))
+	require.NoError(t, m.Ensure(client, "logs-app-audit"))
+
+	assert.Equal(t, []string{
+		"PUT /_template/logs-app-ilm",
+		"HEAD /_alias/logs-app",
+		"PUT /%3Clogs-app-%7Bnow%2Fd%7D-000001%3E",
+		"PUT /_template/logs-app-audit-ilm",
+		"HEAD /_alias/logs-app-audit",
+		"PUT /%3Clogs-app-audit-%7Bnow%2Fd%7D-000001%3E",
+	}, client.paths())
+
+	template := client.requests[0].body.(common.MapStr)
+	assert.Equal(t, []string{"logs-app-*"}, template["index_patterns"])
+	lifecycle, err := template.GetValue("settings.index.lifecycle")
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{"name": "custom", "rollover_alias": "logs-app"}, lifecycle)
+
+	// The template of the longer alias is more specific.
+	other := client.requests[3].body.(common.MapStr)
+	assert.True(t, other["order"].(int) > template["order"].(int))
+}
+
+func TestAliasManagerRetriesOnError(t *testing.T) {
+	m := NewAliasManager(DefaultConfig)
+	client := newStubClient(map[string]response{"PUT /_template/logs-ilm": {500, "internal error"}})
+
+	assert.Error(t, m.Ensure(client, "logs"))
+
+	delete(client.responses, "PUT /_template/logs-ilm")
+	assert.NoError(t, m.Ensure(client, "logs"))
+	assert.Len(t, client.paths(), 4)
+}
+
+// gatedClient blocks requests to the paths in gates until the gate is closed.
+// It is safe for concurrent use.
+type gatedClient struct {
+	mu       sync.Mutex
+	requests map[string]int
+	gates    map[string]chan struct{}
+	blocked  chan string
+}
+
+func (c *gatedClient) Request(method, path string, pipeline string, params map[string]string, body interface{}) (int, []byte, error) {
+	c.mu.Lock()
+	c.requests[method+" "+path]++
+	gate := c.gates[path]
+	c.mu.Unlock()
+
+	if gate != nil {
+		c.blocked <- path
+		<-gate
+	}
+	if method == "HEAD" {
+		return 404, nil, errors.New("not found")
+	}
+	return 200, []byte("{}"), nil
+}
+
+func (c *gatedClient) GetVersion() common.Version {
+	return *common.MustNewVersion("6.6.0")
+}
+
+func TestAliasManagerConcurrent(t *testing.T) {
+	m := NewAliasManager(DefaultConfig)
+	gate := make(chan struct{})
+	client := &gatedClient{
+		requests: map[string]int{},
+		gates:    map[string]chan struct{}{"/_template/slow-ilm": gate},
+		blocked:  make(chan string, 1),
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 2)
+	ensureSlow := func() {
+		defer wg.Done()
+		errs <- m.Ensure(client, "slow")
+	}
+
+	wg.Add(1)
+	go ensureSlow()
+	<-client.blocked
+
+	// a second write to the alias waits for the running bootstrap
+	wg.Add(1)
+	go ensureSlow()
+
+	// other aliases are not blocked by the slow bootstrap
+	require.NoError(t, m.Ensure(client, "fast"))
+
+	close(gate)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+
+	require.NoError(t, m.Ensure(client, "slow"))
+	assert.Equal(t, 1, client.requests["PUT /_template/slow-ilm"])
+	assert.Equal(t, 1, client.requests["PUT /_template/fast-ilm"])
+}
@@ -0,0 +1,93 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilm
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+)
+
+// Config contains the index lifecycle management settings of the Elasticsearch
+// output (output.elasticsearch.ilm).
+type Config struct {
+	RolloverAlias string                 `config:"rollover_alias"`
+	Pattern       string                 `config:"pattern"`
+	PolicyName    string                 `config:"policy_name"`
+	PolicyFile    string                 `config:"policy_file"` // JSON file containing the policy.
+	Policy        map[string]interface{} `config:"policy"`      // Inline policy.
+	Rollover      RolloverConfig         `config:"rollover"`    // Rollover conditions of the default policy.
+	Overwrite     bool                   `config:"overwrite"`   // Overwrite the policy if it was modified.
+}
+
+// RolloverConfig contains the conditions of the rollover action of the default
+// policy.
+type RolloverConfig struct {
+	MaxSize string `config:"max_size"`
+	MaxAge  string `config:"max_age"`
+	MaxDocs int64  `config:"max_docs" validate:"min=0"`
+}
+
+const (
+	// DefaultPolicyName is the name of the policy if ilm.policy_name is not set.
+	DefaultPolicyName = "beats-default-policy"
+	// DefaultPattern is the pattern of the first index created for an alias.
+	DefaultPattern = "{now/d}-000001"
+)
+
+// DefaultConfig contains the default ILM settings.
+var DefaultConfig = Config{
+	Pattern:    DefaultPattern,
+	PolicyName: DefaultPolicyName,
+	Rollover: RolloverConfig{
+		MaxSize: "50gb",
+		MaxAge:  "30d",
+	},
+}
+
+// NewConfig unpacks the ILM settings. The rollover alias defaults to the beat
+// name and version.
+func NewConfig(info beat.Info, cfg *common.Config) (Config, error) {
+	config := DefaultConfig
+	if cfg != nil {
+		if err := cfg.Unpack(&config); err != nil {
+			return Config{}, fmt.Errorf("problem unpacking ilm configs: %v", err)
+		}
+	}
+
+	if config.RolloverAlias == "" {
+		config.RolloverAlias = fmt.Sprintf("%s-%s", info.Beat, info.Version)
+	}
+	return config, nil
+}
+
+// Validate checks the ILM settings.
+func (c *Config) Validate() error {
+	if c.Pattern == "" {
+		return errors.New("ilm.pattern must not be empty")
+	}
+	if c.PolicyName == "" {
+		return errors.New("ilm.policy_name must not be empty")
+	}
+	if c.PolicyFile != "" && len(c.Policy) > 0 {
+		return errors.New("ilm.policy and ilm.policy_file can not be used together")
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package ilm manages the index lifecycle management (ILM) policies and the
+// rollover aliases used by the Elasticsearch output.
+package ilm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/njcx/libbeat_v6/common"
+)
+
+// ESClient is a subset of the Elasticsearch client API capable of managing
+// policies and aliases.
+type ESClient interface {
+	Request(method, path string, pipeline string, params map[string]string, body interface{}) (int, []byte, error)
+	GetVersion() common.Version
+}
+
+var minVersion = common.MustNewVersion("6.6.0")
+
+// CheckSupported returns an error if the Elasticsearch version is too old or ILM
+// is not available and enabled.
+func CheckSupported(client ESClient) error {
+	esVersion := client.GetVersion()
+	if !esVersion.IsValid() {
+		return errors.New("Unknown Elasticsearch version")
+	}
+	if esVersion.LessThan(minVersion) {
+		return fmt.Errorf("ILM requires at least Elasticsearch %s. Used version: %s", minVersion, esVersion.String())
+	}
+
+	code, body, err := client.Request("GET", "/_xpack", "", nil, nil)
+
+	// If we get a 400, it's assumed to be the OSS version of Elasticsearch
+	if code == 400 {
+		return fmt.Errorf("ILM feature is not available in this Elasticsearch version")
+	}
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Features struct {
+			ILM struct {
+				Available bool `json:"available"`
+				Enabled   bool `json:"enabled"`
+			} `json:"ilm"`
+		} `json:"features"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return fmt.Errorf("failed to parse JSON response: %v", err)
+	}
+
+	if !response.Features.ILM.Available {
+		return fmt.Errorf("ILM feature is not available in Elasticsearch")
+	}
+	if !response.Features.ILM.Enabled {
+		return fmt.Errorf("ILM feature is not enabled in Elasticsearch")
+	}
+	return nil
+}
@@ -0,0 +1,268 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/logp"
+	"github.com/njcx/libbeat_v6/paths"
+)
+
+// Policy is an ILM policy. Body contains the policy as sent to Elasticsearch,
+// e.g. {"policy": {"phases": {...}}}.
+type Policy struct {
+	Name string
+	Body common.MapStr
+}
+
+// phases lists the phases supported by Elasticsearch.
+var phases = map[string]struct{}{
+	"hot":    {},
+	"warm":   {},
+	"cold":   {},
+	"delete": {},
+}
+
+// LoadPolicy returns the configured policy. The policy is read from
+// ilm.policy_file or ilm.policy. If neither is set the default policy is used,
+// which rolls over the index based on the ilm.rollover conditions.
+func LoadPolicy(cfg Config) (Policy, error) {
+	var policy map[string]interface{}
+	switch {
+	case cfg.PolicyFile != "":
+		path := paths.Resolve(paths.Config, cfg.PolicyFile)
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return Policy{}, fmt.Errorf("error reading ILM policy file %s: %v", path, err)
+		}
+		if err := json.Unmarshal(content, &policy); err != nil {
+			return Policy{}, fmt.Errorf("could not unmarshal ILM policy file %s: %v", path, err)
+		}
+	case len(cfg.Policy) > 0:
+		policy = cfg.Policy
+	default:
+		policy = defaultPolicy(cfg.Rollover)
+	}
+
+	// Accept the policy with or without the top-level "policy" key, as returned
+	// by the Elasticsearch API.
+	if inner, ok := policy["policy"]; ok && len(policy) == 1 {
+		m, ok := inner.(map[string]interface{})
+		if !ok {
+			return Policy{}, errors.New("ILM policy must be an object")
+		}
+		policy = m
+	}
+
+	policy, err := normalize(policy)
+	if err != nil {
+		return Policy{}, err
+	}
+	if err := validatePolicy(policy); err != nil {
+		return Policy{}, fmt.Errorf("invalid ILM policy %s: %v", cfg.PolicyName, err)
+	}
+
+	return Policy{
+		Name: cfg.PolicyName,
+		Body: common.MapStr{"policy": policy},
+	}, nil
+}
+
+func defaultPolicy(rollover RolloverConfig) map[string]interface{} {
+	conditions := map[string]interface{}{}
+	if rollover.MaxSize != "" {
+		conditions["max_size"] = rollover.MaxSize
+	}
+	if rollover.MaxAge != "" {
+		conditions["max_age"] = rollover.MaxAge
+	}
+	if rollover.MaxDocs > 0 {
+		conditions["max_docs"] = rollover.MaxDocs
+	}
+
+	return map[string]interface{}{
+		"phases": map[string]interface{}{
+			"hot": map[string]interface{}{
+				"actions": map[string]interface{}{
+					"rollover": conditions,
+				},
+			},
+		},
+	}
+}
+
+// normalize converts the policy to the types returned by encoding/json, so it
+// can be compared with the policy read from Elasticsearch.
+func normalize(policy map[string]interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode ILM policy: %v", err)
+	}
+
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, fmt.Errorf("failed to decode ILM policy: %v", err)
+	}
+	return normalized, nil
+}
+
+func validatePolicy(policy map[string]interface{}) error {
+	all, ok := policy["phases"].(map[string]interface{})
+	if !ok || len(all) == 0 {
+		return errors.New("no phases defined")
+	}
+
+	for name, phase := range all {
+		if _, ok := phases[name]; !ok {
+			return fmt.Errorf("unknown phase '%s'", name)
+		}
+		settings, ok := phase.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("phase '%s' must be an object", name)
+		}
+		if actions, found := settings["actions"]; found {
+			if _, ok := actions.(map[string]interface{}); !ok {
+				return fmt.Errorf("actions of phase '%s' must be an object", name)
+			}
+		}
+	}
+
+	if rollover, found := lookup(all, "hot", "actions", "rollover"); found {
+		if conditions, ok := rollover.(map[string]interface{}); !ok || len(conditions) == 0 {
+			return errors.New("rollover action requires at least one condition")
+		}
+	}
+	return nil
+}
+
+// EnsurePolicy loads the policy into Elasticsearch if it doesn't exist yet. An
+// existing policy is only replaced if it differs from the given policy and
+// overwrite is set, so changes made to the policy in Elasticsearch are kept
+// by default. It returns true if the policy was loaded.
+func EnsurePolicy(client ESClient, policy Policy, overwrite bool) (bool, error) {
+	existing, found, err := getPolicy(client, policy.Name)
+	if err != nil {
+		return false, err
+	}
+
+	if found {
+		if contains(policy.Body["policy"], existing) {
+			logp.Info("ILM policy %s is up to date.", policy.Name)
+			return false, nil
+		}
+		if !overwrite {
+			logp.Warn("ILM policy %s differs from the configured policy and will not be "+
+				"overwritten. Set ilm.overwrite to replace it.", policy.Name)
+			return false, nil
+		}
+		logp.Info("ILM policy %s differs from the configured policy and will be overwritten.", policy.Name)
+	}
+
+	if err := WritePolicy(client, policy); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// WritePolicy writes the policy to Elasticsearch, overwriting the existing
+// policy.
+func WritePolicy(client ESClient, policy Policy) error {
+	_, body, err := client.Request("PUT", "/_ilm/policy/"+policy.Name, "", nil, policy.Body)
+	if err != nil {
+		return fmt.Errorf("failed to load ILM policy %s: %v: %s", policy.Name, err, body)
+	}
+	logp.Info("ILM policy %s loaded.", policy.Name)
+	return nil
+}
+
+func getPolicy(client ESClient, name string) (map[string]interface{}, bool, error) {
+	status, body, err := client.Request("GET", "/_ilm/policy/"+name, "", nil, nil)
+	if status == 404 {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read ILM policy %s: %v", name, err)
+	}
+
+	var response map[string]struct {
+		Policy map[string]interface{} `json:"policy"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, false, fmt.Errorf("failed to parse ILM policy %s: %v", name, err)
+	}
+
+	existing, found := response[name]
+	if !found {
+		return nil, false, nil
+	}
+	return existing.Policy, true, nil
+}
+
+// contains returns true if all values of expected are present in actual.
+// Elasticsearch adds defaults (e.g. min_age) to the stored policy, so values
+// only present in actual are ignored.
+func contains(expected, actual interface{}) bool {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range e {
+			if !contains(v, a[k]) {
+				return false
+			}
+		}
+		return true
+	case common.MapStr:
+		return contains(map[string]interface{}(e), actual)
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(e) {
+			return false
+		}
+		for i := range e {
+			if !contains(e[i], a[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(expected, actual)
+	}
+}
+
+func lookup(m map[string]interface{}, keys ...string) (interface{}, bool) {
+	var v interface{} = m
+	for _, key := range keys {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = obj[key]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
@@ -0,0 +1,192 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilm
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+)
+
+func TestNewConfig(t *testing.T) {
+	info := beat.Info{Beat: "testbeat", Version: "6.7.0"}
+
+	cfg, err := NewConfig(info, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "testbeat-6.7.0", cfg.RolloverAlias)
+	assert.Equal(t, DefaultPattern, cfg.Pattern)
+	assert.Equal(t, DefaultPolicyName, cfg.PolicyName)
+
+	_, err = NewConfig(info, common.MustNewConfigFrom(map[string]interface{}{
+		"policy_file": "policy.json",
+		"policy":      map[string]interface{}{"phases": map[string]interface{}{}},
+	}))
+	assert.Error(t, err)
+}
+
+func TestLoadPolicy(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		cfg := DefaultConfig
+		cfg.Rollover.MaxDocs = 1000
+		policy, err := LoadPolicy(cfg)
+		require.NoError(t, err)
+
+		assert.Equal(t, DefaultPolicyName, policy.Name)
+		assert.JSONEq(t, `{"policy": {"phases": {"hot": {"actions": {"rollover": {
+			"max_size": "50gb", "max_age": "30d", "max_docs": 1000
+		}}}}}}`, toJSON(policy.Body))
+	})
+
+	t.Run("inline", func(t *testing.T) {
+		cfg := DefaultConfig
+		err := common.MustNewConfigFrom(`
+policy_name: custom
+policy.phases:
+  hot.actions.rollover.max_size: 10gb
+  warm:
+    min_age: 7d
+    actions.forcemerge.max_num_segments: 1
+  cold:
+    min_age: 30d
+    actions.allocate.number_of_replicas: 0
+`).Unpack(&cfg)
+		require.NoError(t, err)
+
+		policy, err := LoadPolicy(cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "custom", policy.Name)
+		assert.JSONEq(t, `{"policy": {"phases": {
+			"hot": {"actions": {"rollover": {"max_size": "10gb"}}},
+			"warm": {"min_age": "7d", "actions": {"forcemerge": {"max_num_segments": 1}}},
+			"cold": {"min_age": "30d", "actions": {"allocate": {"number_of_replicas": 0}}}
+		}}}`, toJSON(policy.Body))
+	})
+
+	t.Run("file", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "ilm")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		for name, content := range map[string]string{
+			"wrapped.json": `{"policy": {"phases": {"delete": {"min_age": "1d", "actions": {"delete": {}}}}}}`,
+			"plain.json":   `{"phases": {"delete": {"min_age": "1d", "actions": {"delete": {}}}}}`,
+		} {
+			path := filepath.Join(dir, name)
+			require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+
+			cfg := DefaultConfig
+			cfg.PolicyFile = path
+			policy, err := LoadPolicy(cfg)
+			if assert.NoError(t, err, name) {
+				assert.JSONEq(t, `{"policy": {"phases": {"delete": {"min_age": "1d", "actions": {"delete": {}}}}}}`,
+					toJSON(policy.Body), name)
+			}
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, policy := range map[string]map[string]interface{}{
+			"no phases":     {"phases": map[string]interface{}{}},
+			"unknown phase": {"phases": map[string]interface{}{"lukewarm": map[string]interface{}{}}},
+			"phase type":    {"phases": map[string]interface{}{"hot": "rollover"}},
+			"actions type":  {"phases": map[string]interface{}{"hot": map[string]interface{}{"actions": 1}}},
+			"no conditions": {"phases": map[string]interface{}{"hot": map[string]interface{}{
+				"actions": map[string]interface{}{"rollover": map[string]interface{}{}},
+			}}},
+		} {
+			cfg := DefaultConfig
+			cfg.Policy = policy
+			_, err := LoadPolicy(cfg)
+			assert.Error(t, err, name)
+		}
+	})
+}
+
+func TestEnsurePolicy(t *testing.T) {
+	policy, err := LoadPolicy(DefaultConfig)
+	require.NoError(t, err)
+
+	path := "/_ilm/policy/" + DefaultPolicyName
+	stored := func(phases string) map[string]response {
+		return map[string]response{
+			"GET " + path: {200, `{"` + DefaultPolicyName + `": {"version": 1, "policy": {"phases": ` + phases + `}}}`},
+		}
+	}
+	unchanged := `{"hot": {"min_age": "0ms", "actions": {"rollover": {"max_size": "50gb", "max_age": "30d"}}}}`
+	modified := `{"hot": {"min_age": "0ms", "actions": {"rollover": {"max_size": "10gb", "max_age": "30d"}}}}`
+
+	cases := map[string]struct {
+		responses map[string]response
+		overwrite bool
+		loaded    bool
+	}{
+		"missing":             {nil, false, true},
+		"unchanged":           {stored(unchanged), false, false},
+		"unchanged overwrite": {stored(unchanged), true, false},
+		"modified":            {stored(modified), false, false},
+		"modified overwrite":  {stored(modified), true, true},
+	}
+
+	for name, test := range cases {
+		client := newStubClient(test.responses)
+		loaded, err := EnsurePolicy(client, policy, test.overwrite)
+		if !assert.NoError(t, err, name) {
+			continue
+		}
+
+		assert.Equal(t, test.loaded, loaded, name)
+		if test.loaded {
+			assert.Equal(t, []string{"GET " + path, "PUT " + path}, client.paths(), name)
+			assert.Equal(t, policy.Body, client.requests[1].body, name)
+		} else {
+			assert.Equal(t, []string{"GET " + path}, client.paths(), name)
+		}
+	}
+
+	client := newStubClient(map[string]response{"GET " + path: {500, "internal error"}})
+	_, err = EnsurePolicy(client, policy, true)
+	assert.Error(t, err)
+}
+
+func TestCheckSupported(t *testing.T) {
+	features := func(available, enabled bool) map[string]response {
+		return map[string]response{
+			"GET /_xpack": {200, toJSON(map[string]interface{}{
+				"features": map[string]interface{}{
+					"ilm": map[string]interface{}{"available": available, "enabled": enabled},
+				},
+			})},
+		}
+	}
+
+	assert.NoError(t, CheckSupported(newStubClient(features(true, true))))
+	assert.Error(t, CheckSupported(newStubClient(features(true, false))))
+	assert.Error(t, CheckSupported(newStubClient(features(false, false))))
+	assert.Error(t, CheckSupported(newStubClient(map[string]response{"GET /_xpack": {400, "{}"}})))
+
+	old := newStubClient(features(true, true))
+	old.version = *common.MustNewVersion("6.5.4")
+	assert.Error(t, CheckSupported(old))
+}
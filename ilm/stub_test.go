@@ -0,0 +1,89 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ilm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/njcx/libbeat_v6/common"
+)
+
+type request struct {
+	method, path string
+	body         interface{}
+}
+
+type response struct {
+	status int
+	body   string
+}
+
+// stubClient records the requests and replies with the response registered for
+// the method and path, or 404.
+type stubClient struct {
+	version   common.Version
+	responses map[string]response
+	requests  []request
+}
+
+func newStubClient(responses map[string]response) *stubClient {
+	return &stubClient{
+		version:   *common.MustNewVersion("6.6.0"),
+		responses: responses,
+	}
+}
+
+func (c *stubClient) Request(method, path string, pipeline string, params map[string]string, body interface{}) (int, []byte, error) {
+	c.requests = append(c.requests, request{method: method, path: path, body: body})
+
+	resp, found := c.responses[method+" "+path]
+	if !found {
+		if method == "PUT" {
+			resp = response{status: 200, body: "{}"}
+		} else {
+			resp = response{status: 404, body: "{}"}
+		}
+	}
+
+	var err error
+	if resp.status >= 300 {
+		err = fmt.Errorf("%v: %s", resp.status, resp.body)
+	}
+	return resp.status, []byte(resp.body), err
+}
+
+func (c *stubClient) GetVersion() common.Version {
+	return c.version
+}
+
+func (c *stubClient) paths() []string {
+	var paths []string
+	for _, r := range c.requests {
+		paths = append(paths, r.method+" "+r.path)
+	}
+	return paths
+}
+
+func toJSON(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return string(data)
+}
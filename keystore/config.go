@@ -19,9 +19,11 @@ package keystore
 
 // Config Define keystore configurable options
 type Config struct {
+	Type string `config:"type"`
 	Path string `config:"path"`
 }
 
 var defaultConfig = Config{
+	Type: "file",
 	Path: "",
 }
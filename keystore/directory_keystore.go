@@ -0,0 +1,422 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package keystore
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/file"
+	"github.com/njcx/libbeat_v6/logp"
+)
+
+const directoryPermission = 0700
+
+// DirectoryKeystore stores every secret in its own encrypted file inside a directory. The file
+// name is the name of the key, which makes the backend usable with secrets mounted as volumes
+// (for example Kubernetes secrets). Secrets are read from disk on every access, so updates done
+// to the mounted files are picked up without restarting the beat.
+type DirectoryKeystore struct {
+	sync.RWMutex
+	Path     string
+	password *SecureString
+
+	// changes not yet persisted to disk.
+	updates map[string][]byte
+	deletes common.StringSet
+}
+
+// NewDirectoryKeystore returns a new directory based keystore using the default empty password.
+func NewDirectoryKeystore(path string) (Keystore, error) {
+	return NewDirectoryKeystoreWithPassword(path, NewSecureString([]byte("")))
+}
+
+// NewDirectoryKeystoreWithPassword returns a new directory based keystore, secrets are
+// encrypted and decrypted using the given password.
+func NewDirectoryKeystoreWithPassword(path string, password *SecureString) (Keystore, error) {
+	info, err := os.Stat(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil && !info.IsDir() {
+		return nil, fmt.Errorf("keystore path '%s' is not a directory", path)
+	}
+
+	return &DirectoryKeystore{
+		Path:     path,
+		password: password,
+		updates:  map[string][]byte{},
+		deletes:  common.StringSet{},
+	}, nil
+}
+
+func newDirectoryKeystoreFromConfig(cfg *common.Config, defaultPath string, password *SecureString) (Keystore, error) {
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, fmt.Errorf("could not read keystore configuration, err: %v", err)
+	}
+
+	if config.Path == "" {
+		config.Path = defaultPath + ".d"
+	}
+
+	logp.Debug("keystore", "Loading directory keystore from %s", config.Path)
+	return NewDirectoryKeystoreWithPassword(config.Path, password)
+}
+
+// Retrieve returns the secret stored in the file named after the key.
+func (k *DirectoryKeystore) Retrieve(key string) (*SecureString, error) {
+	k.RLock()
+	defer k.RUnlock()
+
+	if err := validateSecretName(key); err != nil {
+		return nil, ErrKeyDoesntExists
+	}
+
+	if k.deletes.Has(key) {
+		return nil, ErrKeyDoesntExists
+	}
+	if value, ok := k.updates[key]; ok {
+		return NewSecureString(value), nil
+	}
+
+	value, err := k.readSecret(key)
+	if err != nil {
+		return nil, err
+	}
+	return NewSecureString(value), nil
+}
+
+// Store adds the key pair to the keystore, the secret is written to disk on Save.
+func (k *DirectoryKeystore) Store(key string, value []byte) error {
+	if err := validateSecretName(key); err != nil {
+		return err
+	}
+
+	k.Lock()
+	defer k.Unlock()
+
+	k.deletes.Del(key)
+	k.updates[key] = value
+	return nil
+}
+
+// Delete removes the key from the keystore, the file is removed on Save.
+func (k *DirectoryKeystore) Delete(key string) error {
+	if err := validateSecretName(key); err != nil {
+		return err
+	}
+
+	k.Lock()
+	defer k.Unlock()
+
+	delete(k.updates, key)
+	k.deletes.Add(key)
+	return nil
+}
+
+// List returns the keys available in the directory.
+func (k *DirectoryKeystore) List() ([]string, error) {
+	k.RLock()
+	defer k.RUnlock()
+
+	return k.list()
+}
+
+// GetConfig returns common.Config representation of all the secrets in the directory.
+func (k *DirectoryKeystore) GetConfig() (*common.Config, error) {
+	k.RLock()
+	defer k.RUnlock()
+
+	keys, err := k.list()
+	if err != nil {
+		return nil, err
+	}
+
+	configHash := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		value, ok := k.updates[key]
+		if !ok {
+			value, err = k.readSecret(key)
+			if err != nil {
+				return nil, err
+			}
+		}
+		configHash[key] = string(value)
+	}
+
+	return common.NewConfigFrom(configHash)
+}
+
+// Create creates an empty keystore directory, existing secrets are removed when override is true.
+func (k *DirectoryKeystore) Create(override bool) error {
+	k.Lock()
+	defer k.Unlock()
+
+	if k.isPersisted() {
+		if !override {
+			return ErrAlreadyExists
+		}
+
+		keys, err := k.secretFiles()
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if err := os.Remove(filepath.Join(k.Path, key)); err != nil {
+				return fmt.Errorf("cannot remove secret '%s' from the keystore: %v", key, err)
+			}
+		}
+	}
+
+	k.updates = map[string][]byte{}
+	k.deletes = common.StringSet{}
+	return os.MkdirAll(k.Path, directoryPermission)
+}
+
+// IsPersisted returns true if the keystore directory exists.
+func (k *DirectoryKeystore) IsPersisted() bool {
+	k.RLock()
+	defer k.RUnlock()
+
+	return k.isPersisted()
+}
+
+// Save writes the pending changes to disk.
+func (k *DirectoryKeystore) Save() error {
+	k.Lock()
+	defer k.Unlock()
+
+	if len(k.updates) == 0 && len(k.deletes) == 0 {
+		return nil
+	}
+
+	if err := os.MkdirAll(k.Path, directoryPermission); err != nil {
+		return fmt.Errorf("cannot create the keystore directory '%s': %v", k.Path, err)
+	}
+
+	for key, value := range k.updates {
+		if err := k.writeSecret(key, value); err != nil {
+			return err
+		}
+		delete(k.updates, key)
+	}
+
+	for key := range k.deletes {
+		err := os.Remove(filepath.Join(k.Path, key))
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("cannot remove secret '%s' from the keystore: %v", key, err)
+		}
+		k.deletes.Del(key)
+	}
+
+	return nil
+}
+
+// ChangePassword encrypts all the secret files again using the new password. Secrets are
+// decrypted with the current password and encrypted into temporary files before any secret
+// file is replaced. The current password is kept if any secret can't be replaced.
+func (k *DirectoryKeystore) ChangePassword(password *SecureString) error {
+	k.Lock()
+	defer k.Unlock()
+
+	keys, err := k.secretFiles()
+	if err != nil {
+		return err
+	}
+
+	secrets := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		value, err := k.readSecret(key)
+		if err != nil {
+			return err
+		}
+		secrets[key] = value
+	}
+
+	temporaryPaths := make(map[string]string, len(secrets))
+	removeTemporary := func() {
+		for _, path := range temporaryPaths {
+			os.Remove(path)
+		}
+	}
+	for key, value := range secrets {
+		path, err := k.writeTemporarySecret(password, key, value)
+		if err != nil {
+			removeTemporary()
+			return err
+		}
+		temporaryPaths[key] = path
+	}
+
+	var replaced []string
+	for key, temporaryPath := range temporaryPaths {
+		if err := k.replaceSecret(key, temporaryPath); err != nil {
+			removeTemporary()
+
+			// Encrypt the secrets replaced so far with the current password again.
+			for _, key := range replaced {
+				if err := k.writeSecret(key, secrets[key]); err != nil {
+					logp.Err("Failed to restore the secret '%s' encrypted with the current password: %v", key, err)
+				}
+			}
+			return err
+		}
+		replaced = append(replaced, key)
+	}
+
+	k.password = password
+	return nil
+}
+
+func (k *DirectoryKeystore) isPersisted() bool {
+	info, err := os.Stat(k.Path)
+	return err == nil && info.IsDir()
+}
+
+func (k *DirectoryKeystore) list() ([]string, error) {
+	keys, err := k.secretFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(keys)+len(k.updates))
+	for _, key := range keys {
+		if _, updated := k.updates[key]; !updated && !k.deletes.Has(key) {
+			result = append(result, key)
+		}
+	}
+	for key := range k.updates {
+		result = append(result, key)
+	}
+	return result, nil
+}
+
+// secretFiles returns the names of the secret files stored in the directory. Hidden files are
+// ignored, Kubernetes uses them for the symlinks used to atomically update the mounted secrets.
+func (k *DirectoryKeystore) secretFiles() ([]string, error) {
+	entries, err := ioutil.ReadDir(k.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var keys []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, ".") || strings.HasSuffix(name, ".tmp") {
+			continue
+		}
+
+		// Follow symlinks, mounted secrets are symlinks to the actual files.
+		info, err := os.Stat(filepath.Join(k.Path, name))
+		if err != nil || info.IsDir() {
+			continue
+		}
+		keys = append(keys, name)
+	}
+	return keys, nil
+}
+
+func (k *DirectoryKeystore) readSecret(key string) ([]byte, error) {
+	path := filepath.Join(k.Path, key)
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrKeyDoesntExists
+		}
+		return nil, err
+	}
+
+	if common.IsStrictPerms() {
+		if err := checkPermissions(path); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(raw) <= len(version) || !bytes.Equal(raw[:len(version)], version) {
+		return nil, fmt.Errorf("secret file '%s' doesn't match the expected keystore format '%s'", path, version)
+	}
+
+	base64Decoder := base64.NewDecoder(base64.StdEncoding, bytes.NewReader(raw[len(version):]))
+	plaintext, err := decrypt(k.password, base64Decoder)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt the secret '%s': %v", key, err)
+	}
+	return ioutil.ReadAll(plaintext)
+}
+
+func (k *DirectoryKeystore) writeSecret(key string, value []byte) error {
+	temporaryPath, err := k.writeTemporarySecret(k.password, key, value)
+	if err != nil {
+		return err
+	}
+	return k.replaceSecret(key, temporaryPath)
+}
+
+// writeTemporarySecret writes the secret encrypted with password next to the secret file and
+// returns the path of the temporary file.
+func (k *DirectoryKeystore) writeTemporarySecret(password *SecureString, key string, value []byte) (string, error) {
+	encrypted, err := encrypt(password, bytes.NewReader(value))
+	if err != nil {
+		return "", fmt.Errorf("cannot encrypt the secret '%s': %v", key, err)
+	}
+
+	data, err := ioutil.ReadAll(encrypted)
+	if err != nil {
+		return "", err
+	}
+
+	buf := bytes.NewBuffer(append([]byte{}, version...))
+	buf.WriteString(base64.StdEncoding.EncodeToString(data))
+
+	temporaryPath := filepath.Join(k.Path, key) + ".tmp"
+	if err := ioutil.WriteFile(temporaryPath, buf.Bytes(), filePermission); err != nil {
+		os.Remove(temporaryPath)
+		return "", fmt.Errorf("cannot write the secret '%s' to '%s': %v", key, temporaryPath, err)
+	}
+	return temporaryPath, nil
+}
+
+// replaceSecret replaces the secret file with the temporary file.
+func (k *DirectoryKeystore) replaceSecret(key, temporaryPath string) error {
+	path := filepath.Join(k.Path, key)
+	if err := file.SafeFileRotate(path, temporaryPath); err != nil {
+		os.Remove(temporaryPath)
+		return fmt.Errorf("cannot replace the secret '%s' at '%s': %v", key, path, err)
+	}
+	return nil
+}
+
+// validateSecretName makes sure the key can be used as a file name inside the keystore directory.
+func validateSecretName(key string) error {
+	if key == "" || strings.HasPrefix(key, ".") || strings.HasSuffix(key, ".tmp") ||
+		strings.ContainsAny(key, `/\`) {
+		return fmt.Errorf("invalid key name '%s' for a directory keystore", key)
+	}
+	return nil
+}
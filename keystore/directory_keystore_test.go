@@ -0,0 +1,211 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package keystore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirectoryKeystoreStoreAndRetrieve(t *testing.T) {
+	base := GetTemporaryKeystoreFile()
+	defer os.RemoveAll(filepath.Dir(base))
+
+	dir := filepath.Join(base, "secrets")
+
+	keystore, err := NewDirectoryKeystore(dir)
+	require.NoError(t, err)
+	assert.False(t, keystore.IsPersisted())
+
+	require.NoError(t, keystore.Create(false))
+	assert.True(t, keystore.IsPersisted())
+	assert.Equal(t, ErrAlreadyExists, keystore.Create(false))
+
+	require.NoError(t, keystore.Store(keyValue, secretValue))
+	require.NoError(t, keystore.Save())
+
+	// Secrets are stored encrypted, one file per key.
+	raw, err := ioutil.ReadFile(filepath.Join(dir, keyValue))
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), string(secretValue))
+
+	info, err := os.Stat(filepath.Join(dir, keyValue))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(filePermission), info.Mode().Perm())
+
+	reopened, err := NewDirectoryKeystore(dir)
+	require.NoError(t, err)
+
+	secure, err := reopened.Retrieve(keyValue)
+	require.NoError(t, err)
+	v, err := secure.Get()
+	require.NoError(t, err)
+	assert.Equal(t, secretValue, v)
+
+	resolver := ResolverWrap(reopened)
+	resolved, err := resolver(keyValue)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", resolved)
+}
+
+func TestDirectoryKeystoreDeleteAndList(t *testing.T) {
+	dir := GetTemporaryKeystoreFile()
+	defer os.RemoveAll(filepath.Dir(dir))
+
+	keystore, err := NewDirectoryKeystore(dir)
+	require.NoError(t, err)
+
+	keystore.Store("a", []byte("1"))
+	keystore.Store("b", []byte("2"))
+	require.NoError(t, keystore.Save())
+
+	// Hidden entries, like the Kubernetes `..data` symlinks, are ignored.
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "..data"), 0700))
+
+	keys, err := keystore.List()
+	require.NoError(t, err)
+	sort.Strings(keys)
+	assert.Equal(t, []string{"a", "b"}, keys)
+
+	require.NoError(t, keystore.Delete("a"))
+	_, err = keystore.Retrieve("a")
+	assert.Equal(t, ErrKeyDoesntExists, err)
+	require.NoError(t, keystore.Save())
+
+	_, err = os.Stat(filepath.Join(dir, "a"))
+	assert.True(t, os.IsNotExist(err))
+
+	cfg, err := keystore.GetConfig()
+	require.NoError(t, err)
+	b, err := cfg.String("b", -1)
+	require.NoError(t, err)
+	assert.Equal(t, "2", b)
+	assert.False(t, cfg.HasField("a"))
+}
+
+func TestDirectoryKeystoreWrongPassword(t *testing.T) {
+	dir := GetTemporaryKeystoreFile()
+	defer os.RemoveAll(filepath.Dir(dir))
+
+	keystore, err := NewDirectoryKeystoreWithPassword(dir, NewSecureString([]byte("password")))
+	require.NoError(t, err)
+	keystore.Store(keyValue, secretValue)
+	require.NoError(t, keystore.Save())
+
+	other, err := NewDirectoryKeystoreWithPassword(dir, NewSecureString([]byte("wrong")))
+	require.NoError(t, err)
+	_, err = other.Retrieve(keyValue)
+	assert.Error(t, err)
+}
+
+func TestDirectoryKeystoreInvalidKeyNames(t *testing.T) {
+	dir := GetTemporaryKeystoreFile()
+	defer os.RemoveAll(filepath.Dir(dir))
+
+	keystore, err := NewDirectoryKeystore(dir)
+	require.NoError(t, err)
+
+	for _, key := range []string{"", ".hidden", "../escape", "a/b", "key.tmp"} {
+		assert.Error(t, keystore.Store(key, secretValue), key)
+		assert.Error(t, keystore.Delete(key), key)
+	}
+}
+
+func TestDirectoryKeystoreDeleteOutside(t *testing.T) {
+	dir := GetTemporaryKeystoreFile()
+	defer os.RemoveAll(filepath.Dir(dir))
+
+	keystore, err := NewDirectoryKeystore(dir)
+	require.NoError(t, err)
+	require.NoError(t, keystore.Create(false))
+
+	outside := filepath.Join(filepath.Dir(dir), "outside")
+	require.NoError(t, ioutil.WriteFile(outside, []byte("keep"), 0600))
+
+	assert.Error(t, keystore.Delete("../outside"))
+	require.NoError(t, keystore.Save())
+	_, err = os.Stat(outside)
+	assert.NoError(t, err)
+}
+
+func TestDirectoryKeystoreChangePassword(t *testing.T) {
+	dir := GetTemporaryKeystoreFile()
+	defer os.RemoveAll(filepath.Dir(dir))
+
+	keystore, err := NewDirectoryKeystore(dir)
+	require.NoError(t, err)
+	keystore.Store(keyValue, secretValue)
+	require.NoError(t, keystore.Save())
+
+	newPassword := NewSecureString([]byte("new password"))
+	require.NoError(t, keystore.(PasswordChanger).ChangePassword(newPassword))
+
+	_, err = keystore.Retrieve(keyValue)
+	require.NoError(t, err)
+
+	old, err := NewDirectoryKeystore(dir)
+	require.NoError(t, err)
+	_, err = old.Retrieve(keyValue)
+	assert.Error(t, err)
+
+	updated, err := NewDirectoryKeystoreWithPassword(dir, NewSecureString([]byte("new password")))
+	require.NoError(t, err)
+	secure, err := updated.Retrieve(keyValue)
+	require.NoError(t, err)
+	v, _ := secure.Get()
+	assert.Equal(t, secretValue, v)
+}
+
+func TestDirectoryKeystoreChangePasswordFailure(t *testing.T) {
+	dir := GetTemporaryKeystoreFile()
+	defer os.RemoveAll(filepath.Dir(dir))
+
+	keystore, err := NewDirectoryKeystore(dir)
+	require.NoError(t, err)
+	require.NoError(t, keystore.Store("a", []byte("1")))
+	require.NoError(t, keystore.Store("b", []byte("2")))
+	require.NoError(t, keystore.Save())
+
+	// The temporary file of b can't be written.
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "b.tmp"), 0700))
+
+	newPassword := NewSecureString([]byte("new password"))
+	assert.Error(t, keystore.(PasswordChanger).ChangePassword(newPassword))
+
+	// No secret has been replaced and the current password is kept.
+	_, err = os.Stat(filepath.Join(dir, "a.tmp"))
+	assert.True(t, os.IsNotExist(err))
+
+	reopened, err := NewDirectoryKeystore(dir)
+	require.NoError(t, err)
+	for _, ks := range []Keystore{keystore, reopened} {
+		for key, expected := range map[string]string{"a": "1", "b": "2"} {
+			secure, err := ks.Retrieve(key)
+			require.NoError(t, err)
+			v, err := secure.Get()
+			require.NoError(t, err)
+			assert.Equal(t, []byte(expected), v)
+		}
+	}
+}
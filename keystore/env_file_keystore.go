@@ -0,0 +1,149 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package keystore
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/logp"
+)
+
+// EnvFileKeystore is a read-only keystore reading the secrets from a file of `KEY=VALUE` lines,
+// like the env files used by Docker or systemd. Secrets are not encrypted, the file must be
+// protected by the file system permissions.
+type EnvFileKeystore struct {
+	Path    string
+	secrets map[string]string
+}
+
+// NewEnvFileKeystore reads all the secrets from the env file.
+func NewEnvFileKeystore(path string) (Keystore, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open the env file keystore: %v", err)
+	}
+	defer f.Close()
+
+	if common.IsStrictPerms() {
+		if err := checkPermissions(path); err != nil {
+			return nil, err
+		}
+	}
+
+	secrets := map[string]string{}
+	scanner := bufio.NewScanner(f)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimSpace(strings.TrimPrefix(line, "export "))
+
+		idx := strings.Index(line, "=")
+		if idx <= 0 {
+			return nil, fmt.Errorf("invalid line %d in env file '%s', expected KEY=VALUE", lineNo, path)
+		}
+
+		key := strings.TrimSpace(line[:idx])
+		value, err := unquoteEnvValue(strings.TrimSpace(line[idx+1:]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for '%s' at line %d in env file '%s': %v", key, lineNo, path, err)
+		}
+		secrets[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("cannot read the env file keystore: %v", err)
+	}
+
+	return &EnvFileKeystore{Path: path, secrets: secrets}, nil
+}
+
+func newEnvFileKeystoreFromConfig(cfg *common.Config, defaultPath string, _ *SecureString) (Keystore, error) {
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, fmt.Errorf("could not read keystore configuration, err: %v", err)
+	}
+
+	if config.Path == "" {
+		return nil, fmt.Errorf("keystore.path is required by the env_file keystore")
+	}
+
+	logp.Debug("keystore", "Loading env file keystore from %s", config.Path)
+	return NewEnvFileKeystore(config.Path)
+}
+
+func unquoteEnvValue(value string) (string, error) {
+	if len(value) < 2 {
+		return value, nil
+	}
+
+	switch {
+	case value[0] == '"' && value[len(value)-1] == '"':
+		return strconv.Unquote(value)
+	case value[0] == '\'' && value[len(value)-1] == '\'':
+		return value[1 : len(value)-1], nil
+	}
+	return value, nil
+}
+
+// Retrieve returns the secret for the key.
+func (k *EnvFileKeystore) Retrieve(key string) (*SecureString, error) {
+	value, ok := k.secrets[key]
+	if !ok {
+		return nil, ErrKeyDoesntExists
+	}
+	return NewSecureString([]byte(value)), nil
+}
+
+// Store is not supported, the env file is managed outside of the beat.
+func (k *EnvFileKeystore) Store(key string, value []byte) error { return ErrReadOnly }
+
+// Delete is not supported, the env file is managed outside of the beat.
+func (k *EnvFileKeystore) Delete(key string) error { return ErrReadOnly }
+
+// Create is not supported, the env file is managed outside of the beat.
+func (k *EnvFileKeystore) Create(override bool) error { return ErrReadOnly }
+
+// Save is not supported, the env file is managed outside of the beat.
+func (k *EnvFileKeystore) Save() error { return ErrReadOnly }
+
+// IsPersisted always returns true, the env file must exist to create the keystore.
+func (k *EnvFileKeystore) IsPersisted() bool { return true }
+
+// List returns the keys defined in the env file.
+func (k *EnvFileKeystore) List() ([]string, error) {
+	keys := make([]string, 0, len(k.secrets))
+	for key := range k.secrets {
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// GetConfig returns common.Config representation of the secrets in the env file.
+func (k *EnvFileKeystore) GetConfig() (*common.Config, error) {
+	configHash := make(map[string]interface{}, len(k.secrets))
+	for key, value := range k.secrets {
+		configHash[key] = value
+	}
+	return common.NewConfigFrom(configHash)
+}
@@ -0,0 +1,126 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package keystore
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/njcx/libbeat_v6/common/file"
+)
+
+// Export writes all the secrets of the keystore into a new file keystore at path, encrypted with
+// password. The exported file can be copied to another host and loaded using Import. An existing
+// file at path is only replaced if override is true. The export is written to a temporary file
+// first, so an existing file is kept if the export fails.
+func Export(store Keystore, path string, password *SecureString, override bool) (n int, err error) {
+	if _, err := os.Stat(path); err == nil && !override {
+		return 0, fmt.Errorf("cannot export the keystore, the file '%s' already exists", path)
+	}
+
+	keys, err := store.List()
+	if err != nil {
+		return 0, fmt.Errorf("could not list the keys of the keystore: %v", err)
+	}
+
+	// Remove leftovers of a failed export, they would be loaded as keystore.
+	temporaryPath := path + ".export"
+	if err := os.Remove(temporaryPath); err != nil && !os.IsNotExist(err) {
+		return 0, fmt.Errorf("cannot remove the temporary export '%s': %v", temporaryPath, err)
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(temporaryPath)
+		}
+	}()
+
+	exported, err := NewFileKeystoreWithPassword(temporaryPath, password)
+	if err != nil {
+		return 0, err
+	}
+	if err := exported.Create(false); err != nil {
+		return 0, err
+	}
+
+	for _, key := range keys {
+		secret, err := store.Retrieve(key)
+		if err != nil {
+			return 0, fmt.Errorf("could not retrieve the key '%s': %v", key, err)
+		}
+		value, err := secret.Get()
+		if err != nil {
+			return 0, err
+		}
+		if err := exported.Store(key, value); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := exported.Save(); err != nil {
+		return 0, fmt.Errorf("could not save the exported keystore: %v", err)
+	}
+	if err := file.SafeFileRotate(path, temporaryPath); err != nil {
+		return 0, fmt.Errorf("cannot replace the existing export '%s': %v", path, err)
+	}
+	return len(keys), nil
+}
+
+// Import adds the secrets of a keystore exported with Export to the store. If override is false
+// and any of the exported keys already exists in the store, nothing is imported.
+func Import(store Keystore, path string, password *SecureString, override bool) (int, error) {
+	if _, err := os.Stat(path); err != nil {
+		return 0, fmt.Errorf("cannot read the exported keystore: %v", err)
+	}
+
+	imported, err := NewFileKeystoreWithPassword(path, password)
+	if err != nil {
+		return 0, err
+	}
+
+	keys, err := imported.List()
+	if err != nil {
+		return 0, err
+	}
+
+	if !override {
+		for _, key := range keys {
+			if _, err := store.Retrieve(key); err == nil {
+				return 0, fmt.Errorf("the key '%s' already exists in the keystore", key)
+			}
+		}
+	}
+
+	for _, key := range keys {
+		secret, err := imported.Retrieve(key)
+		if err != nil {
+			return 0, err
+		}
+		value, err := secret.Get()
+		if err != nil {
+			return 0, err
+		}
+		if err := store.Store(key, value); err != nil {
+			return 0, fmt.Errorf("could not add the key '%s' to the keystore: %v", key, err)
+		}
+	}
+
+	if err := store.Save(); err != nil {
+		return 0, fmt.Errorf("fail to save the keystore: %v", err)
+	}
+	return len(keys), nil
+}
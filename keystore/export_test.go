@@ -0,0 +1,77 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package keystore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImport(t *testing.T) {
+	path := GetTemporaryKeystoreFile()
+	defer os.RemoveAll(filepath.Dir(path))
+
+	source := CreateAnExistingKeystore(path)
+	source.Store("other", []byte("value"))
+	require.NoError(t, source.Save())
+
+	exportPath := path + ".export"
+	password := NewSecureString([]byte("export-password"))
+
+	n, err := Export(source, exportPath, password, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	// Refuse to replace an existing export unless asked to.
+	_, err = Export(source, exportPath, password, false)
+	assert.Error(t, err)
+	_, err = Export(source, exportPath, password, true)
+	assert.NoError(t, err)
+
+	// The export can't be read without the password.
+	_, err = NewFileKeystore(exportPath)
+	assert.Error(t, err)
+
+	target, err := NewDirectoryKeystore(filepath.Join(filepath.Dir(path), "target"))
+	require.NoError(t, err)
+	require.NoError(t, target.Store("other", []byte("old")))
+	require.NoError(t, target.Save())
+
+	_, err = Import(target, exportPath, NewSecureString([]byte("wrong")), false)
+	assert.Error(t, err)
+
+	_, err = Import(target, exportPath, password, false)
+	assert.Error(t, err)
+	_, err = target.Retrieve(keyValue)
+	assert.Equal(t, ErrKeyDoesntExists, err)
+
+	n, err = Import(target, exportPath, password, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	for key, expected := range map[string]string{keyValue: "secret", "other": "value"} {
+		secret, err := target.Retrieve(key)
+		require.NoError(t, err)
+		v, _ := secret.Get()
+		assert.Equal(t, expected, string(v))
+	}
+}
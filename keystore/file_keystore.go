@@ -168,6 +168,23 @@ func (k *FileKeystore) Create(override bool) error {
 	return err
 }
 
+// ChangePassword encrypts the keystore again using the new password, a new salt and
+// initialization vector are generated when the keystore is written to disk. The
+// keystore keeps the previous password if it can't be written.
+func (k *FileKeystore) ChangePassword(password *SecureString) error {
+	k.Lock()
+	defer k.Unlock()
+
+	oldPassword, oldDirty := k.password, k.dirty
+	k.password = password
+	k.dirty = true
+	if err := k.doSave(true); err != nil {
+		k.password, k.dirty = oldPassword, oldDirty
+		return err
+	}
+	return nil
+}
+
 // IsPersisted return if the keystore is physically persisted on disk.
 func (k *FileKeystore) IsPersisted() bool {
 	k.Lock()
@@ -199,7 +216,7 @@ func (k *FileKeystore) doSave(override bool) error {
 		return fmt.Errorf("cannot serialize the keystore before saving it to disk: %v", err)
 	}
 
-	encrypted, err := k.encrypt(w)
+	encrypted, err := encrypt(k.password, w)
 	if err != nil {
 		return fmt.Errorf("cannot encrypt the keystore: %v", err)
 	}
@@ -245,7 +262,7 @@ func (k *FileKeystore) loadRaw() ([]byte, error) {
 	defer f.Close()
 
 	if common.IsStrictPerms() {
-		if err := k.checkPermissions(k.Path); err != nil {
+		if err := checkPermissions(k.Path); err != nil {
 			return nil, err
 		}
 	}
@@ -281,7 +298,7 @@ func (k *FileKeystore) load() error {
 	}
 
 	base64Decoder := base64.NewDecoder(base64.StdEncoding, bytes.NewReader(raw[len(version):]))
-	plaintext, err := k.decrypt(base64Decoder)
+	plaintext, err := decrypt(k.password, base64Decoder)
 	if err != nil {
 		return fmt.Errorf("could not decrypt the keystore: %v", err)
 	}
@@ -289,8 +306,8 @@ func (k *FileKeystore) load() error {
 	return jsonDecoder.Decode(&k.secrets)
 }
 
-// Encrypt the data payload using a derived keys and the AES-256-GCM algorithm.
-func (k *FileKeystore) encrypt(reader io.Reader) (io.Reader, error) {
+// encrypt the data payload using a derived keys and the AES-256-GCM algorithm.
+func encrypt(secret *SecureString, reader io.Reader) (io.Reader, error) {
 	// randomly generate the salt and the initialization vector, this information will be saved
 	// on disk in the file as part of the header
 	iv, err := common.RandomBytes(iVLength)
@@ -305,8 +322,8 @@ func (k *FileKeystore) encrypt(reader io.Reader) (io.Reader, error) {
 	}
 
 	// Stretch the user provided key
-	password, _ := k.password.Get()
-	passwordBytes := k.hashPassword(password, salt)
+	password, _ := secret.Get()
+	passwordBytes := hashPassword(password, salt)
 
 	// Select AES-256: because len(passwordBytes) == 32 bytes
 	block, err := aes.NewCipher(passwordBytes)
@@ -335,8 +352,8 @@ func (k *FileKeystore) encrypt(reader io.Reader) (io.Reader, error) {
 	return buf, nil
 }
 
-// should receive an io.reader...
-func (k *FileKeystore) decrypt(reader io.Reader) (io.Reader, error) {
+// decrypt the payload generated by encrypt using the provided password.
+func decrypt(secret *SecureString, reader io.Reader) (io.Reader, error) {
 	data, err := ioutil.ReadAll(reader)
 	if err != nil {
 		return nil, fmt.Errorf("could not read all the data from the encrypted file, error: %s", err)
@@ -351,8 +368,8 @@ func (k *FileKeystore) decrypt(reader io.Reader) (io.Reader, error) {
 	iv := data[saltLength : saltLength+iVLength]
 	encodedBytes := data[saltLength+iVLength:]
 
-	password, _ := k.password.Get()
-	passwordBytes := k.hashPassword(password, salt)
+	password, _ := secret.Get()
+	passwordBytes := hashPassword(password, salt)
 
 	block, err := aes.NewCipher(passwordBytes)
 	if err != nil {
@@ -374,7 +391,7 @@ func (k *FileKeystore) decrypt(reader io.Reader) (io.Reader, error) {
 
 // checkPermission enforces permission on the keystore file itself, the file should have strict
 // permission (0600) and the keystore should refuses to start if its not the case.
-func (k *FileKeystore) checkPermissions(f string) error {
+func checkPermissions(f string) error {
 	if runtime.GOOS == "windows" {
 		return nil
 	}
@@ -415,6 +432,6 @@ func (k *FileKeystore) Package() ([]byte, error) {
 	return k.loadRaw()
 }
 
-func (k *FileKeystore) hashPassword(password, salt []byte) []byte {
+func hashPassword(password, salt []byte) []byte {
 	return pbkdf2.Key(password, salt, iterationsCount, keyLength, sha512.New)
 }
@@ -254,6 +254,35 @@ func TestMissingEncryptedBlock(t *testing.T) {
 	}
 }
 
+func TestChangePassword(t *testing.T) {
+	path := GetTemporaryKeystoreFile()
+	defer os.Remove(path)
+
+	keystore := CreateAnExistingKeystore(path)
+	raw, err := keystore.(Packager).Package()
+	assert.NoError(t, err)
+
+	newPassword := NewSecureString([]byte("new password"))
+	assert.NoError(t, keystore.(PasswordChanger).ChangePassword(newPassword))
+
+	// A new salt is used, the encrypted content is different.
+	updated, err := keystore.(Packager).Package()
+	assert.NoError(t, err)
+	assert.NotEqual(t, raw, updated)
+
+	_, err = NewFileKeystore(path)
+	assert.Error(t, err)
+
+	keystoreRead, err := NewFileKeystoreWithPassword(path, NewSecureString([]byte("new password")))
+	assert.NoError(t, err)
+
+	secure, err := keystoreRead.Retrieve(keyValue)
+	assert.NoError(t, err)
+	v, err := secure.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, secretValue, v)
+}
+
 func createAndReadKeystoreSecret(t *testing.T, password []byte, key string, value []byte) {
 	path := GetTemporaryKeystoreFile()
 	defer os.Remove(path)
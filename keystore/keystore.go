@@ -20,18 +20,25 @@ package keystore
 import (
 	"errors"
 	"fmt"
+	"os"
 
 	"github.com/njcx/libbeat_v6/common"
 	"github.com/njcx/libbeat_v6/logp"
 	ucfg "github.com/elastic/go-ucfg"
 )
 
+// PasswordEnvVar is the environment variable used to read the password of the keystore.
+const PasswordEnvVar = "KEYSTORE_PASSWORD"
+
 var (
 	// ErrAlreadyExists is returned when the file already exist at the location.
 	ErrAlreadyExists = errors.New("cannot create a new keystore a valid keystore already exist at the location")
 
 	// ErrKeyDoesntExists is returned when the key doesn't exist in the store
 	ErrKeyDoesntExists = errors.New("cannot retrieve the key")
+
+	// ErrReadOnly is returned when trying to modify a keystore backend that doesn't support writes.
+	ErrReadOnly = errors.New("the keystore backend is read-only")
 )
 
 // Keystore implement a way to securely saves and retrieves secrets to be used in the configuration
@@ -69,8 +76,26 @@ type Packager interface {
 	Package() ([]byte, error)
 }
 
-// Factory Create the right keystore with the configured options.
+// PasswordChanger defines a keystore that can be encrypted again using a new password.
+type PasswordChanger interface {
+	ChangePassword(password *SecureString) error
+}
+
+// PasswordFromEnv returns the keystore password defined in the KEYSTORE_PASSWORD environment
+// variable, or the default empty password.
+func PasswordFromEnv() *SecureString {
+	return NewSecureString([]byte(os.Getenv(PasswordEnvVar)))
+}
+
+// Factory Create the right keystore with the configured options, the password is read from the
+// KEYSTORE_PASSWORD environment variable.
 func Factory(cfg *common.Config, defaultPath string) (Keystore, error) {
+	return FactoryWithPassword(cfg, defaultPath, PasswordFromEnv())
+}
+
+// FactoryWithPassword Create the right keystore with the configured options, using password to
+// decrypt the keystore.
+func FactoryWithPassword(cfg *common.Config, defaultPath string, password *SecureString) (Keystore, error) {
 	config := defaultConfig
 
 	if cfg == nil {
@@ -82,13 +107,28 @@ func Factory(cfg *common.Config, defaultPath string) (Keystore, error) {
 		return nil, fmt.Errorf("could not read keystore configuration, err: %v", err)
 	}
 
+	factory, err := FindBackend(config.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	logp.Debug("keystore", "Loading %s keystore", config.Type)
+	return factory(cfg, defaultPath, password)
+}
+
+// newFileKeystoreFromConfig is the factory of the default `file` backend.
+func newFileKeystoreFromConfig(cfg *common.Config, defaultPath string, password *SecureString) (Keystore, error) {
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, fmt.Errorf("could not read keystore configuration, err: %v", err)
+	}
+
 	if config.Path == "" {
 		config.Path = defaultPath
 	}
 
 	logp.Debug("keystore", "Loading file keystore from %s", config.Path)
-	keystore, err := NewFileKeystore(config.Path)
-	return keystore, err
+	return NewFileKeystoreWithPassword(config.Path, password)
 }
 
 // ResolverFromConfig create a resolver from a configuration.
@@ -18,12 +18,17 @@
 package keystore
 
 import (
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	ucfg "github.com/elastic/go-ucfg"
+
+	"github.com/njcx/libbeat_v6/common"
 )
 
 func TestResolverWhenTheKeyDoesntExist(t *testing.T) {
@@ -48,3 +53,98 @@ func TestResolverWhenTheKeyExist(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, v, "secret")
 }
+
+func TestFactoryDefaultsToFileKeystore(t *testing.T) {
+	path := GetTemporaryKeystoreFile()
+	defer os.Remove(path)
+
+	keystore, err := Factory(nil, path)
+	require.NoError(t, err)
+	assert.IsType(t, &FileKeystore{}, keystore)
+}
+
+func TestFactorySelectsBackend(t *testing.T) {
+	path := GetTemporaryKeystoreFile()
+	defer os.RemoveAll(filepath.Dir(path))
+
+	cfg := common.MustNewConfigFrom(map[string]interface{}{"type": "directory"})
+	keystore, err := Factory(cfg, path)
+	require.NoError(t, err)
+	if assert.IsType(t, &DirectoryKeystore{}, keystore) {
+		assert.Equal(t, path+".d", keystore.(*DirectoryKeystore).Path)
+	}
+
+	cfg = common.MustNewConfigFrom(map[string]interface{}{"type": "unknown"})
+	_, err = Factory(cfg, path)
+	assert.Error(t, err)
+}
+
+func TestFactoryReadsPasswordFromEnv(t *testing.T) {
+	path := GetTemporaryKeystoreFile()
+	defer os.RemoveAll(filepath.Dir(path))
+
+	store, err := NewFileKeystoreWithPassword(path, NewSecureString([]byte("password")))
+	require.NoError(t, err)
+	store.Store(keyValue, secretValue)
+	require.NoError(t, store.Save())
+
+	_, err = Factory(nil, path)
+	assert.Error(t, err)
+
+	os.Setenv(PasswordEnvVar, "password")
+	defer os.Unsetenv(PasswordEnvVar)
+
+	keystore, err := Factory(nil, path)
+	require.NoError(t, err)
+	resolved, err := ResolverWrap(keystore)(keyValue)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", resolved)
+}
+
+func TestRegisterBackendTwice(t *testing.T) {
+	assert.Error(t, RegisterBackend("file", newFileKeystoreFromConfig))
+}
+
+func TestEnvFileKeystore(t *testing.T) {
+	path := GetTemporaryKeystoreFile()
+	defer os.RemoveAll(filepath.Dir(path))
+
+	content := `# credentials
+ES_USER=elastic
+export ES_PWD="changeme\n"
+KIBANA_PWD='single quoted'
+
+EMPTY=
+`
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+
+	cfg := common.MustNewConfigFrom(map[string]interface{}{"type": "env_file", "path": path})
+	keystore, err := Factory(cfg, "")
+	require.NoError(t, err)
+
+	resolver := ResolverWrap(keystore)
+	for key, expected := range map[string]string{
+		"ES_USER":    "elastic",
+		"ES_PWD":     "changeme\n",
+		"KIBANA_PWD": "single quoted",
+		"EMPTY":      "",
+	} {
+		v, err := resolver(key)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, v, key)
+	}
+
+	_, err = resolver("donotexist")
+	assert.Equal(t, ucfg.ErrMissing, err)
+
+	assert.Equal(t, ErrReadOnly, keystore.Store("key", []byte("value")))
+}
+
+func TestEnvFileKeystoreInvalidLine(t *testing.T) {
+	path := GetTemporaryKeystoreFile()
+	defer os.RemoveAll(filepath.Dir(path))
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("NOVALUE\n"), 0600))
+	_, err := NewEnvFileKeystore(path)
+	assert.Error(t, err)
+}
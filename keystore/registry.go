@@ -0,0 +1,78 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package keystore
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/njcx/libbeat_v6/common"
+)
+
+// BackendFactory creates a new keystore from the `keystore` configuration section,
+// defaultPath is the location of the keystore in the beat data directory. Backends
+// encrypting the secrets locally use password as the encryption password.
+type BackendFactory func(cfg *common.Config, defaultPath string, password *SecureString) (Keystore, error)
+
+var registry = struct {
+	sync.RWMutex
+	backends map[string]BackendFactory
+}{
+	backends: map[string]BackendFactory{},
+}
+
+func init() {
+	RegisterBackend("file", newFileKeystoreFromConfig)
+	RegisterBackend("directory", newDirectoryKeystoreFromConfig)
+	RegisterBackend("env_file", newEnvFileKeystoreFromConfig)
+	RegisterBackend("vault", newVaultKeystoreFromConfig)
+}
+
+// RegisterBackend registers a new keystore backend selectable by the `keystore.type` setting.
+func RegisterBackend(name string, factory BackendFactory) error {
+	registry.Lock()
+	defer registry.Unlock()
+
+	if _, exists := registry.backends[name]; exists {
+		return fmt.Errorf("keystore backend '%v' already registered", name)
+	}
+	registry.backends[name] = factory
+	return nil
+}
+
+// FindBackend returns the factory of the keystore backend registered under name.
+func FindBackend(name string) (BackendFactory, error) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	factory, found := registry.backends[name]
+	if !found {
+		return nil, fmt.Errorf("unknown keystore type '%v', available types: %v", name, backendNames())
+	}
+	return factory, nil
+}
+
+func backendNames() []string {
+	names := make([]string, 0, len(registry.backends))
+	for name := range registry.backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
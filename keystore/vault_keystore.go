@@ -0,0 +1,494 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package keystore
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/transport/tlscommon"
+	"github.com/njcx/libbeat_v6/logp"
+)
+
+// vaultRetryInterval is the maximum time stale secrets are served after a failed refresh,
+// before trying to contact the server again.
+const vaultRetryInterval = 30 * time.Second
+
+type vaultConfig struct {
+	Address   string            `config:"address" validate:"required"`
+	Token     string            `config:"token"`
+	TokenFile string            `config:"token_file"`
+	Namespace string            `config:"namespace"`
+	Mount     string            `config:"mount"`
+	Path      string            `config:"path" validate:"required"`
+	Timeout   time.Duration     `config:"timeout" validate:"positive,nonzero"`
+	CacheTTL  time.Duration     `config:"cache.ttl" validate:"positive,nonzero"`
+	TLS       *tlscommon.Config `config:"ssl"`
+}
+
+var defaultVaultConfig = vaultConfig{
+	Mount:    "secret",
+	Timeout:  10 * time.Second,
+	CacheTTL: 5 * time.Minute,
+}
+
+func (c *vaultConfig) Validate() error {
+	if _, err := url.Parse(c.Address); err != nil {
+		return fmt.Errorf("invalid vault address '%s': %v", c.Address, err)
+	}
+	if c.Token != "" && c.TokenFile != "" {
+		return errors.New("only one of token and token_file can be configured")
+	}
+	return nil
+}
+
+// VaultKeystore reads and writes the secrets stored in a single secret of a HashiCorp Vault KV
+// version 2 secret engine. Each key of the secret data is a key of the keystore.
+//
+// Secrets are cached for `cache.ttl`, or for the duration of the lease if the server returns a
+// shorter one. When refreshing the secrets fails, the cached values are kept.
+type VaultKeystore struct {
+	sync.Mutex
+	config      vaultConfig
+	token       string
+	dataURL     string
+	metadataURL string
+	client      *http.Client
+	log         *logp.Logger
+
+	secrets map[string]string
+	exists  bool
+	version int
+	expires time.Time
+
+	updates map[string][]byte
+	deletes common.StringSet
+
+	now func() time.Time
+}
+
+type vaultSecret struct {
+	LeaseDuration int `json:"lease_duration"`
+	Data          struct {
+		Data     map[string]interface{} `json:"data"`
+		Metadata struct {
+			Version int `json:"version"`
+		} `json:"metadata"`
+	} `json:"data"`
+}
+
+func newVaultKeystoreFromConfig(cfg *common.Config, defaultPath string, _ *SecureString) (Keystore, error) {
+	config := defaultVaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, fmt.Errorf("could not read vault keystore configuration, err: %v", err)
+	}
+	return newVaultKeystore(config)
+}
+
+// newVaultKeystore creates a new keystore backed by the Vault server. The token is read from the
+// `token` or the `token_file` settings, or from the VAULT_TOKEN environment variable.
+func newVaultKeystore(config vaultConfig) (*VaultKeystore, error) {
+	u, err := url.Parse(config.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vault address '%s': %v", config.Address, err)
+	}
+
+	token := config.Token
+	if token == "" && config.TokenFile == "" {
+		token = os.Getenv("VAULT_TOKEN")
+		if token == "" {
+			return nil, errors.New("no vault token configured, set token, token_file or VAULT_TOKEN")
+		}
+	}
+
+	tlsConfig, err := tlscommon.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("fail to load the TLS config: %v", err)
+	}
+
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig.BuildModuleConfig(u.Hostname())
+	}
+
+	secretURL := func(endpoint string) string {
+		return fmt.Sprintf("%s/v1/%s/%s/%s",
+			strings.TrimRight(config.Address, "/"),
+			strings.Trim(config.Mount, "/"),
+			endpoint,
+			strings.Trim(config.Path, "/"))
+	}
+	dataURL := secretURL("data")
+
+	logp.Debug("keystore", "Loading vault keystore from %s", dataURL)
+	return &VaultKeystore{
+		config:      config,
+		token:       token,
+		dataURL:     dataURL,
+		metadataURL: secretURL("metadata"),
+		client:      &http.Client{Transport: transport, Timeout: config.Timeout},
+		log:         logp.NewLogger("keystore.vault"),
+		updates:     map[string][]byte{},
+		deletes:     common.StringSet{},
+		now:         time.Now,
+	}, nil
+}
+
+// Retrieve returns the secret for the key, fetching the secrets from the server when the cache
+// has expired.
+func (k *VaultKeystore) Retrieve(key string) (*SecureString, error) {
+	k.Lock()
+	defer k.Unlock()
+
+	if k.deletes.Has(key) {
+		return nil, ErrKeyDoesntExists
+	}
+	if value, ok := k.updates[key]; ok {
+		return NewSecureString(value), nil
+	}
+
+	if err := k.refresh(false); err != nil {
+		return nil, err
+	}
+
+	value, ok := k.secrets[key]
+	if !ok {
+		return nil, ErrKeyDoesntExists
+	}
+	return NewSecureString([]byte(value)), nil
+}
+
+// Store adds the key pair to the keystore, the secret is sent to the server on Save.
+func (k *VaultKeystore) Store(key string, value []byte) error {
+	k.Lock()
+	defer k.Unlock()
+
+	k.deletes.Del(key)
+	k.updates[key] = value
+	return nil
+}
+
+// Delete removes the key from the keystore, the change is sent to the server on Save.
+func (k *VaultKeystore) Delete(key string) error {
+	k.Lock()
+	defer k.Unlock()
+
+	delete(k.updates, key)
+	k.deletes.Add(key)
+	return nil
+}
+
+// List returns the keys of the secret.
+func (k *VaultKeystore) List() ([]string, error) {
+	k.Lock()
+	defer k.Unlock()
+
+	secrets, err := k.merged(false)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(secrets))
+	for key := range secrets {
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// GetConfig returns common.Config representation of the secret.
+func (k *VaultKeystore) GetConfig() (*common.Config, error) {
+	k.Lock()
+	defer k.Unlock()
+
+	secrets, err := k.merged(false)
+	if err != nil {
+		return nil, err
+	}
+
+	configHash := make(map[string]interface{}, len(secrets))
+	for key, value := range secrets {
+		configHash[key] = value
+	}
+	return common.NewConfigFrom(configHash)
+}
+
+// Create writes an empty secret to the server. An existing secret is only replaced if override
+// is true.
+func (k *VaultKeystore) Create(override bool) error {
+	k.Lock()
+	defer k.Unlock()
+
+	if err := k.refresh(true); err != nil {
+		return err
+	}
+	if k.exists && !override {
+		return ErrAlreadyExists
+	}
+
+	k.updates = map[string][]byte{}
+	k.deletes = common.StringSet{}
+	return k.write(map[string]string{})
+}
+
+// IsPersisted returns true if the secret exists on the server.
+func (k *VaultKeystore) IsPersisted() bool {
+	k.Lock()
+	defer k.Unlock()
+
+	if err := k.refresh(true); err != nil {
+		k.log.Errorf("Failed to read the keystore secret: %v", err)
+		return false
+	}
+	return k.exists
+}
+
+// Save sends the pending changes to the server. The write uses the check-and-set version of the
+// secret read before merging the changes, so concurrent modifications are not overwritten.
+func (k *VaultKeystore) Save() error {
+	k.Lock()
+	defer k.Unlock()
+
+	if len(k.updates) == 0 && len(k.deletes) == 0 {
+		return nil
+	}
+
+	secrets, err := k.merged(true)
+	if err != nil {
+		return err
+	}
+
+	if err := k.write(secrets); err != nil {
+		return err
+	}
+
+	k.updates = map[string][]byte{}
+	k.deletes = common.StringSet{}
+	return nil
+}
+
+// merged returns the secrets from the server with the pending changes applied.
+func (k *VaultKeystore) merged(force bool) (map[string]string, error) {
+	if err := k.refresh(force); err != nil {
+		return nil, err
+	}
+
+	secrets := make(map[string]string, len(k.secrets)+len(k.updates))
+	for key, value := range k.secrets {
+		if !k.deletes.Has(key) {
+			secrets[key] = value
+		}
+	}
+	for key, value := range k.updates {
+		secrets[key] = string(value)
+	}
+	return secrets, nil
+}
+
+// refresh fetches the secret from the server if the cached copy expired or if force is true.
+func (k *VaultKeystore) refresh(force bool) error {
+	now := k.now()
+	if !force && k.secrets != nil && now.Before(k.expires) {
+		return nil
+	}
+
+	secret, version, err := k.read()
+	if err != nil {
+		if force || k.secrets == nil {
+			return err
+		}
+
+		// Keep serving the cached secrets, but retry soon.
+		retry := vaultRetryInterval
+		if k.config.CacheTTL < retry {
+			retry = k.config.CacheTTL
+		}
+		k.log.Warnf("Failed to refresh the keystore secrets, using cached values for %v: %v", retry, err)
+		k.expires = now.Add(retry)
+		return nil
+	}
+
+	secrets := map[string]string{}
+	ttl := k.config.CacheTTL
+	exists := secret != nil
+	if exists {
+		for key, value := range secret.Data.Data {
+			secrets[key] = vaultValueString(value)
+		}
+
+		if lease := time.Duration(secret.LeaseDuration) * time.Second; lease > 0 && lease < ttl {
+			ttl = lease
+		}
+	}
+
+	k.secrets = secrets
+	k.exists = exists
+	k.version = version
+	k.expires = now.Add(ttl)
+	return nil
+}
+
+// read returns the latest version of the secret and its version number. The secret is nil if
+// it doesn't exist or if its latest version was deleted, the version is then read from the
+// metadata so the next write can still use it as check-and-set version.
+func (k *VaultKeystore) read() (*vaultSecret, int, error) {
+	status, body, err := k.request("GET", k.dataURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch {
+	case status == http.StatusNotFound:
+		version, err := k.currentVersion()
+		return nil, version, err
+	case status != http.StatusOK:
+		return nil, 0, fmt.Errorf("reading secret from %s failed with status %d: %s",
+			k.dataURL, status, vaultErrorMessage(body))
+	}
+
+	secret := &vaultSecret{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(secret); err != nil {
+		return nil, 0, errors.Wrap(err, "failed to decode the vault secret")
+	}
+	return secret, secret.Data.Metadata.Version, nil
+}
+
+// currentVersion returns the current version of the secret from its metadata, or 0 if the
+// secret has never been written.
+func (k *VaultKeystore) currentVersion() (int, error) {
+	status, body, err := k.request("GET", k.metadataURL, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case status == http.StatusNotFound:
+		return 0, nil
+	case status != http.StatusOK:
+		return 0, fmt.Errorf("reading secret metadata from %s failed with status %d: %s",
+			k.metadataURL, status, vaultErrorMessage(body))
+	}
+
+	var metadata struct {
+		Data struct {
+			CurrentVersion int `json:"current_version"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &metadata); err != nil {
+		return 0, errors.Wrap(err, "failed to decode the vault secret metadata")
+	}
+	return metadata.Data.CurrentVersion, nil
+}
+
+func (k *VaultKeystore) write(secrets map[string]string) error {
+	payload := map[string]interface{}{
+		"options": map[string]interface{}{"cas": k.version},
+		"data":    secrets,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	status, resp, err := k.request("POST", k.dataURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK && status != http.StatusNoContent {
+		return fmt.Errorf("writing secret to %s failed with status %d: %s",
+			k.dataURL, status, vaultErrorMessage(resp))
+	}
+
+	// Read the secret again on the next access to pick up the new version.
+	k.secrets = nil
+	return nil
+}
+
+func (k *VaultKeystore) request(method, reqURL string, body io.Reader) (int, []byte, error) {
+	token := k.token
+	if token == "" {
+		raw, err := ioutil.ReadFile(k.config.TokenFile)
+		if err != nil {
+			return 0, nil, fmt.Errorf("cannot read vault token file: %v", err)
+		}
+		token = strings.TrimSpace(string(raw))
+	}
+
+	req, err := http.NewRequest(method, reqURL, body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("fail to create the HTTP %s request: %v", method, err)
+	}
+	req.Header.Set("X-Vault-Token", token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if k.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", k.config.Namespace)
+	}
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("fail to execute the HTTP %s request: %v", method, err)
+	}
+	defer resp.Body.Close()
+
+	result, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("fail to read response: %v", err)
+	}
+	return resp.StatusCode, result, nil
+}
+
+func vaultValueString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(b)
+}
+
+func vaultErrorMessage(body []byte) string {
+	var resp struct {
+		Errors []string `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil || len(resp.Errors) == 0 {
+		return string(body)
+	}
+	return strings.Join(resp.Errors, ", ")
+}
@@ -0,0 +1,267 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package keystore
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// vaultStandIn emulates the KV version 2 API of a single Vault secret.
+type vaultStandIn struct {
+	sync.Mutex
+	token   string
+	lease   int
+	data    map[string]interface{}
+	version int
+	deleted bool
+	reads   int
+	fail    bool
+}
+
+func (v *vaultStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	v.Lock()
+	defer v.Unlock()
+
+	writeJSON := func(status int, body interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(body)
+	}
+
+	if r.Header.Get("X-Vault-Token") != v.token {
+		writeJSON(http.StatusForbidden, map[string]interface{}{"errors": []string{"permission denied"}})
+		return
+	}
+	if r.URL.Path == "/v1/secret/metadata/beats/test" && r.Method == "GET" {
+		if v.version == 0 {
+			writeJSON(http.StatusNotFound, map[string]interface{}{"errors": []string{}})
+			return
+		}
+		writeJSON(http.StatusOK, map[string]interface{}{
+			"data": map[string]interface{}{"current_version": v.version},
+		})
+		return
+	}
+	if r.URL.Path != "/v1/secret/data/beats/test" {
+		writeJSON(http.StatusNotFound, map[string]interface{}{"errors": []string{}})
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		v.reads++
+		if v.fail {
+			writeJSON(http.StatusServiceUnavailable, map[string]interface{}{"errors": []string{"sealed"}})
+			return
+		}
+		if v.data == nil || v.deleted {
+			writeJSON(http.StatusNotFound, map[string]interface{}{"errors": []string{}})
+			return
+		}
+		writeJSON(http.StatusOK, map[string]interface{}{
+			"lease_duration": v.lease,
+			"data": map[string]interface{}{
+				"data":     v.data,
+				"metadata": map[string]interface{}{"version": v.version},
+			},
+		})
+
+	case "POST":
+		var req struct {
+			Options struct {
+				CAS int `json:"cas"`
+			} `json:"options"`
+			Data map[string]interface{} `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(http.StatusBadRequest, map[string]interface{}{"errors": []string{err.Error()}})
+			return
+		}
+		if req.Options.CAS != v.version {
+			writeJSON(http.StatusBadRequest, map[string]interface{}{"errors": []string{"check-and-set parameter did not match the current version"}})
+			return
+		}
+		v.data = req.Data
+		v.deleted = false
+		v.version++
+		writeJSON(http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"version": v.version}})
+	}
+}
+
+func newTestVaultKeystore(t *testing.T, server *httptest.Server) *VaultKeystore {
+	config := defaultVaultConfig
+	config.Address = server.URL
+	config.Token = "test-token"
+	config.Path = "beats/test"
+	config.CacheTTL = time.Minute
+
+	keystore, err := newVaultKeystore(config)
+	require.NoError(t, err)
+	return keystore
+}
+
+func TestVaultKeystoreRetrieve(t *testing.T) {
+	vault := &vaultStandIn{
+		token:   "test-token",
+		data:    map[string]interface{}{keyValue: "secret", "port": 9200},
+		version: 1,
+	}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	keystore := newTestVaultKeystore(t, server)
+
+	resolver := ResolverWrap(keystore)
+	v, err := resolver(keyValue)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", v)
+
+	v, err = resolver("port")
+	require.NoError(t, err)
+	assert.Equal(t, "9200", v)
+
+	_, err = keystore.Retrieve("donotexist")
+	assert.Equal(t, ErrKeyDoesntExists, err)
+
+	keys, err := keystore.List()
+	require.NoError(t, err)
+	sort.Strings(keys)
+	assert.Equal(t, []string{keyValue, "port"}, keys)
+
+	// All the lookups are served from the cache.
+	assert.Equal(t, 1, vault.reads)
+}
+
+func TestVaultKeystoreCacheRefresh(t *testing.T) {
+	vault := &vaultStandIn{
+		token:   "test-token",
+		data:    map[string]interface{}{keyValue: "secret"},
+		version: 1,
+		lease:   10,
+	}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	now := time.Now()
+	keystore := newTestVaultKeystore(t, server)
+	keystore.now = func() time.Time { return now }
+
+	_, err := keystore.Retrieve(keyValue)
+	require.NoError(t, err)
+
+	// The lease is shorter than the cache TTL, the secrets are fetched again once it expires.
+	vault.Lock()
+	vault.data = map[string]interface{}{keyValue: "rotated"}
+	vault.Unlock()
+
+	now = now.Add(5 * time.Second)
+	s, err := keystore.Retrieve(keyValue)
+	require.NoError(t, err)
+	v, _ := s.Get()
+	assert.Equal(t, "secret", string(v))
+
+	now = now.Add(10 * time.Second)
+	s, err = keystore.Retrieve(keyValue)
+	require.NoError(t, err)
+	v, _ = s.Get()
+	assert.Equal(t, "rotated", string(v))
+	assert.Equal(t, 2, vault.reads)
+
+	// Cached values are kept when the server can't be reached.
+	vault.Lock()
+	vault.fail = true
+	vault.Unlock()
+
+	now = now.Add(time.Minute)
+	s, err = keystore.Retrieve(keyValue)
+	require.NoError(t, err)
+	v, _ = s.Get()
+	assert.Equal(t, "rotated", string(v))
+	assert.Equal(t, 3, vault.reads)
+}
+
+func TestVaultKeystoreCreateStoreAndDelete(t *testing.T) {
+	vault := &vaultStandIn{token: "test-token"}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	keystore := newTestVaultKeystore(t, server)
+	assert.False(t, keystore.IsPersisted())
+
+	require.NoError(t, keystore.Create(false))
+	assert.True(t, keystore.IsPersisted())
+	assert.Equal(t, ErrAlreadyExists, keystore.Create(false))
+
+	require.NoError(t, keystore.Store("a", []byte("1")))
+	require.NoError(t, keystore.Store("b", []byte("2")))
+	require.NoError(t, keystore.Save())
+	assert.Equal(t, map[string]interface{}{"a": "1", "b": "2"}, vault.data)
+
+	require.NoError(t, keystore.Delete("a"))
+	require.NoError(t, keystore.Save())
+	assert.Equal(t, map[string]interface{}{"b": "2"}, vault.data)
+	assert.Equal(t, 3, vault.version)
+
+	cfg, err := keystore.GetConfig()
+	require.NoError(t, err)
+	b, err := cfg.String("b", -1)
+	require.NoError(t, err)
+	assert.Equal(t, "2", b)
+}
+
+func TestVaultKeystoreDeletedVersion(t *testing.T) {
+	vault := &vaultStandIn{
+		token:   "test-token",
+		data:    map[string]interface{}{keyValue: "secret"},
+		version: 2,
+		deleted: true,
+	}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	keystore := newTestVaultKeystore(t, server)
+	assert.False(t, keystore.IsPersisted())
+
+	// The check-and-set version is the one of the deleted secret.
+	require.NoError(t, keystore.Store("a", []byte("1")))
+	require.NoError(t, keystore.Save())
+	assert.Equal(t, map[string]interface{}{"a": "1"}, vault.data)
+	assert.Equal(t, 3, vault.version)
+	assert.True(t, keystore.IsPersisted())
+}
+
+func TestVaultKeystoreErrors(t *testing.T) {
+	vault := &vaultStandIn{token: "other-token"}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	keystore := newTestVaultKeystore(t, server)
+	_, err := keystore.Retrieve(keyValue)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "permission denied")
+	}
+}
@@ -19,11 +19,15 @@ package kibana
 
 import (
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"regexp"
 
+	"github.com/elastic/go-ucfg"
+	"github.com/elastic/go-ucfg/yaml"
+
 	"github.com/njcx/libbeat_v6/common"
 )
 
@@ -31,6 +35,7 @@ type IndexPatternGenerator struct {
 	indexName      string
 	beatVersion    string
 	fieldsYaml     string
+	fieldsData     []byte
 	version        common.Version
 	targetDir      string
 	targetFilename string
@@ -54,15 +59,27 @@ func NewGenerator(indexName, beatName, fieldsYAMLFile, outputDir, beatVersion st
 	}, nil
 }
 
+// NewGeneratorFromBytes creates an instance of the Kibana Index Pattern
+// Generator for the given fields.yml content. The generated index pattern is
+// not written to a file, use Build to get it.
+func NewGeneratorFromBytes(indexName string, fieldsYAML []byte, beatVersion string, version common.Version) *IndexPatternGenerator {
+	return &IndexPatternGenerator{
+		indexName:   indexName,
+		fieldsData:  fieldsYAML,
+		beatVersion: beatVersion,
+		version:     version,
+	}
+}
+
 // Create the Index-Pattern for Kibana for 5.x and default.
 func (i *IndexPatternGenerator) Generate() (string, error) {
-	idxPattern, err := i.generate()
-	if err != nil {
-		return "", err
+	if i.targetDir == "" {
+		return "", errors.New("no output directory configured for the index pattern")
 	}
 
-	if i.version.Major >= 6 {
-		idxPattern = i.generateMinVersion6(idxPattern)
+	idxPattern, err := i.Build()
+	if err != nil {
+		return "", err
 	}
 
 	file := filepath.Join(i.targetDir, i.targetFilename)
@@ -71,6 +88,19 @@ func (i *IndexPatternGenerator) Generate() (string, error) {
 	return file, err
 }
 
+// Build creates the Index-Pattern for Kibana without writing it to a file.
+func (i *IndexPatternGenerator) Build() (common.MapStr, error) {
+	idxPattern, err := i.generate()
+	if err != nil {
+		return nil, err
+	}
+
+	if i.version.Major >= 6 {
+		idxPattern = i.generateMinVersion6(idxPattern)
+	}
+	return idxPattern, nil
+}
+
 func (i *IndexPatternGenerator) generate() (common.MapStr, error) {
 	indexPattern := common.MapStr{
 		"timeFieldName": "@timestamp",
@@ -107,7 +137,11 @@ func (i *IndexPatternGenerator) generateMinVersion6(attrs common.MapStr) common.
 }
 
 func (i *IndexPatternGenerator) addGeneral(indexPattern *common.MapStr) error {
-	kibanaEntries, err := loadKibanaEntriesFromYaml(i.fieldsYaml)
+	cfg, err := i.loadFieldsYaml()
+	if err != nil {
+		return err
+	}
+	kibanaEntries, err := loadKibanaEntries(cfg)
 	if err != nil {
 		return err
 	}
@@ -123,10 +157,18 @@ func (i *IndexPatternGenerator) addGeneral(indexPattern *common.MapStr) error {
 }
 
 func (i *IndexPatternGenerator) addFieldsSpecific(indexPattern *common.MapStr) error {
-	fields, err := common.LoadFieldsYaml(i.fieldsYaml)
+	cfg, err := i.loadFieldsYaml()
 	if err != nil {
 		return err
 	}
+	var keys []common.Field
+	if err := cfg.Unpack(&keys); err != nil {
+		return err
+	}
+	fields := common.Fields{}
+	for _, key := range keys {
+		fields = append(fields, key.Fields...)
+	}
 	transformer, err := newFieldsTransformer(&i.version, fields)
 	if err != nil {
 		return err
@@ -150,6 +192,13 @@ func (i *IndexPatternGenerator) addFieldsSpecific(indexPattern *common.MapStr) e
 	return nil
 }
 
+func (i *IndexPatternGenerator) loadFieldsYaml() (*ucfg.Config, error) {
+	if i.fieldsData != nil {
+		return yaml.NewConfig(i.fieldsData)
+	}
+	return yaml.NewConfigWithFile(i.fieldsYaml)
+}
+
 func clean(name string) string {
 	reg := regexp.MustCompile("[^a-zA-Z0-9_]+")
 	return reg.ReplaceAllString(name, "")
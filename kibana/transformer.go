@@ -18,8 +18,9 @@
 package kibana
 
 import (
+	"github.com/elastic/go-ucfg"
+
 	"github.com/njcx/libbeat_v6/common"
-	"github.com/elastic/go-ucfg/yaml"
 )
 
 type transformer struct {
@@ -50,13 +51,9 @@ func (t *transformer) transform() common.MapStr {
 	return transformed
 }
 
-func loadKibanaEntriesFromYaml(yamlFile string) ([]kibanaEntry, error) {
+func loadKibanaEntries(cfg *ucfg.Config) ([]kibanaEntry, error) {
 	entries := []kibanaEntry{}
-	cfg, err := yaml.NewConfigWithFile(yamlFile)
-	if err != nil {
-		return nil, err
-	}
-	err = cfg.Unpack(&entries)
+	err := cfg.Unpack(&entries)
 	if err != nil {
 		return nil, err
 	}
@@ -27,6 +27,8 @@ type Config struct {
 	Level     Level    `config:"level"`     // Logging level (error, warning, info, debug).
 	Selectors []string `config:"selectors"` // Selectors for debug level logging.
 
+	SelectorLevels map[string]Level `config:"selector_levels"` // Logging level per selector.
+
 	toObserver  bool
 	toIODiscard bool
 	ToStderr    bool `config:"to_stderr"`
@@ -36,6 +38,8 @@ type Config struct {
 
 	Files FileConfig `config:"files"`
 
+	ToPipeline PipelineConfig `config:"to_pipeline"` // Publish log entries as events.
+
 	addCaller   bool // Adds package and line number info to messages.
 	development bool // Controls how DPanic behaves.
 }
@@ -60,7 +64,16 @@ var defaultConfig = Config{
 		Permissions: 0600,
 		Interval:    0,
 	},
-	addCaller: true,
+	ToPipeline: defaultPipelineConfig,
+	addCaller:  true,
+}
+
+func (cfg Config) levelConfig() LevelConfig {
+	return LevelConfig{
+		Level:          cfg.Level,
+		Selectors:      cfg.Selectors,
+		SelectorLevels: cfg.SelectorLevels,
+	}
 }
 
 // DefaultConfig returns the default config options.
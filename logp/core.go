@@ -41,7 +41,7 @@ var (
 
 func init() {
 	storeLogger(&coreLogger{
-		selectors:    map[string]struct{}{},
+		levels:       newLevels(LevelConfig{Level: InfoLevel}),
 		rootLogger:   zap.NewNop(),
 		globalLogger: zap.NewNop(),
 		logger:       newLogger(zap.NewNop(), ""),
@@ -49,11 +49,12 @@ func init() {
 }
 
 type coreLogger struct {
-	selectors    map[string]struct{}    // Set of enabled debug selectors.
+	levels       *levels                // Logging level and selectors, can be changed at runtime.
 	rootLogger   *zap.Logger            // Root logger without any options configured.
 	globalLogger *zap.Logger            // Logger used by legacy global functions (e.g. logp.Info).
 	logger       *Logger                // Logger that is the basis for all logp.Loggers.
 	observedLogs *observer.ObservedLogs // Contains events generated while in observation mode (a testing mode).
+	pipeline     *pipelineSink          // Receives the entries to publish if to_pipeline is enabled.
 }
 
 // Configure configures the logp package.
@@ -67,7 +68,7 @@ func Configure(cfg Config) error {
 	// Build a single output (stderr has priority if more than one are enabled).
 	switch {
 	case cfg.toObserver:
-		sink, observedLogs = observer.New(cfg.Level.zapLevel())
+		sink, observedLogs = observer.New(zapcore.DebugLevel)
 	case cfg.toIODiscard:
 		sink, err = makeDiscardOutput(cfg)
 	case cfg.ToStderr:
@@ -85,34 +86,39 @@ func Configure(cfg Config) error {
 		return errors.Wrap(err, "failed to build log output")
 	}
 
-	// Enabled selectors when debug is enabled.
-	selectors := make(map[string]struct{}, len(cfg.Selectors))
+	// Tee the log entries with the publisher pipeline if enabled.
+	var pipeline *pipelineSink
+	if cfg.ToPipeline.Enabled {
+		var core zapcore.Core
+		pipeline, core = newPipelineCore(cfg)
+		sink = zapcore.NewTee(sink, core)
+	}
+
+	// Disable standard logging by default (this is sometimes used by libraries
+	// and we don't want their spam).
 	if cfg.Level.Enabled(DebugLevel) && len(cfg.Selectors) > 0 {
+		stdlog := false
 		for _, sel := range cfg.Selectors {
-			selectors[sel] = struct{}{}
-		}
-
-		// Default to all enabled if no selectors are specified.
-		if len(selectors) == 0 {
-			selectors["*"] = struct{}{}
+			stdlog = stdlog || sel == "stdlog"
 		}
-
-		if _, enabled := selectors["stdlog"]; !enabled {
-			// Disable standard logging by default (this is sometimes used by
-			// libraries and we don't want their spam).
+		if !stdlog {
 			golog.SetOutput(ioutil.Discard)
 		}
-
-		sink = selectiveWrapper(sink, selectors)
 	}
 
+	// The outputs are enabled for all levels, the levels of the selectors are
+	// checked by the selective core.
+	levels := newLevels(cfg.levelConfig())
+	sink = selectiveWrapper(sink, levels)
+
 	root := zap.New(sink, makeOptions(cfg)...)
 	storeLogger(&coreLogger{
-		selectors:    selectors,
+		levels:       levels,
 		rootLogger:   root,
 		globalLogger: root.WithOptions(zap.AddCallerSkip(1)),
 		logger:       newLogger(root, ""),
 		observedLogs: observedLogs,
+		pipeline:     pipeline,
 	})
 	return nil
 }
@@ -168,20 +174,20 @@ func makeOptions(cfg Config) []zap.Option {
 
 func makeStderrOutput(cfg Config) (zapcore.Core, error) {
 	stderr := zapcore.Lock(os.Stderr)
-	return zapcore.NewCore(buildEncoder(cfg), stderr, cfg.Level.zapLevel()), nil
+	return zapcore.NewCore(buildEncoder(cfg), stderr, zapcore.DebugLevel), nil
 }
 
 func makeDiscardOutput(cfg Config) (zapcore.Core, error) {
 	discard := zapcore.AddSync(ioutil.Discard)
-	return zapcore.NewCore(buildEncoder(cfg), discard, cfg.Level.zapLevel()), nil
+	return zapcore.NewCore(buildEncoder(cfg), discard, zapcore.DebugLevel), nil
 }
 
 func makeSyslogOutput(cfg Config) (zapcore.Core, error) {
-	return newSyslog(buildEncoder(cfg), cfg.Level.zapLevel())
+	return newSyslog(buildEncoder(cfg), zapcore.DebugLevel)
 }
 
 func makeEventLogOutput(cfg Config) (zapcore.Core, error) {
-	return newEventLog(cfg.Beat, buildEncoder(cfg), cfg.Level.zapLevel())
+	return newEventLog(cfg.Beat, buildEncoder(cfg), zapcore.DebugLevel)
 }
 
 func makeFileOutput(cfg Config) (zapcore.Core, error) {
@@ -202,7 +208,7 @@ func makeFileOutput(cfg Config) (zapcore.Core, error) {
 		return nil, errors.Wrap(err, "failed to create file rotator")
 	}
 
-	return zapcore.NewCore(buildEncoder(cfg), rotator, cfg.Level.zapLevel()), nil
+	return zapcore.NewCore(buildEncoder(cfg), rotator, zapcore.DebugLevel), nil
 }
 
 func globalLogger() *zap.Logger {
@@ -75,6 +75,88 @@ func TestLoggerSelectors(t *testing.T) {
 	assert.Len(t, logs, 1)
 }
 
+func TestLoggerSelectorLevels(t *testing.T) {
+	err := Configure(Config{
+		Level:      InfoLevel,
+		toObserver: true,
+		SelectorLevels: map[string]Level{
+			"verbose": DebugLevel,
+			"quiet":   ErrorLevel,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer DevelopmentSetup(ToObserverOutput())
+
+	NewLogger("verbose").Debug("is logged")
+	NewLogger("quiet").Warn("not logged")
+	NewLogger("quiet").Error("is logged")
+	NewLogger("other").Debug("not logged")
+	NewLogger("other").Info("is logged")
+	Debug("verbose", "is logged")
+
+	logs := ObserverLogs().TakeAll()
+	if assert.Len(t, logs, 4) {
+		assert.Equal(t, "verbose", logs[0].LoggerName)
+		assert.Equal(t, "quiet", logs[1].LoggerName)
+		assert.Equal(t, "other", logs[2].LoggerName)
+		assert.Equal(t, "verbose", logs[3].LoggerName)
+	}
+}
+
+func TestSetLevels(t *testing.T) {
+	err := Configure(Config{Level: InfoLevel, toObserver: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer DevelopmentSetup(ToObserverOutput())
+
+	// Loggers created before the change are affected too.
+	good := NewLogger("good")
+	bad := NewLogger("bad")
+
+	good.Debug("not logged")
+	assert.Len(t, ObserverLogs().TakeAll(), 0)
+	assert.False(t, HasSelector("good"))
+
+	SetLevels(LevelConfig{Level: DebugLevel, Selectors: []string{"good"}})
+	assert.Equal(t, LevelConfig{
+		Level:          DebugLevel,
+		Selectors:      []string{"good"},
+		SelectorLevels: map[string]Level{},
+	}, Levels())
+	assert.True(t, HasSelector("good"))
+
+	good.Debug("is logged")
+	bad.Debug("not logged")
+	assert.Len(t, ObserverLogs().TakeAll(), 1)
+
+	SetLevels(LevelConfig{Level: WarnLevel, SelectorLevels: map[string]Level{"bad": DebugLevel}})
+	good.Info("not logged")
+	bad.Debug("is logged")
+	assert.Len(t, ObserverLogs().TakeAll(), 1)
+	assert.False(t, HasSelector("good"))
+}
+
+func TestLevelUnpack(t *testing.T) {
+	for str, expected := range map[string]Level{
+		"debug":   DebugLevel,
+		"INFO":    InfoLevel,
+		"warn":    WarnLevel,
+		"warning": WarnLevel,
+		"error":   ErrorLevel,
+	} {
+		var level Level
+		if assert.NoError(t, level.UnmarshalText([]byte(str)), str) {
+			assert.Equal(t, expected, level, str)
+		}
+	}
+
+	var level Level
+	assert.Error(t, level.Unpack("verbose"))
+}
+
 func TestGlobalLoggerLevel(t *testing.T) {
 	if err := DevelopmentSetup(ToObserverOutput()); err != nil {
 		t.Fatal(err)
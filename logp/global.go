@@ -33,7 +33,7 @@ func MakeDebug(selector string) func(string, ...interface{}) {
 
 // HasSelector returns true if the given selector was explicitly set.
 func HasSelector(selector string) bool {
-	_, found := loadLogger().selectors[selector]
+	_, found := loadLogger().levels.load().selectors[selector]
 	return found
 }
 
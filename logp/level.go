@@ -71,6 +71,9 @@ func (l Level) Enabled(level Level) bool {
 // ucfg.StringUnpacker.
 func (l *Level) Unpack(str string) error {
 	str = strings.ToLower(str)
+	if str == "warn" {
+		str = levelStrings[WarnLevel]
+	}
 	for level, name := range levelStrings {
 		if name == str {
 			*l = level
@@ -81,6 +84,18 @@ func (l *Level) Unpack(str string) error {
 	return errors.Errorf("invalid level '%v'", str)
 }
 
+// MarshalText returns the name of the logging level. This implements
+// encoding.TextMarshaler.
+func (l Level) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText unmarshals a level name to a Level. This implements
+// encoding.TextUnmarshaler.
+func (l *Level) UnmarshalText(text []byte) error {
+	return l.Unpack(string(text))
+}
+
 func (l Level) zapLevel() zapcore.Level {
 	z, found := zapLevels[l]
 	if found {
@@ -0,0 +1,220 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package logship publishes the log entries of the beat as events through the
+// publisher pipeline, see the `logging.to_pipeline` settings.
+package logship
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/logp"
+	"github.com/njcx/libbeat_v6/monitoring"
+)
+
+var (
+	metrics   = monitoring.Default.NewRegistry("libbeat.logging.pipeline")
+	published = monitoring.NewInt(metrics, "published")
+	dropped   = monitoring.NewInt(metrics, "dropped")
+	excluded  = monitoring.NewInt(metrics, "excluded")
+)
+
+// excludedPackages lists the packages whose log entries are never published, as
+// publishing them could cause a feedback loop when the pipeline or the outputs log
+// while processing the log events.
+var excludedPackages = func() []string {
+	pkg := reflect.TypeOf(Shipper{}).PkgPath()
+	root := strings.TrimSuffix(pkg, "/logp/logship")
+	return []string{pkg + ".", root + "/outputs/", root + "/publisher/"}
+}()
+
+// Shipper is a zapcore.Core publishing log entries to the publisher pipeline. Entries
+// are queued and published by a single worker, entries are dropped if the queue is
+// full, so logging never blocks on the pipeline.
+type Shipper struct {
+	client   beat.Client
+	excluded map[string]struct{}
+	entries  chan beat.Event
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New connects to the pipeline and starts the worker publishing the log entries.
+func New(info beat.Info, pipeline beat.PipelineConnector, cfg logp.PipelineConfig) (*Shipper, error) {
+	clientCfg := beat.ClientConfig{
+		PublishMode: beat.DropIfFull,
+		Events:      eventer{},
+		Fields: common.MapStr{
+			"event": common.MapStr{"dataset": info.Beat + ".log"},
+		},
+	}
+	if cfg.Index != "" {
+		clientCfg.Meta = common.MapStr{"index": cfg.Index}
+	}
+
+	client, err := pipeline.ConnectWith(clientCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Shipper{
+		client:   client,
+		excluded: make(map[string]struct{}, len(cfg.ExcludeSelectors)),
+		entries:  make(chan beat.Event, cfg.BufferSize),
+		done:     make(chan struct{}),
+	}
+	for _, selector := range cfg.ExcludeSelectors {
+		s.excluded[selector] = struct{}{}
+	}
+
+	s.wg.Add(1)
+	go s.run()
+	return s, nil
+}
+
+func (s *Shipper) run() {
+	defer s.wg.Done()
+	for {
+		select {
+		case <-s.done:
+			return
+		case event := <-s.entries:
+			s.client.Publish(event)
+		}
+	}
+}
+
+// Close stops the worker and closes the pipeline client. Queued entries not
+// published yet are dropped.
+func (s *Shipper) Close() error {
+	close(s.done)
+	s.wg.Wait()
+	return s.client.Close()
+}
+
+// Enabled returns true for all levels, the level is checked by the logp package.
+func (s *Shipper) Enabled(zapcore.Level) bool {
+	return true
+}
+
+// With returns the Shipper, context fields are passed to Write by the logp package.
+func (s *Shipper) With([]zapcore.Field) zapcore.Core {
+	return s
+}
+
+// Check adds the Shipper to the CheckedEntry.
+func (s *Shipper) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return ce.AddCore(ent, s)
+}
+
+// Write converts the entry into an event and queues it for publishing.
+func (s *Shipper) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	if s.isExcluded(ent) {
+		excluded.Inc()
+		return nil
+	}
+
+	select {
+	case s.entries <- makeEvent(ent, fields):
+	default:
+		dropped.Inc()
+	}
+	return nil
+}
+
+// Sync is a noop, entries are published asynchronously.
+func (s *Shipper) Sync() error {
+	return nil
+}
+
+func (s *Shipper) isExcluded(ent zapcore.Entry) bool {
+	if _, found := s.excluded[ent.LoggerName]; found {
+		return true
+	}
+
+	if !ent.Caller.Defined {
+		return false
+	}
+	fn := runtime.FuncForPC(ent.Caller.PC)
+	if fn == nil {
+		return false
+	}
+	name := fn.Name()
+	for _, prefix := range excludedPackages {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func makeEvent(ent zapcore.Entry, fields []zapcore.Field) beat.Event {
+	log := common.MapStr{
+		"level": ent.Level.String(),
+	}
+	if ent.LoggerName != "" {
+		log["logger"] = ent.LoggerName
+	}
+	if ent.Caller.Defined {
+		origin := common.MapStr{
+			"file": common.MapStr{
+				"name": ent.Caller.TrimmedPath(),
+				"line": ent.Caller.Line,
+			},
+		}
+		if fn := runtime.FuncForPC(ent.Caller.PC); fn != nil {
+			origin["function"] = fn.Name()
+		}
+		log["origin"] = origin
+	}
+
+	if len(fields) > 0 {
+		enc := zapcore.NewMapObjectEncoder()
+		for _, f := range fields {
+			f.AddTo(enc)
+		}
+		log["fields"] = common.MapStr(enc.Fields)
+	}
+
+	event := beat.Event{
+		Timestamp: ent.Time,
+		Fields: common.MapStr{
+			"message": ent.Message,
+			"log":     log,
+		},
+	}
+	if ent.Stack != "" {
+		event.Fields["error"] = common.MapStr{"stack_trace": ent.Stack}
+	}
+	return event
+}
+
+type eventer struct{}
+
+func (eventer) Closing()                    {}
+func (eventer) Closed()                     {}
+func (eventer) Published()                  { published.Inc() }
+func (eventer) FilteredOut(beat.Event)      {}
+func (eventer) DroppedOnPublish(beat.Event) { dropped.Inc() }
@@ -0,0 +1,120 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logship
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/logp"
+	pubtest "github.com/njcx/libbeat_v6/publisher/testing"
+)
+
+func TestShipperPublishes(t *testing.T) {
+	client := pubtest.NewChanClient(10)
+	s, err := New(beat.Info{Beat: "testbeat"}, pubtest.PublisherWithClient(client), logp.PipelineConfig{
+		ExcludeSelectors: []string{"publisher"},
+		BufferSize:       10,
+	})
+	require.NoError(t, err)
+	defer s.Close()
+
+	ts := time.Now()
+	s.Write(zapcore.Entry{Level: zapcore.InfoLevel, Time: ts, LoggerName: "publisher", Message: "excluded"}, nil)
+	s.Write(zapcore.Entry{Level: zapcore.WarnLevel, Time: ts, LoggerName: "test", Message: "hello"}, nil)
+
+	select {
+	case event := <-client.Channel:
+		assert.Equal(t, ts, event.Timestamp)
+		assert.Equal(t, "hello", event.Fields["message"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for the log event")
+	}
+	assert.Len(t, client.Channel, 0)
+}
+
+func TestShipperDropsIfFull(t *testing.T) {
+	// No worker is started, so the queue is never drained.
+	s := &Shipper{entries: make(chan beat.Event, 1)}
+	before := dropped.Get()
+
+	s.Write(zapcore.Entry{Message: "queued"}, nil)
+	s.Write(zapcore.Entry{Message: "dropped"}, nil)
+
+	assert.Len(t, s.entries, 1)
+	assert.Equal(t, before+1, dropped.Get())
+}
+
+func TestIsExcluded(t *testing.T) {
+	s := &Shipper{excluded: map[string]struct{}{"monitoring": {}}}
+
+	assert.True(t, s.isExcluded(zapcore.Entry{LoggerName: "monitoring"}))
+	assert.False(t, s.isExcluded(zapcore.Entry{LoggerName: "test"}))
+
+	// Entries logged from this package are never published.
+	caller := zapcore.NewEntryCaller(runtime.Caller(0))
+	assert.True(t, s.isExcluded(zapcore.Entry{LoggerName: "test", Caller: caller}))
+}
+
+func TestMakeEvent(t *testing.T) {
+	ts := time.Now()
+	caller := zapcore.NewEntryCaller(runtime.Caller(0))
+	event := makeEvent(zapcore.Entry{
+		Level:      zapcore.ErrorLevel,
+		Time:       ts,
+		LoggerName: "test",
+		Message:    "failed",
+		Caller:     caller,
+		Stack:      "stack",
+	}, []zapcore.Field{
+		zap.String("key", "value"),
+		zap.Int("count", 2),
+		zap.Error(errors.New("oops")),
+	})
+
+	assert.Equal(t, ts, event.Timestamp)
+	assert.Equal(t, common.MapStr{
+		"message": "failed",
+		"log": common.MapStr{
+			"level":  "error",
+			"logger": "test",
+			"origin": common.MapStr{
+				"file": common.MapStr{
+					"name": caller.TrimmedPath(),
+					"line": caller.Line,
+				},
+				"function": "github.com/njcx/libbeat_v6/logp/logship.TestMakeEvent",
+			},
+			"fields": common.MapStr{
+				"key":   "value",
+				"count": int64(2),
+				"error": "oops",
+			},
+		},
+		"error": common.MapStr{"stack_trace": "stack"},
+	}, event.Fields)
+}
@@ -0,0 +1,154 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logp
+
+import (
+	"sync"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// PipelineConfig contains the options for shipping the beat's own logs through the
+// publisher pipeline.
+type PipelineConfig struct {
+	Enabled          bool     `config:"enabled"`
+	Level            Level    `config:"level"`             // Minimum level of the published entries.
+	ExcludeSelectors []string `config:"exclude_selectors"` // Selectors never published.
+	Index            string   `config:"index"`             // Optional index the events are written to.
+	BufferSize       int      `config:"buffer_size" validate:"min=1"`
+}
+
+var defaultPipelineConfig = PipelineConfig{
+	Level:            InfoLevel,
+	ExcludeSelectors: []string{"publisher", "monitoring"},
+	BufferSize:       1024,
+}
+
+// pipelineSink holds the core publishing the log entries. Logging is configured
+// before the publisher pipeline is available, so entries are buffered until a core
+// is attached using SetPipelineCore.
+type pipelineSink struct {
+	config PipelineConfig
+
+	mu       sync.Mutex
+	core     zapcore.Core
+	detached bool
+	pending  []pendingEntry
+}
+
+type pendingEntry struct {
+	entry  zapcore.Entry
+	fields []zapcore.Field
+}
+
+// pipelineCore is the zapcore.Core teed with the configured log output when
+// `to_pipeline` is enabled. It forwards the entries to the pipelineSink.
+type pipelineCore struct {
+	zapcore.LevelEnabler
+	fields []zapcore.Field
+	sink   *pipelineSink
+}
+
+func newPipelineCore(cfg Config) (*pipelineSink, zapcore.Core) {
+	// The logging level is checked by the selective core wrapping the pipeline core.
+	sink := &pipelineSink{config: cfg.ToPipeline}
+	return sink, &pipelineCore{LevelEnabler: cfg.ToPipeline.Level.zapLevel(), sink: sink}
+}
+
+// PipelineLogging returns the `to_pipeline` settings, and if logging to the publisher
+// pipeline is enabled.
+func PipelineLogging() (PipelineConfig, bool) {
+	sink := loadLogger().pipeline
+	if sink == nil {
+		return PipelineConfig{}, false
+	}
+	return sink.config, true
+}
+
+// SetPipelineCore attaches the core publishing the log entries to the pipeline, the
+// entries logged so far are written to the core. Passing nil detaches the core, all
+// entries logged afterwards are dropped. SetPipelineCore has no effect if `to_pipeline`
+// is not enabled.
+func SetPipelineCore(core zapcore.Core) {
+	sink := loadLogger().pipeline
+	if sink == nil {
+		return
+	}
+
+	sink.mu.Lock()
+	pending := sink.pending
+	sink.pending = nil
+	sink.core = core
+	sink.detached = core == nil
+	sink.mu.Unlock()
+
+	if core == nil {
+		return
+	}
+	for _, p := range pending {
+		core.Write(p.entry, p.fields)
+	}
+}
+
+func (s *pipelineSink) write(ent zapcore.Entry, fields []zapcore.Field) error {
+	s.mu.Lock()
+	core := s.core
+	if core == nil {
+		if !s.detached && len(s.pending) < s.config.BufferSize {
+			s.pending = append(s.pending, pendingEntry{entry: ent, fields: fields})
+		}
+		s.mu.Unlock()
+		return nil
+	}
+	s.mu.Unlock()
+
+	return core.Write(ent, fields)
+}
+
+// With adds structured context to the Core.
+func (c *pipelineCore) With(fields []zapcore.Field) zapcore.Core {
+	return &pipelineCore{
+		LevelEnabler: c.LevelEnabler,
+		fields:       append(c.fields[:len(c.fields):len(c.fields)], fields...),
+		sink:         c.sink,
+	}
+}
+
+// Check adds the core to the CheckedEntry if the entry level is enabled.
+func (c *pipelineCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+// Write forwards the Entry and the context and log site Fields to the pipeline sink.
+func (c *pipelineCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	all := fields
+	if len(c.fields) > 0 {
+		all = make([]zapcore.Field, 0, len(c.fields)+len(fields))
+		all = append(all, c.fields...)
+		all = append(all, fields...)
+	}
+	return c.sink.write(ent, all)
+}
+
+// Sync is a noop, entries are published asynchronously.
+func (c *pipelineCore) Sync() error {
+	return nil
+}
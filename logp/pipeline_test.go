@@ -0,0 +1,87 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestPipelineCore(t *testing.T) {
+	err := Configure(Config{
+		Level:      DebugLevel,
+		toObserver: true,
+		ToPipeline: PipelineConfig{Enabled: true, Level: WarnLevel, BufferSize: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer DevelopmentSetup(ToObserverOutput())
+
+	cfg, enabled := PipelineLogging()
+	assert.True(t, enabled)
+	assert.Equal(t, WarnLevel, cfg.Level)
+
+	log := NewLogger("test")
+
+	// Entries are buffered until the pipeline core is attached.
+	log.Warn("first")
+	log.Warn("second")
+	log.Warn("dropped, buffer is full")
+
+	core, published := observer.New(zapcore.DebugLevel)
+	SetPipelineCore(core)
+	logs := published.TakeAll()
+	if assert.Len(t, logs, 2) {
+		assert.Equal(t, "first", logs[0].Message)
+		assert.Equal(t, "second", logs[1].Message)
+	}
+
+	// The level of the pipeline applies, the other outputs are not affected.
+	log.Info("not published")
+	log.With("x", 1).Errorw("published", "y", 2)
+	logs = published.TakeAll()
+	if assert.Len(t, logs, 1) {
+		assert.Equal(t, "published", logs[0].Message)
+		assert.Equal(t, "test", logs[0].LoggerName)
+		assert.Equal(t, map[string]interface{}{"x": int64(1), "y": int64(2)}, logs[0].ContextMap())
+	}
+	assert.Len(t, ObserverLogs().TakeAll(), 5)
+
+	SetPipelineCore(nil)
+	log.Error("not published after detach")
+	assert.Len(t, published.TakeAll(), 0)
+}
+
+func TestPipelineDisabled(t *testing.T) {
+	if err := DevelopmentSetup(ToObserverOutput()); err != nil {
+		t.Fatal(err)
+	}
+
+	_, enabled := PipelineLogging()
+	assert.False(t, enabled)
+
+	// Noop if not enabled.
+	core, published := observer.New(zapcore.DebugLevel)
+	SetPipelineCore(core)
+	Warn("not published")
+	assert.Len(t, published.TakeAll(), 0)
+}
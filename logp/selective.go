@@ -18,32 +18,136 @@
 package logp
 
 import (
+	"sort"
+	"sync/atomic"
+
 	"go.uber.org/zap/zapcore"
 )
 
-type selectiveCore struct {
-	allSelectors bool
-	selectors    map[string]struct{}
-	core         zapcore.Core
+// LevelConfig contains the logging level and the selectors. They can be changed
+// at runtime using SetLevels.
+type LevelConfig struct {
+	Level          Level            `json:"level"`           // Logging level of all selectors without a level.
+	Selectors      []string         `json:"selectors"`       // Selectors for debug level logging.
+	SelectorLevels map[string]Level `json:"selector_levels"` // Logging level per selector.
 }
 
-func selectiveWrapper(core zapcore.Core, selectors map[string]struct{}) zapcore.Core {
-	if len(selectors) == 0 {
-		return core
+// levels holds the current levelState. The state is replaced as a whole when the
+// levels are changed, so the cores never need to lock.
+type levels struct {
+	state atomic.Value // *levelState
+}
+
+type levelState struct {
+	config         LevelConfig
+	level          zapcore.Level
+	minLevel       zapcore.Level // Lowest level enabled by any selector.
+	allSelectors   bool
+	selectors      map[string]struct{} // Set of enabled debug selectors.
+	selectorLevels map[string]zapcore.Level
+}
+
+func newLevels(cfg LevelConfig) *levels {
+	l := &levels{}
+	l.store(cfg)
+	return l
+}
+
+func newLevelState(cfg LevelConfig) *levelState {
+	s := &levelState{
+		config: LevelConfig{
+			Level:          cfg.Level,
+			Selectors:      append([]string(nil), cfg.Selectors...),
+			SelectorLevels: make(map[string]Level, len(cfg.SelectorLevels)),
+		},
+		level:          cfg.Level.zapLevel(),
+		selectors:      map[string]struct{}{},
+		selectorLevels: make(map[string]zapcore.Level, len(cfg.SelectorLevels)),
+	}
+	sort.Strings(s.config.Selectors)
+
+	// Enable selectors when debug is enabled.
+	if cfg.Level.Enabled(DebugLevel) {
+		for _, sel := range cfg.Selectors {
+			s.selectors[sel] = struct{}{}
+		}
+	}
+	_, all := s.selectors["*"]
+	s.allSelectors = all || len(s.selectors) == 0
+
+	s.minLevel = s.level
+	for sel, level := range cfg.SelectorLevels {
+		s.config.SelectorLevels[sel] = level
+		s.selectorLevels[sel] = level.zapLevel()
+		if z := level.zapLevel(); z < s.minLevel {
+			s.minLevel = z
+		}
 	}
-	_, allSelectors := selectors["*"]
-	return &selectiveCore{selectors: selectors, core: core, allSelectors: allSelectors}
+	return s
+}
+
+func (l *levels) load() *levelState {
+	return l.state.Load().(*levelState)
+}
+
+func (l *levels) store(cfg LevelConfig) {
+	l.state.Store(newLevelState(cfg))
+}
+
+// enabled returns true if entries of the given selector and level are logged.
+func (s *levelState) enabled(selector string, level zapcore.Level) bool {
+	if selectorLevel, found := s.selectorLevels[selector]; found {
+		return level >= selectorLevel
+	}
+	if level < s.level {
+		return false
+	}
+	if level == zapcore.DebugLevel && !s.allSelectors {
+		_, enabled := s.selectors[selector]
+		return enabled
+	}
+	return true
+}
+
+// Levels returns the current logging level and selectors.
+func Levels() LevelConfig {
+	config := loadLogger().levels.load().config
+	config.Selectors = append([]string(nil), config.Selectors...)
+	selectorLevels := make(map[string]Level, len(config.SelectorLevels))
+	for sel, level := range config.SelectorLevels {
+		selectorLevels[sel] = level
+	}
+	config.SelectorLevels = selectorLevels
+	return config
+}
+
+// SetLevels changes the logging level and selectors of all loggers, including
+// the loggers already created.
+func SetLevels(cfg LevelConfig) {
+	loadLogger().levels.store(cfg)
+}
+
+// selectiveCore filters the entries by the level of their selector. It's the
+// only level check, the wrapped cores are enabled for all levels, so the levels
+// can be changed at runtime.
+type selectiveCore struct {
+	levels *levels
+	core   zapcore.Core
+}
+
+func selectiveWrapper(core zapcore.Core, levels *levels) zapcore.Core {
+	return &selectiveCore{levels: levels, core: core}
 }
 
 // Enabled returns whether a given logging level is enabled when logging a
 // message.
 func (c *selectiveCore) Enabled(level zapcore.Level) bool {
-	return c.core.Enabled(level)
+	return level >= c.levels.load().minLevel && c.core.Enabled(level)
 }
 
 // With adds structured context to the Core.
 func (c *selectiveCore) With(fields []zapcore.Field) zapcore.Core {
-	return selectiveWrapper(c.core.With(fields), c.selectors)
+	return selectiveWrapper(c.core.With(fields), c.levels)
 }
 
 // Check determines whether the supplied Entry should be logged (using the
@@ -53,17 +157,8 @@ func (c *selectiveCore) With(fields []zapcore.Field) zapcore.Core {
 //
 // Callers must use Check before calling Write.
 func (c *selectiveCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
-	if c.Enabled(ent.Level) {
-		if ent.Level == zapcore.DebugLevel {
-			if c.allSelectors {
-				return ce.AddCore(ent, c)
-			} else if _, enabled := c.selectors[ent.LoggerName]; enabled {
-				return ce.AddCore(ent, c)
-			}
-			return ce
-		}
-
-		return ce.AddCore(ent, c)
+	if c.levels.load().enabled(ent.LoggerName, ent.Level) {
+		return c.core.Check(ent, ce)
 	}
 	return ce
 }
@@ -28,41 +28,6 @@ import (
 	"github.com/njcx/libbeat_v6/monitoring/report"
 )
 
-// List of metrics that are gauges. This is used to identify metrics that should
-// not be reported as deltas. Instead we log the raw value if there was any
-// observable change during the interval.
-//
-// TODO: Replace this with a proper solution that uses the metric type from
-// where it is defined. See: https://github.com/elastic/beats/issues/5433
-var gauges = map[string]bool{
-	"libbeat.pipeline.events.active": true,
-	"libbeat.pipeline.clients":       true,
-	"libbeat.config.module.running":  true,
-	"registrar.states.current":       true,
-	"filebeat.harvester.running":     true,
-	"filebeat.harvester.open_files":  true,
-	"beat.memstats.memory_total":     true,
-	"beat.memstats.memory_alloc":     true,
-	"beat.memstats.gc_next":          true,
-	"beat.info.uptime.ms":            true,
-	"beat.cpu.user.ticks":            true,
-	"beat.cpu.user.time":             true,
-	"beat.cpu.system.ticks":          true,
-	"beat.cpu.system.time":           true,
-	"beat.cpu.total.value":           true,
-	"beat.cpu.total.ticks":           true,
-	"beat.cpu.total.time":            true,
-	"beat.handles.open":              true,
-	"beat.handles.limit.hard":        true,
-	"beat.handles.limit.soft":        true,
-	"system.load.1":                  true,
-	"system.load.5":                  true,
-	"system.load.15":                 true,
-	"system.load.norm.1":             true,
-	"system.load.norm.5":             true,
-	"system.load.norm.15":            true,
-}
-
 // TODO: Change this when gauges are refactored, too.
 var strConsts = map[string]bool{
 	"beat.info.ephemeral_id": true,
@@ -176,7 +141,7 @@ func makeDeltaSnapshot(prev, cur monitoring.FlatSnapshot) monitoring.FlatSnapsho
 	}
 
 	for k, i := range cur.Ints {
-		if _, found := gauges[k]; found {
+		if report.IsGauge(k) {
 			delta.Ints[k] = i
 		} else {
 			if p := prev.Ints[k]; p != i {
@@ -186,7 +151,7 @@ func makeDeltaSnapshot(prev, cur monitoring.FlatSnapshot) monitoring.FlatSnapsho
 	}
 
 	for k, f := range cur.Floats {
-		if _, found := gauges[k]; found {
+		if report.IsGauge(k) {
 			delta.Floats[k] = f
 		} else if p := prev.Floats[k]; p != f {
 			delta.Floats[k] = f - p
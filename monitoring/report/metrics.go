@@ -0,0 +1,58 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package report
+
+// List of metrics that are gauges. This is used by reporters to identify metrics
+// that must not be reported as deltas or counters, but with their raw value.
+//
+// TODO: Replace this with a proper solution that uses the metric type from
+// where it is defined. See: https://github.com/elastic/beats/issues/5433
+var gauges = map[string]bool{
+	"libbeat.pipeline.events.active": true,
+	"libbeat.pipeline.clients":       true,
+	"libbeat.config.module.running":  true,
+	"registrar.states.current":       true,
+	"filebeat.harvester.running":     true,
+	"filebeat.harvester.open_files":  true,
+	"beat.memstats.memory_total":     true,
+	"beat.memstats.memory_alloc":     true,
+	"beat.memstats.gc_next":          true,
+	"beat.info.uptime.ms":            true,
+	"beat.cpu.user.ticks":            true,
+	"beat.cpu.user.time":             true,
+	"beat.cpu.system.ticks":          true,
+	"beat.cpu.system.time":           true,
+	"beat.cpu.total.value":           true,
+	"beat.cpu.total.ticks":           true,
+	"beat.cpu.total.time":            true,
+	"beat.handles.open":              true,
+	"beat.handles.limit.hard":        true,
+	"beat.handles.limit.soft":        true,
+	"system.load.1":                  true,
+	"system.load.5":                  true,
+	"system.load.15":                 true,
+	"system.load.norm.1":             true,
+	"system.load.norm.5":             true,
+	"system.load.norm.15":            true,
+}
+
+// IsGauge returns true if the metric with the given flattened name is a gauge. All other
+// numeric metrics are monotonic counters.
+func IsGauge(name string) bool {
+	return gauges[name]
+}
@@ -0,0 +1,69 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/njcx/libbeat_v6/common/transport/tlscommon"
+)
+
+type config struct {
+	Endpoint           string            `config:"endpoint" validate:"required"`
+	Path               string            `config:"path"`
+	Headers            map[string]string `config:"headers"`
+	ProxyURL           string            `config:"proxy_url"`
+	TLS                *tlscommon.Config `config:"ssl"`
+	CompressionLevel   int               `config:"compression_level" validate:"min=0, max=9"`
+	Timeout            time.Duration     `config:"timeout" validate:"positive,nonzero"`
+	MetricsPeriod      time.Duration     `config:"metrics.period" validate:"positive,nonzero"`
+	StatePeriod        time.Duration     `config:"state.period" validate:"positive,nonzero"`
+	MaxRetries         int               `config:"max_retries" validate:"min=0"`
+	Backoff            backoff           `config:"backoff"`
+	ResourceAttributes map[string]string `config:"resource.attributes"`
+}
+
+type backoff struct {
+	Init time.Duration
+	Max  time.Duration
+}
+
+var defaultConfig = config{
+	Path:          "/v1/metrics",
+	Timeout:       30 * time.Second,
+	MetricsPeriod: 10 * time.Second,
+	StatePeriod:   1 * time.Minute,
+	MaxRetries:    3,
+	Backoff: backoff{
+		Init: 1 * time.Second,
+		Max:  60 * time.Second,
+	},
+}
+
+func (c *config) Validate() error {
+	u, err := url.Parse(c.Endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint '%v': %v", c.Endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid endpoint '%v': scheme must be http or https", c.Endpoint)
+	}
+	return nil
+}
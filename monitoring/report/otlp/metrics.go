@@ -0,0 +1,200 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/monitoring"
+	"github.com/njcx/libbeat_v6/monitoring/report"
+)
+
+// Subset of the OTLP metrics data model, using the JSON encoding of the protobuf
+// messages as expected by the OTLP/HTTP JSON protocol.
+
+const aggregationTemporalityCumulative = 2
+
+const scopeName = "github.com/njcx/libbeat_v6/monitoring"
+
+type exportRequest struct {
+	ResourceMetrics []resourceMetrics `json:"resourceMetrics"`
+}
+
+type exportResponse struct {
+	PartialSuccess *struct {
+		RejectedDataPoints string `json:"rejectedDataPoints"`
+		ErrorMessage       string `json:"errorMessage"`
+	} `json:"partialSuccess"`
+}
+
+type resourceMetrics struct {
+	Resource     resource       `json:"resource"`
+	ScopeMetrics []scopeMetrics `json:"scopeMetrics"`
+}
+
+type resource struct {
+	Attributes []keyValue `json:"attributes"`
+}
+
+type scopeMetrics struct {
+	Scope   scope    `json:"scope"`
+	Metrics []metric `json:"metrics"`
+}
+
+type scope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type metric struct {
+	Name  string `json:"name"`
+	Gauge *gauge `json:"gauge,omitempty"`
+	Sum   *sum   `json:"sum,omitempty"`
+}
+
+type gauge struct {
+	DataPoints []dataPoint `json:"dataPoints"`
+}
+
+type sum struct {
+	DataPoints             []dataPoint `json:"dataPoints"`
+	AggregationTemporality int         `json:"aggregationTemporality"`
+	IsMonotonic            bool        `json:"isMonotonic"`
+}
+
+// dataPoint is a number data point. 64 bit integers are encoded as strings in the JSON
+// representation of protobuf messages.
+type dataPoint struct {
+	Attributes        []keyValue `json:"attributes,omitempty"`
+	StartTimeUnixNano string     `json:"startTimeUnixNano,omitempty"`
+	TimeUnixNano      string     `json:"timeUnixNano"`
+	AsInt             *string    `json:"asInt,omitempty"`
+	AsDouble          *float64   `json:"asDouble,omitempty"`
+}
+
+type keyValue struct {
+	Key   string   `json:"key"`
+	Value anyValue `json:"value"`
+}
+
+type anyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+// makeResource returns the resource describing the beat, using the OpenTelemetry semantic
+// conventions. Additional attributes overwrite the attributes derived from the beat info.
+func makeResource(info beat.Info, extra map[string]string) resource {
+	attributes := map[string]string{
+		"service.name":        info.Beat,
+		"service.version":     info.Version,
+		"service.instance.id": info.UUID.String(),
+		"host.name":           info.Hostname,
+		"beat.name":           info.Name,
+	}
+	for k, v := range extra {
+		attributes[k] = v
+	}
+	return resource{Attributes: makeAttributes(attributes)}
+}
+
+// makeMetrics converts a snapshot of a registry into OTLP metrics. Integer metrics are reported
+// as cumulative monotonic sums, unless they are known gauges. Float and boolean metrics are
+// reported as gauges. String metrics are added as attributes of a single gauge named infoName
+// with the value 1, string slices are not reported.
+func makeMetrics(snapshot monitoring.FlatSnapshot, infoName string, start, ts time.Time) []metric {
+	var (
+		metrics   []metric
+		startNano = unixNano(start)
+		tsNano    = unixNano(ts)
+	)
+
+	for name, v := range snapshot.Ints {
+		value := strconv.FormatInt(v, 10)
+		if report.IsGauge(name) {
+			metrics = append(metrics, metric{
+				Name:  name,
+				Gauge: &gauge{DataPoints: []dataPoint{{TimeUnixNano: tsNano, AsInt: &value}}},
+			})
+			continue
+		}
+
+		metrics = append(metrics, metric{
+			Name: name,
+			Sum: &sum{
+				DataPoints:             []dataPoint{{StartTimeUnixNano: startNano, TimeUnixNano: tsNano, AsInt: &value}},
+				AggregationTemporality: aggregationTemporalityCumulative,
+				IsMonotonic:            true,
+			},
+		})
+	}
+
+	for name, v := range snapshot.Floats {
+		value := v
+		metrics = append(metrics, metric{
+			Name:  name,
+			Gauge: &gauge{DataPoints: []dataPoint{{TimeUnixNano: tsNano, AsDouble: &value}}},
+		})
+	}
+
+	for name, v := range snapshot.Bools {
+		value := "0"
+		if v {
+			value = "1"
+		}
+		metrics = append(metrics, metric{
+			Name:  name,
+			Gauge: &gauge{DataPoints: []dataPoint{{TimeUnixNano: tsNano, AsInt: &value}}},
+		})
+	}
+
+	if len(snapshot.Strings) > 0 {
+		value := "1"
+		metrics = append(metrics, metric{
+			Name: infoName,
+			Gauge: &gauge{DataPoints: []dataPoint{{
+				Attributes:   makeAttributes(snapshot.Strings),
+				TimeUnixNano: tsNano,
+				AsInt:        &value,
+			}}},
+		})
+	}
+
+	sort.Slice(metrics, func(i, j int) bool { return metrics[i].Name < metrics[j].Name })
+	return metrics
+}
+
+func makeAttributes(m map[string]string) []keyValue {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attributes := make([]keyValue, len(keys))
+	for i, k := range keys {
+		attributes[i] = keyValue{Key: k, Value: anyValue{StringValue: m[k]}}
+	}
+	return attributes
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
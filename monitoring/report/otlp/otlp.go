@@ -0,0 +1,272 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/backoff"
+	"github.com/njcx/libbeat_v6/common/transport/tlscommon"
+	"github.com/njcx/libbeat_v6/logp"
+	"github.com/njcx/libbeat_v6/monitoring"
+	"github.com/njcx/libbeat_v6/monitoring/report"
+)
+
+const selector = "monitoring.otlp"
+
+type reporter struct {
+	wg     sync.WaitGroup
+	done   chan struct{}
+	logger *logp.Logger
+
+	config   config
+	url      string
+	client   *http.Client
+	resource resource
+	scope    scope
+	start    time.Time
+}
+
+func init() {
+	report.RegisterReporterFactory("otlp", makeReporter)
+}
+
+func makeReporter(beat beat.Info, settings report.Settings, cfg *common.Config) (report.Reporter, error) {
+	config := defaultConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	r, err := newReporter(beat, config)
+	if err != nil {
+		return nil, err
+	}
+
+	r.wg.Add(2)
+	go r.snapshotLoop("state", "beat.state.info", config.StatePeriod)
+	go r.snapshotLoop("stats", "beat.stats.info", config.MetricsPeriod)
+	return r, nil
+}
+
+func newReporter(beat beat.Info, config config) (*reporter, error) {
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := tlscommon.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if config.ProxyURL != "" {
+		proxyURL, err := parseProxyURL(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL '%v': %v", config.ProxyURL, err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig.BuildModuleConfig(endpoint.Hostname())
+	}
+
+	return &reporter{
+		done:     make(chan struct{}),
+		logger:   logp.NewLogger(selector),
+		config:   config,
+		url:      strings.TrimRight(config.Endpoint, "/") + "/" + strings.TrimLeft(config.Path, "/"),
+		client:   &http.Client{Transport: transport, Timeout: config.Timeout},
+		resource: makeResource(beat, config.ResourceAttributes),
+		scope:    scope{Name: scopeName, Version: beat.Version},
+		start:    time.Now(),
+	}, nil
+}
+
+func (r *reporter) Stop() {
+	close(r.done)
+	r.wg.Wait()
+}
+
+func (r *reporter) snapshotLoop(namespace, infoName string, period time.Duration) {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	log := r.logger
+	log.Infof("Start monitoring %s metrics snapshot loop with period %s.", namespace, period)
+	defer log.Infof("Stop monitoring %s metrics snapshot loop.", namespace)
+
+	retry := backoff.NewExpBackoff(r.done, r.config.Backoff.Init, r.config.Backoff.Max)
+	for {
+		var ts time.Time
+
+		select {
+		case <-r.done:
+			return
+		case ts = <-ticker.C:
+		}
+
+		registry := monitoring.GetNamespace(namespace).GetRegistry()
+		snapshot := monitoring.CollectFlatSnapshot(registry, monitoring.Full, false)
+		metrics := makeMetrics(snapshot, infoName, r.start, ts)
+		if len(metrics) == 0 {
+			log.Debugf("Empty %s snapshot.", namespace)
+			continue
+		}
+
+		if err := r.export(retry, metrics); err != nil {
+			log.Errorf("Failed to export %s metrics: %v", namespace, err)
+		}
+	}
+}
+
+// export sends the metrics to the collector. Requests failing because of network errors or
+// because the collector is temporarily unavailable are retried up to max_retries times.
+func (r *reporter) export(b backoff.Backoff, metrics []metric) error {
+	body, err := r.encode(metrics)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 0; ; attempt++ {
+		retryable, err := r.send(body)
+		if err == nil {
+			b.Reset()
+			return nil
+		}
+
+		if !retryable || attempt >= r.config.MaxRetries {
+			return err
+		}
+
+		r.logger.Debugf("Export attempt %d failed, retrying: %v", attempt+1, err)
+		if !b.Wait() {
+			return err
+		}
+	}
+}
+
+func (r *reporter) encode(metrics []metric) ([]byte, error) {
+	req := exportRequest{
+		ResourceMetrics: []resourceMetrics{{
+			Resource: r.resource,
+			ScopeMetrics: []scopeMetrics{{
+				Scope:   r.scope,
+				Metrics: metrics,
+			}},
+		}},
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.config.CompressionLevel == 0 {
+		return body, nil
+	}
+
+	var buf bytes.Buffer
+	w, err := gzip.NewWriterLevel(&buf, r.config.CompressionLevel)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(body); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// send executes a single export request, and reports if the request can be retried on failure.
+func (r *reporter) send(body []byte) (bool, error) {
+	req, err := http.NewRequest("POST", r.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if r.config.CompressionLevel > 0 {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	for k, v := range r.config.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return true, err
+	}
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		r.checkPartialSuccess(respBody)
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode == http.StatusBadGateway,
+		resp.StatusCode == http.StatusServiceUnavailable,
+		resp.StatusCode == http.StatusGatewayTimeout:
+		return true, fmt.Errorf("collector returned %v", resp.Status)
+	default:
+		return false, fmt.Errorf("collector returned %v: %s", resp.Status, respBody)
+	}
+}
+
+func (r *reporter) checkPartialSuccess(body []byte) {
+	var resp exportResponse
+	if len(body) == 0 || json.Unmarshal(body, &resp) != nil || resp.PartialSuccess == nil {
+		return
+	}
+
+	rejected := resp.PartialSuccess.RejectedDataPoints
+	if resp.PartialSuccess.ErrorMessage != "" || (rejected != "" && rejected != "0") {
+		r.logger.Warnf("Collector rejected %v data points: %v", rejected, resp.PartialSuccess.ErrorMessage)
+	}
+}
+
+func parseProxyURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err == nil && strings.HasPrefix(u.Scheme, "http") {
+		return u, nil
+	}
+
+	// Proxy was bogus. Try prepending "http://" to it and
+	// see if that parses correctly.
+	return url.Parse("http://" + raw)
+}
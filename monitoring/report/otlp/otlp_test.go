@@ -0,0 +1,276 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/backoff"
+	"github.com/njcx/libbeat_v6/monitoring"
+	"github.com/njcx/libbeat_v6/monitoring/report"
+)
+
+var testInfo = beat.Info{
+	Beat:     "testbeat",
+	Version:  "6.8.0",
+	Name:     "test-host-beat",
+	Hostname: "test-host",
+	UUID:     uuid.Must(uuid.FromString("ae4e6c33-9ee4-4c6c-9b22-2b0a3dc1b3b1")),
+}
+
+// collector records the export requests received by a stand-in OTLP collector.
+type collector struct {
+	sync.Mutex
+	statuses []int
+	requests []exportRequest
+	headers  []http.Header
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.Lock()
+	defer c.Unlock()
+
+	var body []byte
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body, _ = ioutil.ReadAll(gz)
+	} else {
+		body, _ = ioutil.ReadAll(r.Body)
+	}
+
+	var req exportRequest
+	if r.URL.Path != "/v1/metrics" || json.Unmarshal(body, &req) != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	c.requests = append(c.requests, req)
+	c.headers = append(c.headers, r.Header)
+
+	status := http.StatusOK
+	if len(c.statuses) > 0 {
+		status, c.statuses = c.statuses[0], c.statuses[1:]
+	}
+	w.WriteHeader(status)
+	w.Write([]byte("{}"))
+}
+
+func (c *collector) count() int {
+	c.Lock()
+	defer c.Unlock()
+	return len(c.requests)
+}
+
+func newTestReporter(t *testing.T, url string, settings map[string]interface{}) *reporter {
+	cfg := common.MustNewConfigFrom(map[string]interface{}{"endpoint": url})
+	if settings != nil {
+		require.NoError(t, cfg.Merge(settings))
+	}
+
+	config := defaultConfig
+	require.NoError(t, cfg.Unpack(&config))
+
+	r, err := newReporter(testInfo, config)
+	require.NoError(t, err)
+	return r
+}
+
+func TestMakeMetrics(t *testing.T) {
+	snapshot := monitoring.MakeFlatSnapshot()
+	snapshot.Ints["libbeat.pipeline.events.total"] = 42
+	snapshot.Ints["libbeat.pipeline.events.active"] = 3
+	snapshot.Floats["system.load.1"] = 1.5
+	snapshot.Bools["output.enabled"] = true
+	snapshot.Strings["beat.info.ephemeral_id"] = "abc"
+
+	start := time.Unix(100, 0)
+	ts := time.Unix(200, 0)
+	metrics := makeMetrics(snapshot, "beat.stats.info", start, ts)
+	require.Len(t, metrics, 5)
+
+	byName := map[string]metric{}
+	for _, m := range metrics {
+		byName[m.Name] = m
+	}
+
+	total := byName["libbeat.pipeline.events.total"]
+	if assert.NotNil(t, total.Sum) {
+		assert.True(t, total.Sum.IsMonotonic)
+		assert.Equal(t, aggregationTemporalityCumulative, total.Sum.AggregationTemporality)
+		assert.Equal(t, "42", *total.Sum.DataPoints[0].AsInt)
+		assert.Equal(t, "100000000000", total.Sum.DataPoints[0].StartTimeUnixNano)
+		assert.Equal(t, "200000000000", total.Sum.DataPoints[0].TimeUnixNano)
+	}
+
+	active := byName["libbeat.pipeline.events.active"]
+	if assert.NotNil(t, active.Gauge) {
+		assert.Equal(t, "3", *active.Gauge.DataPoints[0].AsInt)
+	}
+
+	load := byName["system.load.1"]
+	if assert.NotNil(t, load.Gauge) {
+		assert.Equal(t, 1.5, *load.Gauge.DataPoints[0].AsDouble)
+	}
+
+	enabled := byName["output.enabled"]
+	if assert.NotNil(t, enabled.Gauge) {
+		assert.Equal(t, "1", *enabled.Gauge.DataPoints[0].AsInt)
+	}
+
+	info := byName["beat.stats.info"]
+	if assert.NotNil(t, info.Gauge) {
+		assert.Equal(t, []keyValue{{Key: "beat.info.ephemeral_id", Value: anyValue{StringValue: "abc"}}},
+			info.Gauge.DataPoints[0].Attributes)
+	}
+}
+
+func TestMakeResource(t *testing.T) {
+	res := makeResource(testInfo, map[string]string{
+		"deployment.environment": "test",
+		"host.name":              "override",
+	})
+
+	attributes := map[string]string{}
+	for _, kv := range res.Attributes {
+		attributes[kv.Key] = kv.Value.StringValue
+	}
+
+	assert.Equal(t, map[string]string{
+		"service.name":           "testbeat",
+		"service.version":        "6.8.0",
+		"service.instance.id":    "ae4e6c33-9ee4-4c6c-9b22-2b0a3dc1b3b1",
+		"host.name":              "override",
+		"beat.name":              "test-host-beat",
+		"deployment.environment": "test",
+	}, attributes)
+}
+
+func TestExportRetriesWithBackoff(t *testing.T) {
+	c := &collector{statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}}
+	server := httptest.NewServer(c)
+	defer server.Close()
+
+	r := newTestReporter(t, server.URL, map[string]interface{}{
+		"headers":           map[string]interface{}{"Authorization": "Bearer token"},
+		"compression_level": 5,
+		"backoff.init":      "1ms",
+		"backoff.max":       "2ms",
+	})
+	defer r.Stop()
+
+	snapshot := monitoring.MakeFlatSnapshot()
+	snapshot.Ints["libbeat.pipeline.events.total"] = 1
+	metrics := makeMetrics(snapshot, "beat.stats.info", r.start, time.Now())
+
+	b := backoff.NewExpBackoff(r.done, r.config.Backoff.Init, r.config.Backoff.Max)
+	require.NoError(t, r.export(b, metrics))
+
+	require.Equal(t, 3, c.count())
+	assert.Equal(t, "Bearer token", c.headers[2].Get("Authorization"))
+
+	req := c.requests[2]
+	require.Len(t, req.ResourceMetrics, 1)
+	assert.Equal(t, r.resource, req.ResourceMetrics[0].Resource)
+	assert.Equal(t, scopeName, req.ResourceMetrics[0].ScopeMetrics[0].Scope.Name)
+	assert.Equal(t, "libbeat.pipeline.events.total", req.ResourceMetrics[0].ScopeMetrics[0].Metrics[0].Name)
+}
+
+func TestExportGivesUp(t *testing.T) {
+	c := &collector{statuses: []int{
+		http.StatusServiceUnavailable,
+		http.StatusServiceUnavailable,
+		http.StatusServiceUnavailable,
+	}}
+	server := httptest.NewServer(c)
+	defer server.Close()
+
+	r := newTestReporter(t, server.URL, map[string]interface{}{
+		"max_retries":  2,
+		"backoff.init": "1ms",
+		"backoff.max":  "1ms",
+	})
+	defer r.Stop()
+
+	b := backoff.NewExpBackoff(r.done, r.config.Backoff.Init, r.config.Backoff.Max)
+	assert.Error(t, r.export(b, []metric{{Name: "test", Gauge: &gauge{}}}))
+	assert.Equal(t, 3, c.count())
+}
+
+func TestExportDoesNotRetryRejectedRequests(t *testing.T) {
+	c := &collector{statuses: []int{http.StatusBadRequest}}
+	server := httptest.NewServer(c)
+	defer server.Close()
+
+	r := newTestReporter(t, server.URL, map[string]interface{}{"backoff.init": "1ms"})
+	defer r.Stop()
+
+	b := backoff.NewExpBackoff(r.done, r.config.Backoff.Init, r.config.Backoff.Max)
+	assert.Error(t, r.export(b, []metric{{Name: "test", Gauge: &gauge{}}}))
+	assert.Equal(t, 1, c.count())
+}
+
+func TestReporterSendsSnapshots(t *testing.T) {
+	c := &collector{}
+	server := httptest.NewServer(c)
+	defer server.Close()
+
+	monitoring.NewInt(monitoring.Default, "otlp_test.events").Add(5)
+
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"endpoint":       server.URL,
+		"metrics.period": "10ms",
+		"state.period":   "1h",
+	})
+	r, err := makeReporter(testInfo, report.Settings{}, cfg)
+	require.NoError(t, err)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for c.count() == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	r.Stop()
+
+	require.NotZero(t, c.count())
+
+	c.Lock()
+	defer c.Unlock()
+	found := false
+	for _, m := range c.requests[0].ResourceMetrics[0].ScopeMetrics[0].Metrics {
+		if m.Name == "otlp_test.events" {
+			found = true
+			assert.Equal(t, "5", *m.Sum.DataPoints[0].AsInt)
+		}
+	}
+	assert.True(t, found)
+}
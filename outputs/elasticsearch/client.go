@@ -30,6 +30,7 @@ import (
 
 	"github.com/njcx/libbeat_v6/beat"
 	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/ilm"
 	"github.com/njcx/libbeat_v6/logp"
 	"github.com/njcx/libbeat_v6/outputs"
 	"github.com/njcx/libbeat_v6/outputs/outil"
@@ -47,6 +48,7 @@ type Client struct {
 	pipeline *outil.Selector
 	params   map[string]string
 	timeout  time.Duration
+	aliases  *ilm.AliasManager // Bootstraps the ILM alias of each index, if the index is dynamic.
 
 	// buffered bulk requests
 	bulkRequ *bulkRequest
@@ -75,6 +77,7 @@ type ClientSettings struct {
 	Timeout            time.Duration
 	CompressionLevel   int
 	Observer           outputs.Observer
+	Aliases            *ilm.AliasManager
 }
 
 type connectCallback func(client *Client) error
@@ -127,6 +130,7 @@ var (
 	errUnexpectedEmptyObject = errors.New("empty object")
 	errExpectedObjectEnd     = errors.New("expected end of object")
 	errTempBulkFailure       = errors.New("temporary bulk send failure")
+	errAliasBootstrap        = errors.New("failed to bootstrap ILM aliases")
 )
 
 const (
@@ -215,6 +219,7 @@ func NewClient(
 		pipeline:  pipeline,
 		params:    params,
 		timeout:   s.Timeout,
+		aliases:   s.Aliases,
 
 		bulkRequ: bulkRequ,
 
@@ -271,6 +276,7 @@ func (client *Client) Clone() *Client {
 			Headers:          client.Headers,
 			Timeout:          client.http.Timeout,
 			CompressionLevel: client.compressionLevel,
+			Aliases:          client.aliases,
 		},
 		nil, // XXX: do not pass connection callback?
 	)
@@ -316,13 +322,20 @@ func (client *Client) publishEvents(
 		eventType = defaultEventType
 	}
 
+	// events whose ILM alias can't be bootstrapped are retried
 	origCount := len(data)
-	data = bulkEncodePublishRequest(body, client.index, client.pipeline, eventType, data)
+	data, retry := bulkEncodePublishRequest(body, client.index, client.pipeline, eventType, client.aliasEnsurer(), data)
 	newCount := len(data)
-	if st != nil && origCount > newCount {
-		st.Dropped(origCount - newCount)
+	if st != nil && origCount > newCount+len(retry) {
+		st.Dropped(origCount - newCount - len(retry))
+	}
+	if st != nil && len(retry) > 0 {
+		st.Failed(len(retry))
 	}
 	if newCount == 0 {
+		if len(retry) > 0 {
+			return retry, errAliasBootstrap
+		}
 		return nil, nil
 	}
 
@@ -331,7 +344,7 @@ func (client *Client) publishEvents(
 	status, result, sendErr := client.sendBulkRequest(requ)
 	if sendErr != nil {
 		logp.Err("Failed to perform any bulk index operations: %s", sendErr)
-		return data, sendErr
+		return append(data, retry...), sendErr
 	}
 
 	debugf("PublishEvents: %d events have been published to elasticsearch in %v.",
@@ -361,25 +374,57 @@ func (client *Client) publishEvents(
 		st.Duplicate(duplicates)
 	}
 
-	if failed > 0 {
+	if failed > 0 || len(retry) > 0 {
 		if sendErr == nil {
 			sendErr = errTempBulkFailure
+			if failed == 0 {
+				sendErr = errAliasBootstrap
+			}
 		}
-		return failedEvents, sendErr
+		return append(failedEvents, retry...), sendErr
 	}
 	return nil, nil
 }
 
+// aliasEnsurer returns a function bootstrapping the ILM alias of an index,
+// calling the alias manager once per index of a batch. It returns nil if no
+// aliases need to be bootstrapped.
+func (client *Client) aliasEnsurer() func(index string) error {
+	if client.aliases == nil {
+		return nil
+	}
+
+	results := map[string]error{}
+	return func(index string) error {
+		if index == "" {
+			return nil
+		}
+
+		err, done := results[index]
+		if !done {
+			err = client.aliases.Ensure(client, index)
+			if err != nil {
+				logp.Err("Failed to bootstrap ILM alias %s: %s", index, err)
+			}
+			results[index] = err
+		}
+		return err
+	}
+}
+
 // fillBulkRequest encodes all bulk requests and returns slice of events
-// successfully added to bulk request.
+// successfully added to bulk request. If ensureAlias is set, it is called with
+// the index of every event. Events whose alias can't be bootstrapped are not
+// encoded, but returned as retry.
 func bulkEncodePublishRequest(
 	body bulkWriter,
 	index outil.Selector,
 	pipeline *outil.Selector,
 	eventType string,
+	ensureAlias func(index string) error,
 	data []publisher.Event,
-) []publisher.Event {
-	okEvents := data[:0]
+) (okEvents, retry []publisher.Event) {
+	okEvents = data[:0]
 	for i := range data {
 		event := &data[i].Content
 		meta, err := createEventBulkMeta(index, pipeline, eventType, event)
@@ -387,6 +432,12 @@ func bulkEncodePublishRequest(
 			logp.Err("Failed to encode event meta data: %s", err)
 			continue
 		}
+		if ensureAlias != nil {
+			if err := ensureAlias(bulkMetaIndex(meta)); err != nil {
+				retry = append(retry, data[i])
+				continue
+			}
+		}
 		if err := body.Add(meta, event); err != nil {
 			logp.Err("Failed to encode event: %s", err)
 			logp.Debug("elasticsearch", "Failed event: %v", event)
@@ -439,6 +490,18 @@ func createEventBulkMeta(
 	return bulkIndexAction{meta}, nil
 }
 
+// bulkMetaIndex returns the index of a bulk action created by
+// createEventBulkMeta.
+func bulkMetaIndex(meta interface{}) string {
+	switch m := meta.(type) {
+	case bulkIndexAction:
+		return m.Index.Index
+	case bulkCreateAction:
+		return m.Create.Index
+	}
+	return ""
+}
+
 func getPipeline(event *beat.Event, pipelineSel *outil.Selector) (string, error) {
 	if event.Meta != nil {
 		if pipeline, exists := event.Meta["pipeline"]; exists {
@@ -21,17 +21,21 @@ package elasticsearch
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/njcx/libbeat_v6/beat"
 	"github.com/njcx/libbeat_v6/common"
 	"github.com/njcx/libbeat_v6/common/fmtstr"
+	"github.com/njcx/libbeat_v6/ilm"
 	"github.com/njcx/libbeat_v6/logp"
 	"github.com/njcx/libbeat_v6/outputs/outest"
 	"github.com/njcx/libbeat_v6/outputs/outil"
@@ -334,6 +338,64 @@ func TestClientWithHeaders(t *testing.T) {
 	assert.Equal(t, 2, requestCount)
 }
 
+func TestClientPublishAliasFailure(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []string
+		bulk     string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+
+		switch {
+		case r.URL.Path == "/_template/db-ilm":
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"error": "failed"}`)
+		case r.Method == "HEAD":
+			w.WriteHeader(http.StatusNotFound)
+		case r.URL.Path == "/_bulk":
+			bulk = string(body)
+			fmt.Fprint(w, `{"items": [{"index": {"status": 201}}, {"index": {"status": 201}}]}`)
+		default:
+			fmt.Fprint(w, `{}`)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ClientSettings{
+		URL:     ts.URL,
+		Index:   outil.MakeSelector(outil.FmtSelectorExpr(fmtstr.MustCompileEvent("%{[service]}"), "")),
+		Aliases: ilm.NewAliasManager(ilm.DefaultConfig),
+	}, nil)
+	require.NoError(t, err)
+
+	web := beat.Event{Timestamp: time.Now(), Fields: common.MapStr{"service": "web"}}
+	db := beat.Event{Timestamp: time.Now(), Fields: common.MapStr{"service": "db"}}
+	batch := outest.NewBatch(web, db, web, db)
+
+	// events of the failed alias are retried, the others are published
+	err = client.Publish(batch)
+	assert.Error(t, err)
+	require.Len(t, batch.Signals, 1)
+	assert.Equal(t, outest.BatchRetryEvents, batch.Signals[0].Tag)
+	assert.Equal(t, []publisher.Event{{Content: db}, {Content: db}}, batch.Signals[0].Events)
+	assert.Equal(t, 2, strings.Count(bulk, `"_index":"web"`))
+	assert.NotContains(t, bulk, `"_index":"db"`)
+
+	// every alias is bootstrapped once per batch
+	assert.Equal(t, []string{
+		"PUT /_template/web-ilm",
+		"HEAD /_alias/web",
+		"PUT /%3Cweb-%7Bnow%2Fd%7D-000001%3E",
+		"PUT /_template/db-ilm",
+		"POST /_bulk",
+	}, requests)
+}
+
 func TestAddToURL(t *testing.T) {
 	type Test struct {
 		url      string
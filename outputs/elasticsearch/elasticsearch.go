@@ -27,6 +27,7 @@ import (
 	"github.com/njcx/libbeat_v6/beat"
 	"github.com/njcx/libbeat_v6/common"
 	"github.com/njcx/libbeat_v6/common/transport/tlscommon"
+	"github.com/njcx/libbeat_v6/ilm"
 	"github.com/njcx/libbeat_v6/logp"
 	"github.com/njcx/libbeat_v6/outputs"
 	"github.com/njcx/libbeat_v6/outputs/outil"
@@ -137,7 +138,7 @@ func makeES(
 	beat beat.Info,
 	observer outputs.Observer,
 	cfg *common.Config,
-) (outputs.Group, error) {
+) (grp outputs.Group, err error) {
 	if !cfg.HasField("bulk_max_size") {
 		cfg.SetInt("bulk_max_size", -1, defaultBulkSize)
 	}
@@ -166,6 +167,11 @@ func makeES(
 	if err != nil {
 		return outputs.Fail(err)
 	}
+	defer func() {
+		if err != nil {
+			index.Close()
+		}
+	}()
 
 	tlsConfig, err := tlscommon.LoadTLSConfig(config.TLS)
 	if err != nil {
@@ -181,12 +187,22 @@ func makeES(
 	if err != nil {
 		return outputs.Fail(err)
 	}
+	defer func() {
+		if err != nil {
+			pipelineSel.Close()
+		}
+	}()
 
 	var pipeline *outil.Selector
 	if !pipelineSel.IsEmpty() {
 		pipeline = &pipelineSel
 	}
 
+	aliases, err := buildAliasManager(beat, cfg, index)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
 	proxyURL, err := parseProxyURL(config.ProxyURL)
 	if err != nil {
 		return outputs.Fail(err)
@@ -223,6 +239,7 @@ func makeES(
 			CompressionLevel: config.CompressionLevel,
 			Observer:         observer,
 			EscapeHTML:       config.EscapeHTML,
+			Aliases:          aliases,
 		}, &connectCallbackRegistry)
 		if err != nil {
 			return outputs.Fail(err)
@@ -232,7 +249,37 @@ func makeES(
 		clients[i] = client
 	}
 
-	return outputs.SuccessNet(config.LoadBalance, config.BulkMaxSize, config.MaxRetries, clients)
+	grp, err = outputs.SuccessNet(config.LoadBalance, config.BulkMaxSize, config.MaxRetries, clients)
+	grp.OnClose = func() {
+		index.Close()
+		pipelineSel.Close()
+	}
+	return grp, err
+}
+
+// buildAliasManager returns the manager bootstrapping the ILM alias of each
+// index, if ILM is enabled and the index is selected dynamically. With a fixed
+// index, the index is the rollover alias, which is bootstrapped on connect.
+func buildAliasManager(
+	info beat.Info,
+	cfg *common.Config,
+	index outil.Selector,
+) (*ilm.AliasManager, error) {
+	if !cfg.HasField("ilm") || index.IsConst() {
+		return nil, nil
+	}
+
+	sub, err := cfg.Child("ilm", -1)
+	if err != nil || !sub.Enabled() {
+		return nil, err
+	}
+
+	ilmCfg, err := ilm.NewConfig(info, sub)
+	if err != nil {
+		return nil, err
+	}
+	logp.Info("ILM is enabled with dynamic indices, the rollover alias of each index is created on first use.")
+	return ilm.NewAliasManager(ilmCfg), nil
 }
 
 // NewConnectedClient creates a new Elasticsearch client based on the given config.
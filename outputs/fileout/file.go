@@ -18,6 +18,8 @@
 package fileout
 
 import (
+	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -28,6 +30,7 @@ import (
 	"github.com/njcx/libbeat_v6/outputs"
 	"github.com/njcx/libbeat_v6/outputs/codec"
 	"github.com/njcx/libbeat_v6/publisher"
+	"github.com/njcx/libbeat_v6/testing"
 )
 
 func init() {
@@ -35,11 +38,14 @@ func init() {
 }
 
 type fileOutput struct {
-	filePath string
-	beat     beat.Info
-	observer outputs.Observer
-	rotator  *file.Rotator
-	codec    codec.Codec
+	filePath    string
+	permissions os.FileMode
+	rotateBytes uint
+	keepFiles   uint
+	beat        beat.Info
+	observer    outputs.Observer
+	rotator     *file.Rotator
+	codec       codec.Codec
 }
 
 // makeFileout instantiates a new file output instance.
@@ -76,6 +82,9 @@ func (out *fileOutput) init(beat beat.Info, c config) error {
 	}
 
 	out.filePath = path
+	out.permissions = os.FileMode(c.Permissions)
+	out.rotateBytes = c.RotateEveryKb * 1024
+	out.keepFiles = c.NumberOfFiles
 
 	var err error
 	out.rotator, err = file.NewFileRotator(
@@ -154,6 +163,62 @@ func (out *fileOutput) Publish(
 	return nil
 }
 
+// Test checks the output file can be written. The directory of the file is
+// created on the first write if it does not exist, in this case the closest
+// existing parent directory must be writable.
+func (out *fileOutput) Test(d testing.Driver) {
+	d.Run("file: "+out.filePath, func(d testing.Driver) {
+		d.Info("permissions", out.permissions.String())
+		d.Info("rotation", fmt.Sprintf("every %d bytes, keep %d files", out.rotateBytes, out.keepFiles))
+
+		dir := filepath.Dir(out.filePath)
+		existing := dir
+		for {
+			if _, err := os.Stat(existing); !os.IsNotExist(err) {
+				break
+			}
+			parent := filepath.Dir(existing)
+			if parent == existing {
+				break
+			}
+			existing = parent
+		}
+		if existing != dir {
+			d.Info("directory", dir+" does not exist, it is created on the first write")
+		}
+
+		tmp, err := ioutil.TempFile(existing, "."+filepath.Base(out.filePath)+".test")
+		if err == nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+		d.Fatal("directory writable", err)
+
+		info, err := os.Stat(out.filePath)
+		if os.IsNotExist(err) {
+			d.Info("file", "does not exist yet")
+			return
+		}
+		d.Fatal("file", err)
+		if err != nil {
+			return
+		}
+		if !info.Mode().IsRegular() {
+			d.Fatal("file", fmt.Errorf("%s is not a regular file", out.filePath))
+			return
+		}
+		if perm := info.Mode().Perm(); perm != out.permissions {
+			d.Warn("file permissions", fmt.Sprintf("file has permissions %v, the configured permissions are applied to new files", perm))
+		}
+
+		f, err := os.OpenFile(out.filePath, os.O_WRONLY|os.O_APPEND, 0)
+		if err == nil {
+			f.Close()
+		}
+		d.Fatal("file writable", err)
+	})
+}
+
 func (out *fileOutput) String() string {
 	return "file(" + out.filePath + ")"
 }
@@ -18,3 +18,58 @@
 // +build !integration
 
 package fileout
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	libtesting "github.com/njcx/libbeat_v6/testing"
+)
+
+func TestFileOutputTest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fileout")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	run := func(path string) *libtesting.Result {
+		out := &fileOutput{filePath: path, permissions: 0600, rotateBytes: 1024, keepFiles: 7}
+		d := libtesting.NewJSONDriverWithKiller(ioutil.Discard, func() {})
+		out.Test(d)
+		return d.Report().Checks[0]
+	}
+
+	checks := func(r *libtesting.Result) map[string]*libtesting.Result {
+		m := map[string]*libtesting.Result{}
+		for _, c := range r.Checks {
+			m[c.Name] = c
+		}
+		return m
+	}
+
+	// Missing directory is created on the first write.
+	result := run(filepath.Join(dir, "sub", "beat"))
+	assert.Equal(t, libtesting.StatusOK, result.Status)
+	assert.Contains(t, checks(result), "directory")
+	assert.Equal(t, "does not exist yet", checks(result)["file"].Value)
+
+	// Existing file with other permissions.
+	path := filepath.Join(dir, "beat")
+	require.NoError(t, ioutil.WriteFile(path, nil, 0644))
+	require.NoError(t, os.Chmod(path, 0644))
+	result = run(path)
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, libtesting.StatusWarn, result.Status)
+		assert.Equal(t, libtesting.StatusWarn, checks(result)["file permissions"].Status)
+	}
+	assert.Equal(t, libtesting.StatusOK, checks(result)["file writable"].Status)
+
+	// Path is a directory.
+	result = run(dir)
+	assert.Equal(t, libtesting.StatusError, result.Status)
+}
@@ -26,12 +26,14 @@ import (
 
 	"github.com/Shopify/sarama"
 
+	"github.com/njcx/libbeat_v6/beat"
 	"github.com/njcx/libbeat_v6/common/fmtstr"
 	"github.com/njcx/libbeat_v6/logp"
 	"github.com/njcx/libbeat_v6/outputs"
 	"github.com/njcx/libbeat_v6/outputs/codec"
 	"github.com/njcx/libbeat_v6/outputs/outil"
 	"github.com/njcx/libbeat_v6/publisher"
+	"github.com/njcx/libbeat_v6/testing"
 )
 
 type client struct {
@@ -268,3 +270,101 @@ func (r *msgRef) dec() {
 		stats.Acked(r.total)
 	}
 }
+
+// Test checks the brokers can be reached, the cluster metadata can be fetched
+// and the configured topic exists. The acknowledgement settings are reported
+// too.
+func (c *client) Test(d testing.Driver) {
+	d.Run("kafka: "+strings.Join(c.hosts, ","), func(d testing.Driver) {
+		d.Info("version", c.config.Version.String())
+		d.Info("required_acks", requiredAcksString(c.config.Producer.RequiredAcks))
+		if c.config.Producer.RequiredAcks == sarama.NoResponse {
+			d.Warn("ACK", "events are not acknowledged by the brokers and can be lost")
+		}
+
+		for _, host := range c.hosts {
+			d.Run("broker "+host, func(d testing.Driver) {
+				broker := sarama.NewBroker(host)
+				err := broker.Open(&c.config)
+				if err == nil {
+					_, err = broker.Connected()
+				}
+				d.Error("connection", err)
+				broker.Close()
+			})
+		}
+
+		d.Run("metadata", func(d testing.Driver) {
+			client, err := sarama.NewClient(c.hosts, &c.config)
+			d.Fatal("fetch metadata", err)
+			if err != nil {
+				return
+			}
+			defer client.Close()
+
+			var addrs []string
+			for _, broker := range client.Brokers() {
+				addrs = append(addrs, fmt.Sprintf("%d=%s", broker.ID(), broker.Addr()))
+			}
+			d.Info("brokers", strings.Join(addrs, ", "))
+
+			if controller, err := client.Controller(); err == nil {
+				d.Info("controller", controller.Addr())
+			}
+
+			c.testTopic(d, client)
+		})
+	})
+}
+
+func (c *client) testTopic(d testing.Driver, client sarama.Client) {
+	if !c.topic.IsConst() {
+		d.Info("topic", "topic is selected per event, existence not checked")
+		return
+	}
+
+	topic, err := c.topic.Select(&beat.Event{})
+	if err == nil && topic == "" {
+		err = errNoTopicsSelected
+	}
+	d.Fatal("topic", err)
+	if err != nil {
+		return
+	}
+
+	topics, err := client.Topics()
+	d.Fatal("list topics", err)
+	if err != nil {
+		return
+	}
+
+	exists := false
+	for _, t := range topics {
+		exists = exists || t == topic
+	}
+	if !exists {
+		d.Warn("topic "+topic, "topic does not exist, publishing fails if auto creation of topics is disabled")
+		return
+	}
+
+	partitions, err := client.Partitions(topic)
+	d.Error("topic "+topic, err)
+	if err == nil && len(partitions) == 0 {
+		d.Error("partitions", errors.New("topic has no partitions"))
+		return
+	}
+	d.Info("partitions", fmt.Sprintf("%d", len(partitions)))
+}
+
+func requiredAcksString(acks sarama.RequiredAcks) string {
+	switch acks {
+	case sarama.NoResponse:
+		return "0 (no response)"
+	case sarama.WaitForLocal:
+		return "1 (wait for leader)"
+	case sarama.WaitForAll:
+		return "-1 (wait for all replicas)"
+	default:
+		return fmt.Sprintf("%d", acks)
+	}
+}
@@ -73,7 +73,7 @@ func makeKafka(
 	beat beat.Info,
 	observer outputs.Observer,
 	cfg *common.Config,
-) (outputs.Group, error) {
+) (grp outputs.Group, err error) {
 	debugf("initialize kafka output")
 
 	config, err := readConfig(cfg)
@@ -90,6 +90,11 @@ func makeKafka(
 	if err != nil {
 		return outputs.Fail(err)
 	}
+	defer func() {
+		if err != nil {
+			topic.Close()
+		}
+	}()
 
 	libCfg, err := newSaramaConfig(config)
 	if err != nil {
@@ -115,5 +120,7 @@ func makeKafka(
 	if config.MaxRetries < 0 {
 		retry = -1
 	}
-	return outputs.Success(config.BulkMaxSize, retry, client)
+	grp, err = outputs.Success(config.BulkMaxSize, retry, client)
+	grp.OnClose = topic.Close
+	return grp, err
 }
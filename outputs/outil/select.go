@@ -95,6 +95,30 @@ func (s Selector) Select(evt *beat.Event) (string, error) {
 	return s.sel.sel(evt)
 }
 
+// Close releases the routing tables used by the selector. The selector can
+// still be used, but tables are not reloaded anymore.
+func (s Selector) Close() {
+	if s.sel != nil {
+		closeSelectorExpr(s.sel)
+	}
+}
+
+func closeSelectorExpr(s SelectorExpr) {
+	switch v := s.(type) {
+	case *listSelector:
+		for _, sub := range v.selectors {
+			closeSelectorExpr(sub)
+		}
+	case *condSelector:
+		closeSelectorExpr(v.s)
+	case *mapSelector:
+		closeSelectorExpr(v.from)
+	case *tableSelector:
+		closeSelectorExpr(v.from)
+		v.table.Close()
+	}
+}
+
 func (s Selector) IsEmpty() bool {
 	return s.sel == nilSelector || s.sel == nil
 }
@@ -113,6 +137,13 @@ func BuildSelectorFromConfig(
 	settings Settings,
 ) (Selector, error) {
 	var sel []SelectorExpr
+	success := false
+	defer func() {
+		// release routing tables loaded before the error
+		if !success {
+			MakeSelector(sel...).Close()
+		}
+	}()
 
 	key := settings.Key
 	multiKey := settings.MultiKey
@@ -180,6 +211,7 @@ func BuildSelectorFromConfig(
 			multiKey, cfg.Path())
 	}
 
+	success = true
 	return MakeSelector(sel...), nil
 }
 
@@ -214,6 +246,15 @@ func LookupSelectorExpr(
 	return &mapSelector{s, fallback, table}
 }
 
+func TableSelectorExpr(
+	s SelectorExpr,
+	table *RoutingTable,
+	column string,
+	fallback string,
+) SelectorExpr {
+	return &tableSelector{s, fallback, column, table}
+}
+
 func buildSingle(cfg *common.Config, key string) (SelectorExpr, error) {
 	// TODO: check for unknown fields
 
@@ -252,7 +293,31 @@ func buildSingle(cfg *common.Config, key string) (SelectorExpr, error) {
 		}
 	}
 
-	// 4. extract conditional
+	// 4. extract optional routing `table`
+	var tableCfg *common.Config
+	column := key
+	if cfg.HasField("table") {
+		if len(mapping.Table) > 0 {
+			return nil, fmt.Errorf("%v and %v can not be used together",
+				cfg.PathOf("mappings"), cfg.PathOf("table"))
+		}
+
+		sub, err := cfg.Child("table", -1)
+		if err != nil {
+			return nil, err
+		}
+
+		if sub.HasField("column") {
+			column, err = sub.String("column", -1)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		tableCfg = sub
+	}
+
+	// 5. extract conditional
 	var cond conditions.Condition
 	if cfg.HasField("when") {
 		sub, err := cfg.Child("when", -1)
@@ -273,9 +338,16 @@ func buildSingle(cfg *common.Config, key string) (SelectorExpr, error) {
 		cond = tmp
 	}
 
-	// 5. build selector from available fields
+	// 6. build selector from available fields
 	var sel SelectorExpr
-	if len(mapping.Table) > 0 {
+	if tableCfg != nil {
+		// load the table last, so it is not leaked if the configuration is invalid
+		table, err := LoadRoutingTable(tableCfg)
+		if err != nil {
+			return nil, err
+		}
+		sel = TableSelectorExpr(FmtSelectorExpr(evtfmt, ""), table, column, otherwise)
+	} else if len(mapping.Table) > 0 {
 		if evtfmt.IsConst() {
 			str, err := evtfmt.Run(nil)
 			if err != nil {
@@ -0,0 +1,289 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package outil
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/atomic"
+	"github.com/njcx/libbeat_v6/common/file"
+	"github.com/njcx/libbeat_v6/logp"
+	"github.com/njcx/libbeat_v6/monitoring"
+)
+
+// TableConfig configures a routing table file.
+type TableConfig struct {
+	// Path of the YAML or CSV file holding the routing rules.
+	Path string `config:"path" validate:"required"`
+
+	// Format of the file, `yaml` or `csv`. Derived from the file extension if not set.
+	Format string `config:"format"`
+
+	// Key is the name of the column holding the value to match on.
+	Key string `config:"key"`
+
+	// ReloadPeriod is the interval to check the file for changes. Use 0 to disable reloading.
+	ReloadPeriod time.Duration `config:"reload.period"`
+}
+
+// RoutingTable maps values to routing targets, like index names or ingest
+// pipelines. Every row of the table is identified by its key column, all other
+// columns are targets that can be looked up by name.
+// The table is reloaded in the background when the underlying file changes.
+type RoutingTable struct {
+	config   TableConfig
+	log      *logp.Logger
+	reloader *file.Reloader
+
+	mu     sync.RWMutex
+	routes map[string]route
+
+	metricsName string
+	closeOnce   sync.Once
+	reloads     *monitoring.Int
+	failures    *monitoring.Int
+	misses      *monitoring.Int
+}
+
+type route struct {
+	values map[string]string
+	hits   *atomic.Uint64
+}
+
+type tableSelector struct {
+	from      SelectorExpr
+	otherwise string
+	column    string
+	table     *RoutingTable
+}
+
+var tableID atomic.Uint32
+
+var defaultTableConfig = TableConfig{
+	Key:          "key",
+	ReloadPeriod: 10 * time.Second,
+}
+
+// LoadRoutingTable reads the routing table file configured in cfg.
+// Metrics about the table are registered under `outil.routing.<id>`. The table
+// must be closed to stop watching the file and to remove the metrics.
+func LoadRoutingTable(cfg *common.Config) (*RoutingTable, error) {
+	config := defaultTableConfig
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	if config.Format == "" {
+		config.Format = strings.TrimPrefix(filepath.Ext(config.Path), ".")
+	}
+	switch config.Format {
+	case "yml":
+		config.Format = "yaml"
+	case "yaml", "csv":
+	default:
+		return nil, fmt.Errorf("unsupported routing table format '%v' in %v",
+			config.Format, cfg.PathOf("format"))
+	}
+
+	t := &RoutingTable{
+		config:   config,
+		log:      logp.NewLogger("routing").With("path", config.Path),
+		reloader: file.NewReloader(config.Path),
+		routes:   map[string]route{},
+	}
+	if err := t.load(); err != nil {
+		return nil, err
+	}
+
+	t.metricsName = "outil.routing." + strconv.Itoa(int(tableID.Inc()))
+	metrics := monitoring.Default.NewRegistry(t.metricsName, monitoring.DoNotReport)
+	t.reloads = monitoring.NewInt(metrics, "reloads")
+	t.failures = monitoring.NewInt(metrics, "failures")
+	t.misses = monitoring.NewInt(metrics, "misses")
+	monitoring.NewFunc(metrics, "hits", t.reportHits)
+
+	t.reloader.Start(config.ReloadPeriod, t.reload)
+	return t, nil
+}
+
+// Close stops watching the file for changes and removes the metrics of the
+// table. Lookups still return the rules loaded last.
+func (t *RoutingTable) Close() {
+	t.closeOnce.Do(func() {
+		t.reloader.Close()
+		monitoring.Default.Remove(t.metricsName)
+	})
+}
+
+// Lookup returns the value of column for the row identified by key.
+func (t *RoutingTable) Lookup(key, column string) (string, bool) {
+	t.mu.RLock()
+	r, found := t.routes[key]
+	t.mu.RUnlock()
+
+	if !found {
+		t.misses.Inc()
+		return "", false
+	}
+
+	v := r.values[column]
+	if v == "" {
+		t.misses.Inc()
+		return "", false
+	}
+
+	r.hits.Inc()
+	return v, true
+}
+
+// Hits returns the number of successful lookups per row key.
+func (t *RoutingTable) Hits() map[string]uint64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	hits := make(map[string]uint64, len(t.routes))
+	for key, r := range t.routes {
+		hits[key] = r.hits.Load()
+	}
+	return hits
+}
+
+func (t *RoutingTable) reportHits(_ monitoring.Mode, V monitoring.Visitor) {
+	V.OnRegistryStart()
+	defer V.OnRegistryFinished()
+
+	for key, hits := range t.Hits() {
+		monitoring.ReportInt(V, key, int64(hits))
+	}
+}
+
+func (t *RoutingTable) reload() {
+	if err := t.load(); err != nil {
+		t.failures.Inc()
+		t.log.Errorf("Failed to reload routing table, keeping previous rules: %v", err)
+		return
+	}
+	t.reloads.Inc()
+	t.log.Infof("Routing table reloaded")
+}
+
+// load reads and parses the file and swaps the rules. It is not called
+// concurrently, the table is loaded once and then by the reloader only.
+func (t *RoutingTable) load() error {
+	data, err := ioutil.ReadFile(t.config.Path)
+	if err != nil {
+		return err
+	}
+
+	var rows []map[string]string
+	switch t.config.Format {
+	case "csv":
+		rows, err = parseCSVTable(data)
+	default:
+		rows, err = parseYAMLTable(data, t.config.Path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse routing table %v: %v", t.config.Path, err)
+	}
+
+	t.mu.RLock()
+	old := t.routes
+	t.mu.RUnlock()
+
+	routes := make(map[string]route, len(rows))
+	for i, row := range rows {
+		key := row[t.config.Key]
+		if key == "" {
+			return fmt.Errorf("row %v in %v has no '%v' column", i, t.config.Path, t.config.Key)
+		}
+		if _, exists := routes[key]; exists {
+			return fmt.Errorf("duplicate key '%v' in %v", key, t.config.Path)
+		}
+
+		// keep hit counters of rules surviving the reload
+		hits := &atomic.Uint64{}
+		if r, exists := old[key]; exists {
+			hits = r.hits
+		}
+		routes[key] = route{values: row, hits: hits}
+	}
+
+	t.mu.Lock()
+	t.routes = routes
+	t.mu.Unlock()
+	return nil
+}
+
+// parseYAMLTable parses a list of rules in the format:
+//
+//	routes:
+//	  - key: nginx
+//	    index: web
+//	    pipeline: nginx-access
+func parseYAMLTable(data []byte, source string) ([]map[string]string, error) {
+	cfg, err := common.NewConfigWithYAML(data, source)
+	if err != nil {
+		return nil, err
+	}
+
+	table := struct {
+		Routes []map[string]string `config:"routes"`
+	}{}
+	if err := cfg.Unpack(&table); err != nil {
+		return nil, err
+	}
+	return table.Routes, nil
+}
+
+// parseCSVTable parses a CSV file. The first line is a header naming the columns.
+func parseCSVTable(data []byte) ([]map[string]string, error) {
+	var rows []map[string]string
+	err := common.ReadCSVWithHeader(bytes.NewReader(data), 0, func(row map[string]string) error {
+		rows = append(rows, row)
+		return nil
+	})
+	return rows, err
+}
+
+func (s *tableSelector) sel(evt *beat.Event) (string, error) {
+	n, err := s.from.sel(evt)
+	if err != nil {
+		if s.otherwise == "" {
+			return "", err
+		}
+		return s.otherwise, nil
+	}
+
+	if n == "" {
+		return s.otherwise, nil
+	}
+
+	if v, found := s.table.Lookup(n, s.column); found {
+		return v, nil
+	}
+	return s.otherwise, nil
+}
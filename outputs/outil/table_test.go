@@ -0,0 +1,310 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package outil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/file/filetest"
+	"github.com/njcx/libbeat_v6/monitoring"
+)
+
+const yamlRoutes = `
+routes:
+  - key: nginx
+    index: web
+    pipeline: nginx-access
+  - key: mysql
+    index: db
+`
+
+const csvRoutes = `# service to index mappings
+key,index,pipeline
+nginx,web,nginx-access
+mysql,db,
+`
+
+func TestRoutingTableLookup(t *testing.T) {
+	tests := map[string]string{
+		"routes.yml": yamlRoutes,
+		"routes.csv": csvRoutes,
+	}
+
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := writeTable(t, name, content)
+			defer os.RemoveAll(filepath.Dir(path))
+
+			table, err := LoadRoutingTable(common.MustNewConfigFrom(map[string]interface{}{
+				"path": path,
+			}))
+			require.NoError(t, err)
+			defer table.Close()
+
+			v, found := table.Lookup("nginx", "index")
+			assert.True(t, found)
+			assert.Equal(t, "web", v)
+
+			v, found = table.Lookup("nginx", "pipeline")
+			assert.True(t, found)
+			assert.Equal(t, "nginx-access", v)
+
+			_, found = table.Lookup("mysql", "pipeline")
+			assert.False(t, found)
+
+			_, found = table.Lookup("redis", "index")
+			assert.False(t, found)
+
+			assert.Equal(t, map[string]uint64{"nginx": 2, "mysql": 0}, table.Hits())
+		})
+	}
+}
+
+func TestRoutingTableReload(t *testing.T) {
+	path := writeTable(t, "routes.yml", yamlRoutes)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	table, err := LoadRoutingTable(common.MustNewConfigFrom(map[string]interface{}{
+		"path":          path,
+		"reload.period": "10ms",
+	}))
+	require.NoError(t, err)
+	defer table.Close()
+
+	v, _ := table.Lookup("nginx", "index")
+	assert.Equal(t, "web", v)
+
+	filetest.Replace(t, path, []byte("routes: [{key: nginx, index: proxy}]"))
+	filetest.WaitFor(t, func() bool {
+		v, _ := table.Lookup("nginx", "index")
+		return v == "proxy"
+	})
+	assert.Equal(t, int64(1), table.reloads.Get())
+
+	// invalid tables keep the previous rules
+	filetest.Replace(t, path, []byte("routes: [{index: broken}]"))
+	filetest.WaitFor(t, func() bool { return table.failures.Get() == 1 })
+
+	v, _ = table.Lookup("nginx", "index")
+	assert.Equal(t, "proxy", v)
+}
+
+func TestRoutingTableConcurrentReload(t *testing.T) {
+	path := writeTable(t, "routes.yml", yamlRoutes)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	table, err := LoadRoutingTable(common.MustNewConfigFrom(map[string]interface{}{
+		"path":          path,
+		"reload.period": "1ms",
+	}))
+	require.NoError(t, err)
+	defer table.Close()
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				v, found := table.Lookup("nginx", "index")
+				if !found || (v != "web" && v != "proxy") {
+					t.Errorf("unexpected lookup result: %v, %v", v, found)
+					return
+				}
+			}
+		}()
+	}
+
+	for _, index := range []string{"proxy", "web", "proxy"} {
+		filetest.Replace(t, path, []byte("routes: [{key: nginx, index: "+index+"}]"))
+		filetest.WaitFor(t, func() bool {
+			v, _ := table.Lookup("nginx", "index")
+			return v == index
+		})
+	}
+	close(done)
+	wg.Wait()
+}
+
+func TestRoutingTableClose(t *testing.T) {
+	path := writeTable(t, "routes.yml", yamlRoutes)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	table, err := LoadRoutingTable(common.MustNewConfigFrom(map[string]interface{}{
+		"path":          path,
+		"reload.period": "1ms",
+	}))
+	require.NoError(t, err)
+	require.NotNil(t, monitoring.Default.GetRegistry(table.metricsName))
+
+	table.Close()
+	table.Close()
+	assert.Nil(t, monitoring.Default.GetRegistry(table.metricsName))
+
+	// closed tables keep the rules loaded last, but are not reloaded anymore
+	filetest.Replace(t, path, []byte("routes: [{key: nginx, index: proxy}]"))
+	time.Sleep(20 * time.Millisecond)
+
+	v, _ := table.Lookup("nginx", "index")
+	assert.Equal(t, "web", v)
+	assert.Equal(t, int64(0), table.reloads.Get())
+}
+
+func TestRoutingTableInitFail(t *testing.T) {
+	tests := map[string]string{
+		"routes.yml":  "routes: [{key: a, index: x}, {key: a, index: y}]",
+		"routes.json": "{}",
+		"broken.yml":  "routes: [{index: x}]",
+	}
+
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := writeTable(t, name, content)
+			defer os.RemoveAll(filepath.Dir(path))
+
+			_, err := LoadRoutingTable(common.MustNewConfigFrom(map[string]interface{}{
+				"path": path,
+			}))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSelectorWithRoutingTable(t *testing.T) {
+	path := writeTable(t, "routes.yml", yamlRoutes)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"pipelines": []map[string]interface{}{
+			{
+				"pipeline":   "%{[service]}",
+				"default":    "default-pipeline",
+				"table.path": path,
+			},
+		},
+	})
+
+	sel, err := BuildSelectorFromConfig(cfg, Settings{
+		Key:      "pipeline",
+		MultiKey: "pipelines",
+	})
+	require.NoError(t, err)
+	defer sel.Close()
+
+	tests := map[string]string{
+		"nginx": "nginx-access",
+		"mysql": "default-pipeline",
+		"redis": "default-pipeline",
+	}
+	for service, expected := range tests {
+		actual, err := sel.Select(&beat.Event{
+			Timestamp: time.Now(),
+			Fields:    common.MapStr{"service": service},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual, service)
+	}
+}
+
+func TestSelectorClose(t *testing.T) {
+	path := writeTable(t, "routes.yml", yamlRoutes)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"indices": []map[string]interface{}{
+			{
+				"index":      "%{[service]}",
+				"table.path": path,
+				"when.equals": map[string]interface{}{
+					"type": "access",
+				},
+			},
+			{
+				"index":      "%{[type]}",
+				"table.path": path,
+			},
+		},
+	})
+
+	sel, err := BuildSelectorFromConfig(cfg, Settings{
+		Key:      "index",
+		MultiKey: "indices",
+	})
+	require.NoError(t, err)
+
+	tables := routingTables(sel.sel)
+	require.Len(t, tables, 2)
+	for _, table := range tables {
+		require.NotNil(t, monitoring.Default.GetRegistry(table.metricsName))
+	}
+
+	sel.Close()
+	for _, table := range tables {
+		assert.Nil(t, monitoring.Default.GetRegistry(table.metricsName))
+	}
+
+	actual, err := sel.Select(&beat.Event{
+		Timestamp: time.Now(),
+		Fields:    common.MapStr{"service": "nginx", "type": "access"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "web", actual)
+}
+
+func routingTables(s SelectorExpr) []*RoutingTable {
+	switch v := s.(type) {
+	case *listSelector:
+		var tables []*RoutingTable
+		for _, sub := range v.selectors {
+			tables = append(tables, routingTables(sub)...)
+		}
+		return tables
+	case *condSelector:
+		return routingTables(v.s)
+	case *tableSelector:
+		return []*RoutingTable{v.table}
+	}
+	return nil
+}
+
+func writeTable(t *testing.T, name, content string) string {
+	dir, err := ioutil.TempDir("", "routing")
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, name)
+	err = ioutil.WriteFile(path, []byte(content), 0600)
+	require.NoError(t, err)
+	return path
+}
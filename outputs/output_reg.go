@@ -39,6 +39,10 @@ type Group struct {
 	Clients   []Client
 	BatchSize int
 	Retry     int
+
+	// OnClose, if set, releases resources shared by the clients, like routing
+	// tables. It is called once all clients of the group have been closed.
+	OnClose func()
 }
 
 // RegisterType registers a new output type.
@@ -24,6 +24,7 @@ import (
 
 	b "github.com/njcx/libbeat_v6/common/backoff"
 	"github.com/njcx/libbeat_v6/publisher"
+	"github.com/njcx/libbeat_v6/testing"
 )
 
 type backoffClient struct {
@@ -72,6 +73,10 @@ func (b *backoffClient) Connect() error {
 	return err
 }
 
+func (b *backoffClient) Test(d testing.Driver) {
+	b.client.Test(d)
+}
+
 func (b *backoffClient) Close() error {
 	err := b.client.Close()
 	close(b.done)
@@ -19,6 +19,7 @@ package redis
 
 import (
 	"errors"
+	"fmt"
 	"regexp"
 	"strconv"
 	"time"
@@ -33,6 +34,7 @@ import (
 	"github.com/njcx/libbeat_v6/outputs/outil"
 	"github.com/njcx/libbeat_v6/outputs/transport"
 	"github.com/njcx/libbeat_v6/publisher"
+	"github.com/njcx/libbeat_v6/testing"
 )
 
 var (
@@ -64,6 +66,17 @@ const (
 	redisChannelType
 )
 
+func (t redisDataType) String() string {
+	switch t {
+	case redisListType:
+		return "list"
+	case redisChannelType:
+		return "channel"
+	default:
+		return "unknown"
+	}
+}
+
 func newClient(
 	tc *transport.Client,
 	observer outputs.Observer,
@@ -126,6 +139,80 @@ func initRedisConn(c redis.Conn, pwd string, db int) error {
 	return nil
 }
 
+// Test checks the connection to the Redis server, the authentication, the
+// selected database and the configured data type.
+func (c *client) Test(d testing.Driver) {
+	d.Run("redis: "+c.Client.String(), func(d testing.Driver) {
+		c.Client.TestConnection(d)
+
+		err := c.Client.Connect()
+		d.Fatal("talk to server", err)
+		if err != nil {
+			return
+		}
+		defer c.Client.Close()
+
+		conn := redis.NewConn(c.Client, c.timeout, c.timeout)
+		if c.password == "" {
+			d.Info("AUTH", "no password configured")
+		} else {
+			_, err = conn.Do("AUTH", c.password)
+			d.Fatal("AUTH", err)
+		}
+
+		_, err = conn.Do("PING")
+		d.Fatal("PING", err)
+
+		if c.db != 0 {
+			_, err = conn.Do("SELECT", c.db)
+			d.Fatal("SELECT "+strconv.Itoa(c.db), err)
+		}
+		d.Info("database", strconv.Itoa(c.db))
+
+		info, err := redis.String(conn.Do("INFO"))
+		d.Error("INFO", err)
+		if matches := versionRegex.FindStringSubmatch(info); matches != nil {
+			d.Info("version", matches[1]+"."+matches[2])
+		}
+
+		d.Info("datatype", c.dataType.String())
+		c.testKey(d, conn)
+	})
+}
+
+func (c *client) testKey(d testing.Driver, conn redis.Conn) {
+	if !c.key.IsConst() {
+		d.Info("key", "key is selected per event, not checked")
+		return
+	}
+
+	key, err := c.key.Select(&beat.Event{})
+	d.Fatal("key", err)
+	if err != nil {
+		return
+	}
+
+	switch c.dataType {
+	case redisListType:
+		typ, err := redis.String(conn.Do("TYPE", key))
+		d.Error("TYPE "+key, err)
+		if err == nil && typ != "none" && typ != "list" {
+			d.Error("key "+key, fmt.Errorf("key has type %s, events can only be pushed to a list", typ))
+		}
+
+	case redisChannelType:
+		values, err := redis.Values(conn.Do("PUBSUB", "NUMSUB", key))
+		d.Error("PUBSUB NUMSUB "+key, err)
+		if err != nil || len(values) != 2 {
+			return
+		}
+		subscribers, err := redis.Int(values[1], nil)
+		if err == nil && subscribers == 0 {
+			d.Warn("channel "+key, "no subscribers, published events are lost")
+		}
+	}
+}
+
 func (c *client) Close() error {
 	debugf("close connection")
 	return c.Client.Close()
@@ -51,7 +51,7 @@ func makeRedis(
 	beat beat.Info,
 	observer outputs.Observer,
 	cfg *common.Config,
-) (outputs.Group, error) {
+) (grp outputs.Group, err error) {
 
 	if !cfg.HasField("index") {
 		cfg.SetString("index", -1, beat.Beat)
@@ -96,6 +96,11 @@ func makeRedis(
 	if err != nil {
 		return outputs.Fail(err)
 	}
+	defer func() {
+		if err != nil {
+			key.Close()
+		}
+	}()
 
 	hosts, err := outputs.ReadHostList(cfg)
 	if err != nil {
@@ -131,5 +136,7 @@ func makeRedis(
 		clients[i] = newBackoffClient(client, config.Backoff.Init, config.Backoff.Max)
 	}
 
-	return outputs.SuccessNet(config.LoadBalance, config.BulkMaxSize, config.MaxRetries, clients)
+	grp, err = outputs.SuccessNet(config.LoadBalance, config.BulkMaxSize, config.MaxRetries, clients)
+	grp.OnClose = key.Close
+	return grp, err
 }
@@ -226,28 +226,34 @@ func (c *Client) handleError(err error) error {
 
 func (c *Client) Test(d testing.Driver) {
 	d.Run("logstash: "+c.host, func(d testing.Driver) {
-		d.Run("connection", func(d testing.Driver) {
-			netDialer := TestNetDialer(d, c.config.Timeout)
-			_, err := netDialer.Dial("tcp", c.host)
-			d.Fatal("dial up", err)
-		})
-
-		if c.config.TLS == nil {
-			d.Warn("TLS", "secure connection disabled")
-		} else {
-			d.Run("TLS", func(d testing.Driver) {
-				netDialer := NetDialer(c.config.Timeout)
-				tlsDialer, err := TestTLSDialer(d, netDialer, c.config.TLS, c.config.Timeout)
-				_, err = tlsDialer.Dial("tcp", c.host)
-				d.Fatal("dial up", err)
-			})
-		}
+		c.TestConnection(d)
 
 		err := c.Connect()
 		d.Fatal("talk to server", err)
 	})
 }
 
+// TestConnection checks the address of the host can be resolved and dialed.
+// If TLS is configured, the TLS handshake is tested too.
+func (c *Client) TestConnection(d testing.Driver) {
+	d.Run("connection", func(d testing.Driver) {
+		netDialer := TestNetDialer(d, c.config.Timeout)
+		_, err := netDialer.Dial("tcp", c.host)
+		d.Fatal("dial up", err)
+	})
+
+	if c.config.TLS == nil {
+		d.Warn("TLS", "secure connection disabled")
+	} else {
+		d.Run("TLS", func(d testing.Driver) {
+			netDialer := NetDialer(c.config.Timeout)
+			tlsDialer, err := TestTLSDialer(d, netDialer, c.config.TLS, c.config.Timeout)
+			_, err = tlsDialer.Dial("tcp", c.host)
+			d.Fatal("dial up", err)
+		})
+	}
+}
+
 func (c *Client) String() string {
 	return c.network + "://" + c.host
 }
@@ -19,6 +19,7 @@ package transport
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net"
@@ -153,6 +154,8 @@ func postVerifyTLSConnection(d testing.Driver, conn *tls.Conn, config *TLSConfig
 	}
 
 	d.Info("TLS version", fmt.Sprintf("%v", TLSVersion(st.Version)))
+	d.Info("cipher", tlscommon.CipherSuiteName(st.CipherSuite))
+	reportCertificates(d, st.PeerCertificates, time.Now())
 
 	// no more checks if no extra configs available
 	if config == nil {
@@ -175,3 +178,26 @@ func postVerifyTLSConnection(d testing.Driver, conn *tls.Conn, config *TLSConfig
 
 	return nil
 }
+
+// certExpiryWarning is the time before the expiry of a certificate from which
+// on a warning is reported.
+const certExpiryWarning = 30 * 24 * time.Hour
+
+// reportCertificates reports the certificate chain presented by the server
+// and warns about certificates that are expired or about to expire.
+func reportCertificates(d testing.Driver, certs []*x509.Certificate, now time.Time) {
+	for i, cert := range certs {
+		field := fmt.Sprintf("certificate %d", i)
+		d.Info(field, fmt.Sprintf("subject=%q issuer=%q not_after=%v",
+			cert.Subject.String(), cert.Issuer.String(), cert.NotAfter.UTC().Format(time.RFC3339)))
+
+		switch {
+		case now.After(cert.NotAfter):
+			d.Warn(field+" expiry", "certificate expired")
+		case now.Before(cert.NotBefore):
+			d.Warn(field+" expiry", "certificate not valid yet")
+		case cert.NotAfter.Sub(now) < certExpiryWarning:
+			d.Warn(field+" expiry", fmt.Sprintf("certificate expires in %v", cert.NotAfter.Sub(now).Round(time.Hour)))
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package transport
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	libtesting "github.com/njcx/libbeat_v6/testing"
+)
+
+func TestReportCertificates(t *testing.T) {
+	now := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	cert := func(cn string, notBefore, notAfter time.Time) *x509.Certificate {
+		return &x509.Certificate{
+			Subject:   pkix.Name{CommonName: cn},
+			Issuer:    pkix.Name{CommonName: "ca"},
+			NotBefore: notBefore,
+			NotAfter:  notAfter,
+		}
+	}
+
+	d := libtesting.NewJSONDriverWithKiller(ioutil.Discard, func() {})
+	reportCertificates(d, []*x509.Certificate{
+		cert("valid", now.AddDate(-1, 0, 0), now.AddDate(1, 0, 0)),
+		cert("expiring", now.AddDate(-1, 0, 0), now.AddDate(0, 0, 10)),
+		cert("expired", now.AddDate(-1, 0, 0), now.AddDate(0, 0, -1)),
+		cert("future", now.AddDate(0, 0, 1), now.AddDate(1, 0, 0)),
+	}, now)
+
+	checks := d.Report().Checks
+	var names []string
+	for _, c := range checks {
+		names = append(names, c.Name)
+	}
+	assert.Equal(t, []string{
+		"certificate 0",
+		"certificate 1", "certificate 1 expiry",
+		"certificate 2", "certificate 2 expiry",
+		"certificate 3", "certificate 3 expiry",
+	}, names)
+
+	assert.Equal(t, `subject="CN=valid" issuer="CN=ca" not_after=2020-01-01T00:00:00Z`, checks[0].Value)
+	assert.Equal(t, "certificate expires in 240h0m0s", checks[2].Message)
+	assert.Equal(t, "certificate expired", checks[4].Message)
+	assert.Equal(t, "certificate not valid yet", checks[6].Message)
+}
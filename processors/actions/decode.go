@@ -0,0 +1,263 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/cfgtype"
+	"github.com/njcx/libbeat_v6/common/jsontransform"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+type decode struct {
+	config   decodeConfig
+	decoders []decoderFunc
+	json     *decodeJSONOptions
+}
+
+type decodeConfig struct {
+	Field         string           `config:"field" validate:"required"`
+	Target        *string          `config:"target"`
+	Decoders      []string         `config:"decoders" validate:"required"`
+	MaxSize       cfgtype.ByteSize `config:"max_decompressed_size" validate:"min=1"`
+	JSON          *common.Config   `config:"json"`
+	IgnoreMissing bool             `config:"ignore_missing"`
+}
+
+type decodeJSONOptions struct {
+	MaxDepth      int  `config:"max_depth" validate:"min=1"`
+	ProcessArray  bool `config:"process_array"`
+	OverwriteKeys bool `config:"overwrite_keys"`
+}
+
+var (
+	defaultDecodeConfig = decodeConfig{
+		MaxSize: 10 * 1024 * 1024,
+	}
+
+	defaultDecodeJSONOptions = decodeJSONOptions{
+		MaxDepth: 1,
+	}
+)
+
+// decoderFunc decodes data. Decompressed data must not be larger than
+// maxSize bytes.
+type decoderFunc func(data []byte, maxSize int64) ([]byte, error)
+
+var decoders = map[string]decoderFunc{
+	"base64":    decodeBase64(base64.StdEncoding, base64.RawStdEncoding),
+	"base64url": decodeBase64(base64.URLEncoding, base64.RawURLEncoding),
+	"hex":       decodeHex,
+	"gzip":      decodeGzip,
+	"zlib":      decodeZlib,
+	"snappy":    decodeSnappy,
+}
+
+func (c *decodeConfig) Validate() error {
+	if len(c.Decoders) == 0 {
+		return errors.New("at least one decoder is required")
+	}
+	for _, name := range c.Decoders {
+		if _, found := decoders[strings.ToLower(name)]; !found {
+			return fmt.Errorf("invalid decoder '%v' (valid values are: base64, base64url, hex, gzip, zlib, snappy)", name)
+		}
+	}
+	if c.Target != nil && *c.Target == "" && !c.JSON.Enabled() {
+		return errors.New("decoding into the root of the event requires json decoding")
+	}
+	return nil
+}
+
+func init() {
+	processors.RegisterPlugin("decode",
+		configChecked(newDecode,
+			requireFields("field", "decoders"),
+			allowedFields("field", "target", "decoders", "max_decompressed_size", "json",
+				"ignore_missing", "when")))
+}
+
+func newDecode(c *common.Config) (processors.Processor, error) {
+	config := defaultDecodeConfig
+	err := c.Unpack(&config)
+	if err != nil {
+		return nil, fmt.Errorf("fail to unpack the decode configuration: %s", err)
+	}
+
+	p := &decode{config: config}
+	for _, name := range config.Decoders {
+		p.decoders = append(p.decoders, decoders[strings.ToLower(name)])
+	}
+
+	if config.JSON.Enabled() {
+		opts := defaultDecodeJSONOptions
+		if err := config.JSON.Unpack(&opts); err != nil {
+			return nil, fmt.Errorf("fail to unpack the decode json configuration: %s", err)
+		}
+		p.json = &opts
+	}
+	return p, nil
+}
+
+func (p *decode) Run(event *beat.Event) (*beat.Event, error) {
+	value, err := event.GetValue(p.config.Field)
+	if err != nil {
+		if p.config.IgnoreMissing && errors.Cause(err) == common.ErrKeyNotFound {
+			return event, nil
+		}
+		return event, fmt.Errorf("could not fetch value for key: %s, Error: %s", p.config.Field, err)
+	}
+
+	text, ok := value.(string)
+	if !ok {
+		return event, fmt.Errorf("field %s is not a string, value: `%v`", p.config.Field, value)
+	}
+
+	data := []byte(text)
+	for i, decoder := range p.decoders {
+		data, err = decoder(data, int64(p.config.MaxSize))
+		if err != nil {
+			return event, errors.Wrapf(err, "failed to decode field %s with %s", p.config.Field, p.config.Decoders[i])
+		}
+	}
+
+	if !utf8.Valid(data) {
+		return event, fmt.Errorf("decoded value of field %s is not valid UTF-8 text", p.config.Field)
+	}
+
+	target := p.config.Field
+	if p.config.Target != nil {
+		target = *p.config.Target
+	}
+
+	if p.json == nil {
+		_, err = event.PutValue(target, string(data))
+		return event, err
+	}
+
+	var output interface{}
+	if err := unmarshal(p.json.MaxDepth, string(data), &output, p.json.ProcessArray); err != nil {
+		return event, errors.Wrapf(err, "failed to decode JSON in field %s", p.config.Field)
+	}
+
+	if target != "" {
+		_, err = event.PutValue(target, output)
+		return event, err
+	}
+
+	fields, ok := output.(map[string]interface{})
+	if !ok {
+		return event, fmt.Errorf("failed to add the decoded value of field %s to root, it is not an object", p.config.Field)
+	}
+	jsontransform.WriteJSONKeys(event, fields, p.json.OverwriteKeys)
+	return event, nil
+}
+
+func (p *decode) String() string {
+	return fmt.Sprintf("decode=[field=%s, decoders=%s]", p.config.Field, strings.Join(p.config.Decoders, ","))
+}
+
+// decodeBase64 decodes padded data with enc and unpadded data with raw.
+// Whitespace like line breaks is ignored.
+func decodeBase64(enc, raw *base64.Encoding) decoderFunc {
+	return func(data []byte, _ int64) ([]byte, error) {
+		data = bytes.Join(bytes.Fields(data), nil)
+		e := enc
+		if len(data)%4 != 0 {
+			e = raw
+		}
+		out := make([]byte, e.DecodedLen(len(data)))
+		n, err := e.Decode(out, data)
+		return out[:n], err
+	}
+}
+
+func decodeHex(data []byte, _ int64) ([]byte, error) {
+	data = bytes.TrimSpace(data)
+	out := make([]byte, hex.DecodedLen(len(data)))
+	n, err := hex.Decode(out, data)
+	return out[:n], err
+}
+
+func decodeGzip(data []byte, maxSize int64) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return readLimited(r, maxSize)
+}
+
+func decodeZlib(data []byte, maxSize int64) ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return readLimited(r, maxSize)
+}
+
+// snappyStreamHeader starts data in the snappy framing format.
+var snappyStreamHeader = []byte("\xff\x06\x00\x00sNaPpY")
+
+// decodeSnappy decodes data in the snappy framing format or a snappy block.
+func decodeSnappy(data []byte, maxSize int64) ([]byte, error) {
+	if bytes.HasPrefix(data, snappyStreamHeader) {
+		return readLimited(snappy.NewReader(bytes.NewReader(data)), maxSize)
+	}
+
+	n, err := snappy.DecodedLen(data)
+	if err != nil {
+		return nil, err
+	}
+	if int64(n) > maxSize {
+		return nil, errTooLarge(maxSize)
+	}
+	return snappy.Decode(nil, data)
+}
+
+// readLimited reads r to the end. It fails if more than maxSize bytes are
+// read, to protect against decompression bombs.
+func readLimited(r io.Reader, maxSize int64) ([]byte, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(r, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, errTooLarge(maxSize)
+	}
+	return data, nil
+}
+
+func errTooLarge(maxSize int64) error {
+	return fmt.Errorf("decompressed data exceeds the max_decompressed_size of %d bytes", maxSize)
+}
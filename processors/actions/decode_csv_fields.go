@@ -0,0 +1,191 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/logp"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+type decodeCSVFields struct {
+	config decodeCSVFieldsConfig
+	opts   common.CSVOptions
+}
+
+type decodeCSVFieldsConfig struct {
+	Fields           []fromTo `config:"fields" validate:"required"`
+	Separator        string   `config:"separator"`
+	LazyQuotes       bool     `config:"lazy_quotes"`
+	TrimLeadingSpace bool     `config:"trim_leading_space"`
+	Columns          []string `config:"columns"`
+	OverwriteKeys    bool     `config:"overwrite_keys"`
+	IgnoreMissing    bool     `config:"ignore_missing"`
+	FailOnError      bool     `config:"fail_on_error"`
+}
+
+var defaultDecodeCSVFieldsConfig = decodeCSVFieldsConfig{
+	Separator:   ",",
+	FailOnError: true,
+}
+
+func (c *decodeCSVFieldsConfig) Validate() error {
+	if utf8.RuneCountInString(c.Separator) != 1 {
+		return fmt.Errorf("separator must be a single character, got '%v'", c.Separator)
+	}
+	switch c.Separator {
+	case `"`, "\r", "\n", string(utf8.RuneError):
+		return fmt.Errorf("invalid separator '%v'", c.Separator)
+	}
+
+	seen := map[string]bool{}
+	for _, column := range c.Columns {
+		if column == "" {
+			return errors.New("column names must not be empty")
+		}
+		if seen[column] {
+			return fmt.Errorf("duplicate column '%v'", column)
+		}
+		seen[column] = true
+	}
+
+	for _, field := range c.Fields {
+		if field.From == "" {
+			return errors.New("the field to decode must not be empty")
+		}
+		if field.To == "" && len(c.Columns) == 0 {
+			return fmt.Errorf("no target for field '%v', columns are required to decode into the root of the event", field.From)
+		}
+	}
+	return nil
+}
+
+func init() {
+	processors.RegisterPlugin("decode_csv_fields",
+		configChecked(newDecodeCSVFields,
+			requireFields("fields"),
+			allowedFields("fields", "separator", "lazy_quotes", "trim_leading_space",
+				"columns", "overwrite_keys", "ignore_missing", "fail_on_error", "when")))
+}
+
+func newDecodeCSVFields(c *common.Config) (processors.Processor, error) {
+	config := defaultDecodeCSVFieldsConfig
+	err := c.Unpack(&config)
+	if err != nil {
+		return nil, fmt.Errorf("fail to unpack the decode_csv_fields configuration: %s", err)
+	}
+
+	comma, _ := utf8.DecodeRuneInString(config.Separator)
+	return &decodeCSVFields{
+		config: config,
+		opts: common.CSVOptions{
+			Comma:            comma,
+			LazyQuotes:       config.LazyQuotes,
+			TrimLeadingSpace: config.TrimLeadingSpace,
+		},
+	}, nil
+}
+
+func (f *decodeCSVFields) Run(event *beat.Event) (*beat.Event, error) {
+	var backup common.MapStr
+	// Creates a copy of the event to revert in case of failure
+	if f.config.FailOnError {
+		backup = event.Fields.Clone()
+	}
+
+	for _, field := range f.config.Fields {
+		err := f.decodeField(field.From, field.To, event.Fields)
+		if err != nil {
+			if f.config.FailOnError {
+				logp.Debug("decode_csv_fields", "Failed to decode fields, revert to old event: %s", err)
+				event.Fields = backup
+				return event, err
+			}
+			logp.Debug("decode_csv_fields", "Failed to decode field %s: %s", field.From, err)
+		}
+	}
+
+	return event, nil
+}
+
+func (f *decodeCSVFields) decodeField(from, to string, fields common.MapStr) error {
+	value, err := fields.GetValue(from)
+	if err != nil {
+		if f.config.IgnoreMissing && errors.Cause(err) == common.ErrKeyNotFound {
+			return nil
+		}
+		return fmt.Errorf("could not fetch value for key: %s, Error: %s", from, err)
+	}
+
+	text, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("field %s is not a string, value: `%v`", from, value)
+	}
+
+	values, err := common.ParseCSVLine(text, f.opts)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decode CSV in field %s", from)
+	}
+
+	if len(f.config.Columns) == 0 {
+		if err := f.checkTarget(to, from, fields); err != nil {
+			return err
+		}
+		_, err = fields.Put(to, values)
+		return err
+	}
+
+	if len(values) != len(f.config.Columns) {
+		return fmt.Errorf("field %s has %d values, expected %d columns", from, len(values), len(f.config.Columns))
+	}
+	for i, column := range f.config.Columns {
+		key := column
+		if to != "" {
+			key = to + "." + column
+		}
+		if err := f.checkTarget(key, from, fields); err != nil {
+			return err
+		}
+		if _, err := fields.Put(key, values[i]); err != nil {
+			return fmt.Errorf("could not put value: %s: %v, %+v", key, values[i], err)
+		}
+	}
+	return nil
+}
+
+// checkTarget fails if the key already exists and existing keys must not be
+// overwritten. Decoding a field into itself is always allowed.
+func (f *decodeCSVFields) checkTarget(key, from string, fields common.MapStr) error {
+	if f.config.OverwriteKeys || key == from {
+		return nil
+	}
+	if exists, _ := fields.HasKey(key); exists {
+		return fmt.Errorf("target field %s already exists, set overwrite_keys or drop or rename this field first", key)
+	}
+	return nil
+}
+
+func (f *decodeCSVFields) String() string {
+	return "decode_csv_fields=" + fmt.Sprintf("%+v", f.config.Fields)
+}
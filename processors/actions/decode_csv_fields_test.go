@@ -0,0 +1,205 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+)
+
+func TestDecodeCSVFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]interface{}
+		input    common.MapStr
+		expected common.MapStr
+		fail     bool
+	}{
+		{
+			name: "array",
+			config: map[string]interface{}{
+				"fields": []map[string]string{{"from": "message", "to": "csv"}},
+			},
+			input: common.MapStr{"message": `a,"b,c",d`},
+			expected: common.MapStr{
+				"message": `a,"b,c",d`,
+				"csv":     []string{"a", "b,c", "d"},
+			},
+		},
+		{
+			name: "separator and trimming",
+			config: map[string]interface{}{
+				"fields":             []map[string]string{{"from": "message", "to": "message"}},
+				"separator":          ";",
+				"trim_leading_space": true,
+			},
+			input:    common.MapStr{"message": `a; "b;c"`},
+			expected: common.MapStr{"message": []string{"a", "b;c"}},
+		},
+		{
+			name: "columns",
+			config: map[string]interface{}{
+				"fields":  []map[string]string{{"from": "message", "to": "firewall"}},
+				"columns": []string{"action", "source", "port"},
+			},
+			input: common.MapStr{"message": "deny,10.0.0.1,22"},
+			expected: common.MapStr{
+				"message": "deny,10.0.0.1,22",
+				"firewall": common.MapStr{
+					"action": "deny",
+					"source": "10.0.0.1",
+					"port":   "22",
+				},
+			},
+		},
+		{
+			name: "columns into root",
+			config: map[string]interface{}{
+				"fields":  []map[string]string{{"from": "message", "to": ""}},
+				"columns": []string{"action", "port"},
+			},
+			input: common.MapStr{"message": "deny,22"},
+			expected: common.MapStr{
+				"message": "deny,22",
+				"action":  "deny",
+				"port":    "22",
+			},
+		},
+		{
+			name: "column count mismatch",
+			config: map[string]interface{}{
+				"fields":  []map[string]string{{"from": "message", "to": "firewall"}},
+				"columns": []string{"action", "port"},
+			},
+			input:    common.MapStr{"message": "deny,10.0.0.1,22"},
+			expected: common.MapStr{"message": "deny,10.0.0.1,22"},
+			fail:     true,
+		},
+		{
+			name: "target exists",
+			config: map[string]interface{}{
+				"fields": []map[string]string{{"from": "message", "to": "csv"}},
+			},
+			input:    common.MapStr{"message": "a,b", "csv": "old"},
+			expected: common.MapStr{"message": "a,b", "csv": "old"},
+			fail:     true,
+		},
+		{
+			name: "overwrite keys",
+			config: map[string]interface{}{
+				"fields":         []map[string]string{{"from": "message", "to": "csv"}},
+				"overwrite_keys": true,
+			},
+			input:    common.MapStr{"message": "a,b", "csv": "old"},
+			expected: common.MapStr{"message": "a,b", "csv": []string{"a", "b"}},
+		},
+		{
+			name: "revert on error",
+			config: map[string]interface{}{
+				"fields": []map[string]string{
+					{"from": "message", "to": "csv"},
+					{"from": "missing", "to": "other"},
+				},
+			},
+			input:    common.MapStr{"message": "a,b"},
+			expected: common.MapStr{"message": "a,b"},
+			fail:     true,
+		},
+		{
+			name: "ignore missing",
+			config: map[string]interface{}{
+				"fields": []map[string]string{
+					{"from": "message", "to": "csv"},
+					{"from": "missing", "to": "other"},
+				},
+				"ignore_missing": true,
+			},
+			input:    common.MapStr{"message": "a,b"},
+			expected: common.MapStr{"message": "a,b", "csv": []string{"a", "b"}},
+		},
+		{
+			name: "continue on error",
+			config: map[string]interface{}{
+				"fields": []map[string]string{
+					{"from": "message", "to": "csv"},
+					{"from": "invalid", "to": "other"},
+				},
+				"fail_on_error": false,
+			},
+			input: common.MapStr{"message": "a,b", "invalid": `a,b"c`},
+			expected: common.MapStr{
+				"message": "a,b",
+				"invalid": `a,b"c`,
+				"csv":     []string{"a", "b"},
+			},
+		},
+		{
+			name: "lazy quotes",
+			config: map[string]interface{}{
+				"fields":      []map[string]string{{"from": "message", "to": "csv"}},
+				"lazy_quotes": true,
+			},
+			input:    common.MapStr{"message": `a,b"c`},
+			expected: common.MapStr{"message": `a,b"c`, "csv": []string{"a", `b"c`}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, err := common.NewConfigFrom(test.config)
+			require.NoError(t, err)
+
+			p, err := newDecodeCSVFields(c)
+			require.NoError(t, err)
+
+			event, err := p.Run(&beat.Event{Fields: test.input})
+			if test.fail {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, event.Fields)
+		})
+	}
+}
+
+func TestDecodeCSVFieldsConfig(t *testing.T) {
+	fields := []map[string]string{{"from": "message", "to": "csv"}}
+	tests := map[string]map[string]interface{}{
+		"no fields":           {"separator": ","},
+		"long separator":      {"fields": fields, "separator": ";;"},
+		"quote separator":     {"fields": fields, "separator": `"`},
+		"duplicate column":    {"fields": fields, "columns": []string{"a", "a"}},
+		"root without column": {"fields": []map[string]string{{"from": "message", "to": ""}}},
+	}
+
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, err := common.NewConfigFrom(config)
+			require.NoError(t, err)
+
+			_, err = newDecodeCSVFields(c)
+			assert.Error(t, err)
+		})
+	}
+}
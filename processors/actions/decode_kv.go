@@ -0,0 +1,304 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/jsontransform"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+type decodeKV struct {
+	config  decodeKVConfig
+	include map[string]struct{}
+	exclude map[string]struct{}
+}
+
+type decodeKVConfig struct {
+	Field         string   `config:"field"`
+	FieldSplit    string   `config:"field_split"`
+	ValueSplit    string   `config:"value_split"`
+	QuoteChars    string   `config:"quote_chars"`
+	TrimKey       string   `config:"trim_key"`
+	TrimValue     string   `config:"trim_value"`
+	IncludeKeys   []string `config:"include_keys"`
+	ExcludeKeys   []string `config:"exclude_keys"`
+	Prefix        string   `config:"prefix"`
+	Target        string   `config:"target"`
+	ConvertTypes  bool     `config:"convert_types"`
+	OverwriteKeys bool     `config:"overwrite_keys"`
+}
+
+var defaultDecodeKVConfig = decodeKVConfig{
+	Field:      "message",
+	FieldSplit: " ",
+	ValueSplit: "=",
+	QuoteChars: `"'`,
+}
+
+func (c *decodeKVConfig) Validate() error {
+	if c.FieldSplit == "" {
+		return errors.New("field_split must not be empty")
+	}
+	if c.ValueSplit == "" {
+		return errors.New("value_split must not be empty")
+	}
+	if c.FieldSplit == c.ValueSplit {
+		return errors.New("field_split and value_split must be different")
+	}
+	if strings.ContainsAny(c.QuoteChars, c.FieldSplit+c.ValueSplit) {
+		return errors.New("quote_chars must not contain the field_split or value_split characters")
+	}
+	return nil
+}
+
+func init() {
+	processors.RegisterPlugin("decode_kv",
+		configChecked(newDecodeKV,
+			allowedFields("field", "field_split", "value_split", "quote_chars",
+				"trim_key", "trim_value", "include_keys", "exclude_keys", "prefix",
+				"target", "convert_types", "overwrite_keys", "when")))
+}
+
+func newDecodeKV(c *common.Config) (processors.Processor, error) {
+	config := defaultDecodeKVConfig
+	err := c.Unpack(&config)
+	if err != nil {
+		return nil, fmt.Errorf("fail to unpack the decode_kv configuration: %s", err)
+	}
+
+	return &decodeKV{
+		config:  config,
+		include: stringSet(config.IncludeKeys),
+		exclude: stringSet(config.ExcludeKeys),
+	}, nil
+}
+
+func stringSet(values []string) map[string]struct{} {
+	if len(values) == 0 {
+		return nil
+	}
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return set
+}
+
+func (p *decodeKV) Run(event *beat.Event) (*beat.Event, error) {
+	data, err := event.GetValue(p.config.Field)
+	if err != nil {
+		if errors.Cause(err) == common.ErrKeyNotFound {
+			return event, nil
+		}
+		return event, err
+	}
+
+	text, ok := data.(string)
+	if !ok {
+		// ignore non string fields, like decode_json_fields does
+		return event, nil
+	}
+
+	keys := p.decode(text)
+	if len(keys) == 0 {
+		return event, nil
+	}
+
+	if p.config.Target == "" {
+		jsontransform.WriteJSONKeys(event, keys, p.config.OverwriteKeys)
+		return event, nil
+	}
+
+	current, err := event.GetValue(p.config.Target)
+	if err != nil {
+		if errors.Cause(err) != common.ErrKeyNotFound {
+			return event, err
+		}
+		_, err = event.PutValue(p.config.Target, common.MapStr(keys))
+		return event, err
+	}
+
+	var m common.MapStr
+	switch v := current.(type) {
+	case common.MapStr:
+		m = v
+	case map[string]interface{}:
+		m = common.MapStr(v)
+	default:
+		if !p.config.OverwriteKeys {
+			return event, fmt.Errorf("cannot decode into target field `%s`, it is not an object", p.config.Target)
+		}
+		_, err = event.PutValue(p.config.Target, common.MapStr(keys))
+		return event, err
+	}
+
+	for k, v := range keys {
+		if _, exists := m[k]; exists && !p.config.OverwriteKeys {
+			continue
+		}
+		m[k] = v
+	}
+	return event, nil
+}
+
+// decode splits the text in key-value pairs. Pairs without a value separator
+// are skipped, and when a key appears more than once the last value wins.
+func (p *decodeKV) decode(text string) map[string]interface{} {
+	keys := map[string]interface{}{}
+	for _, pair := range p.split(text, p.config.FieldSplit, -1) {
+		parts := p.split(pair, p.config.ValueSplit, 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		key, _ := p.unquote(strings.Trim(parts[0], p.config.TrimKey))
+		if key == "" || !p.keep(key) {
+			continue
+		}
+
+		value, quoted := p.unquote(strings.Trim(parts[1], p.config.TrimValue))
+		if p.config.ConvertTypes && !quoted {
+			keys[p.config.Prefix+key] = inferType(value)
+		} else {
+			keys[p.config.Prefix+key] = value
+		}
+	}
+	return keys
+}
+
+func (p *decodeKV) keep(key string) bool {
+	if p.include != nil {
+		if _, ok := p.include[key]; !ok {
+			return false
+		}
+	}
+	_, excluded := p.exclude[key]
+	return !excluded
+}
+
+// split splits s around sep, ignoring separators inside quoted strings.
+// Empty parts are dropped. At most n parts are returned if n > 0.
+func (p *decodeKV) split(s, sep string, n int) []string {
+	var parts []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case strings.IndexByte(p.config.QuoteChars, c) >= 0 && p.opensQuote(s[start:i]):
+			quote = c
+		case strings.HasPrefix(s[i:], sep) && (n <= 0 || len(parts) < n-1):
+			if i > start {
+				parts = append(parts, s[start:i])
+			} else if n > 0 {
+				parts = append(parts, "")
+			}
+			i += len(sep) - 1
+			start = i + 1
+		}
+	}
+	if start < len(s) {
+		parts = append(parts, s[start:])
+	} else if n > 0 && len(parts) > 0 {
+		parts = append(parts, "")
+	}
+	return parts
+}
+
+// opensQuote reports whether a quote character following prefix starts a
+// quoted string. Quotes are only recognized at the beginning of a key or a
+// value, so apostrophes inside of words are kept as is.
+func (p *decodeKV) opensQuote(prefix string) bool {
+	return prefix == "" || strings.HasSuffix(prefix, p.config.ValueSplit)
+}
+
+// unquote removes the surrounding quotes of s and resolves backslash escapes
+// in it. It reports whether s was quoted.
+func (p *decodeKV) unquote(s string) (string, bool) {
+	if len(s) < 2 || s[0] != s[len(s)-1] || strings.IndexByte(p.config.QuoteChars, s[0]) < 0 {
+		return s, false
+	}
+
+	quote := s[0]
+	s = s[1 : len(s)-1]
+	if strings.IndexByte(s, '\\') < 0 {
+		return s, true
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i+1 == len(s) {
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'r':
+			b.WriteByte('\r')
+		case '\\', quote:
+			b.WriteByte(s[i])
+		default:
+			b.WriteByte('\\')
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String(), true
+}
+
+// inferType converts unquoted values to integers, floats or booleans when
+// possible.
+func inferType(s string) interface{} {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil && !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return f
+	}
+	switch s {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	return s
+}
+
+func (p *decodeKV) String() string {
+	return fmt.Sprintf("decode_kv=[field=%s, field_split=%q, value_split=%q, target=%s, prefix=%s]",
+		p.config.Field, p.config.FieldSplit, p.config.ValueSplit, p.config.Target, p.config.Prefix)
+}
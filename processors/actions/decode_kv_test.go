@@ -0,0 +1,220 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+)
+
+func TestDecodeKV(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]interface{}
+		input    common.MapStr
+		expected common.MapStr
+	}{
+		{
+			name:   "logfmt",
+			config: map[string]interface{}{},
+			input: common.MapStr{
+				"message": `level=info msg="hello world" took=1.5`,
+			},
+			expected: common.MapStr{
+				"message": `level=info msg="hello world" took=1.5`,
+				"level":   "info",
+				"msg":     "hello world",
+				"took":    "1.5",
+			},
+		},
+		{
+			name:   "convert types",
+			config: map[string]interface{}{"convert_types": true},
+			input: common.MapStr{
+				"message": `count=3 took=1.5 ok=true id="42" name=web`,
+			},
+			expected: common.MapStr{
+				"message": `count=3 took=1.5 ok=true id="42" name=web`,
+				"count":   int64(3),
+				"took":    1.5,
+				"ok":      true,
+				"id":      "42",
+				"name":    "web",
+			},
+		},
+		{
+			name: "custom splitters and trimming",
+			config: map[string]interface{}{
+				"field":       "log",
+				"field_split": ", ",
+				"value_split": ":",
+				"trim_value":  "[]",
+				"target":      "kv",
+			},
+			input: common.MapStr{
+				"log": `a:[1], b:"x, y", c:`,
+			},
+			expected: common.MapStr{
+				"log": `a:[1], b:"x, y", c:`,
+				"kv": common.MapStr{
+					"a": "1",
+					"b": "x, y",
+					"c": "",
+				},
+			},
+		},
+		{
+			name: "quotes and escapes",
+			config: map[string]interface{}{
+				"target": "kv",
+			},
+			input: common.MapStr{
+				"message": `"my key"='v v' x="a \"b\"" it=it's bare`,
+			},
+			expected: common.MapStr{
+				"message": `"my key"='v v' x="a \"b\"" it=it's bare`,
+				"kv": common.MapStr{
+					"my key": "v v",
+					"x":      `a "b"`,
+					"it":     "it's",
+				},
+			},
+		},
+		{
+			name: "include and exclude keys with prefix",
+			config: map[string]interface{}{
+				"include_keys": []string{"a", "b"},
+				"exclude_keys": []string{"b"},
+				"prefix":       "kv_",
+			},
+			input: common.MapStr{
+				"message": "a=1 b=2 c=3",
+			},
+			expected: common.MapStr{
+				"message": "a=1 b=2 c=3",
+				"kv_a":    "1",
+			},
+		},
+		{
+			name:   "keep existing keys",
+			config: map[string]interface{}{},
+			input: common.MapStr{
+				"message": "a=1 b=2",
+				"a":       "old",
+			},
+			expected: common.MapStr{
+				"message": "a=1 b=2",
+				"a":       "old",
+				"b":       "2",
+			},
+		},
+		{
+			name:   "overwrite keys",
+			config: map[string]interface{}{"overwrite_keys": true},
+			input: common.MapStr{
+				"message": "a=1 b=2",
+				"a":       "old",
+			},
+			expected: common.MapStr{
+				"message": "a=1 b=2",
+				"a":       "1",
+				"b":       "2",
+			},
+		},
+		{
+			name:   "merge into existing target",
+			config: map[string]interface{}{"target": "kv"},
+			input: common.MapStr{
+				"message": "a=1 b=2",
+				"kv":      common.MapStr{"a": "old", "z": "0"},
+			},
+			expected: common.MapStr{
+				"message": "a=1 b=2",
+				"kv":      common.MapStr{"a": "old", "b": "2", "z": "0"},
+			},
+		},
+		{
+			name:   "missing field",
+			config: map[string]interface{}{},
+			input: common.MapStr{
+				"other": "a=1",
+			},
+			expected: common.MapStr{
+				"other": "a=1",
+			},
+		},
+		{
+			name:   "field not string",
+			config: map[string]interface{}{},
+			input: common.MapStr{
+				"message": 42,
+			},
+			expected: common.MapStr{
+				"message": 42,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, err := common.NewConfigFrom(test.config)
+			require.NoError(t, err)
+
+			p, err := newDecodeKV(c)
+			require.NoError(t, err)
+
+			event, err := p.Run(&beat.Event{Fields: test.input})
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, event.Fields)
+		})
+	}
+}
+
+func TestDecodeKVTargetNotObject(t *testing.T) {
+	c, err := common.NewConfigFrom(map[string]interface{}{"target": "kv"})
+	require.NoError(t, err)
+
+	p, err := newDecodeKV(c)
+	require.NoError(t, err)
+
+	_, err = p.Run(&beat.Event{Fields: common.MapStr{"message": "a=1", "kv": "value"}})
+	assert.Error(t, err)
+}
+
+func TestDecodeKVConfig(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"empty field_split":   {"field_split": ""},
+		"same splitters":      {"field_split": "=", "value_split": "="},
+		"quote is a splitter": {"field_split": `"`},
+	}
+
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, err := common.NewConfigFrom(config)
+			require.NoError(t, err)
+
+			_, err = newDecodeKV(c)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,247 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+)
+
+func gzipped(t *testing.T, data string) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func zlibbed(t *testing.T, data string) []byte {
+	var buf bytes.Buffer
+	w := zlib.NewWriter(&buf)
+	_, err := w.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func snappyStream(t *testing.T, data string) []byte {
+	var buf bytes.Buffer
+	w := snappy.NewBufferedWriter(&buf)
+	_, err := w.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestDecode(t *testing.T) {
+	payload := `{"user":{"name":"alice"},"count":2}`
+
+	tests := []struct {
+		name     string
+		config   map[string]interface{}
+		input    common.MapStr
+		expected common.MapStr
+		fail     bool
+	}{
+		{
+			name:     "base64",
+			config:   map[string]interface{}{"field": "message", "decoders": []string{"base64"}},
+			input:    common.MapStr{"message": "aGVsbG8gd29ybGQ="},
+			expected: common.MapStr{"message": "hello world"},
+		},
+		{
+			name:     "base64 without padding",
+			config:   map[string]interface{}{"field": "message", "decoders": []string{"base64"}},
+			input:    common.MapStr{"message": "aGVsbG8gd29ybGQ"},
+			expected: common.MapStr{"message": "hello world"},
+		},
+		{
+			name:     "base64url",
+			config:   map[string]interface{}{"field": "message", "decoders": []string{"base64url"}},
+			input:    common.MapStr{"message": base64.URLEncoding.EncodeToString([]byte("a?b>c"))},
+			expected: common.MapStr{"message": "a?b>c"},
+		},
+		{
+			name: "hex to target",
+			config: map[string]interface{}{
+				"field":    "message",
+				"target":   "decoded",
+				"decoders": []string{"hex"},
+			},
+			input:    common.MapStr{"message": hex.EncodeToString([]byte("hello"))},
+			expected: common.MapStr{"message": "68656c6c6f", "decoded": "hello"},
+		},
+		{
+			name:     "gzip and base64",
+			config:   map[string]interface{}{"field": "message", "decoders": []string{"base64", "gzip"}},
+			input:    common.MapStr{"message": base64.StdEncoding.EncodeToString(gzipped(t, "hello"))},
+			expected: common.MapStr{"message": "hello"},
+		},
+		{
+			name:     "zlib",
+			config:   map[string]interface{}{"field": "message", "decoders": []string{"base64", "zlib"}},
+			input:    common.MapStr{"message": base64.StdEncoding.EncodeToString(zlibbed(t, "hello"))},
+			expected: common.MapStr{"message": "hello"},
+		},
+		{
+			name:     "snappy block",
+			config:   map[string]interface{}{"field": "message", "decoders": []string{"base64", "snappy"}},
+			input:    common.MapStr{"message": base64.StdEncoding.EncodeToString(snappy.Encode(nil, []byte("hello")))},
+			expected: common.MapStr{"message": "hello"},
+		},
+		{
+			name:     "snappy stream",
+			config:   map[string]interface{}{"field": "message", "decoders": []string{"base64", "snappy"}},
+			input:    common.MapStr{"message": base64.StdEncoding.EncodeToString(snappyStream(t, "hello"))},
+			expected: common.MapStr{"message": "hello"},
+		},
+		{
+			name: "json",
+			config: map[string]interface{}{
+				"field":    "message",
+				"target":   "payload",
+				"decoders": []string{"base64", "gzip"},
+				"json":     map[string]interface{}{},
+			},
+			input: common.MapStr{"message": base64.StdEncoding.EncodeToString(gzipped(t, payload))},
+			expected: common.MapStr{
+				"message": base64.StdEncoding.EncodeToString(gzipped(t, payload)),
+				"payload": map[string]interface{}{
+					"user":  map[string]interface{}{"name": "alice"},
+					"count": int64(2),
+				},
+			},
+		},
+		{
+			name: "json into root",
+			config: map[string]interface{}{
+				"field":    "message",
+				"target":   "",
+				"decoders": []string{"base64"},
+				"json":     map[string]interface{}{"overwrite_keys": true},
+			},
+			input: common.MapStr{"message": base64.StdEncoding.EncodeToString([]byte(payload)), "count": 1},
+			expected: common.MapStr{
+				"message": base64.StdEncoding.EncodeToString([]byte(payload)),
+				"user":    map[string]interface{}{"name": "alice"},
+				"count":   int64(2),
+			},
+		},
+		{
+			name: "ignore missing",
+			config: map[string]interface{}{
+				"field":          "message",
+				"decoders":       []string{"base64"},
+				"ignore_missing": true,
+			},
+			input:    common.MapStr{"other": "a"},
+			expected: common.MapStr{"other": "a"},
+		},
+		{
+			name:     "missing",
+			config:   map[string]interface{}{"field": "message", "decoders": []string{"base64"}},
+			input:    common.MapStr{"other": "a"},
+			expected: common.MapStr{"other": "a"},
+			fail:     true,
+		},
+		{
+			name:     "invalid base64",
+			config:   map[string]interface{}{"field": "message", "decoders": []string{"base64"}},
+			input:    common.MapStr{"message": "not base64!"},
+			expected: common.MapStr{"message": "not base64!"},
+			fail:     true,
+		},
+		{
+			name:     "binary result",
+			config:   map[string]interface{}{"field": "message", "decoders": []string{"base64"}},
+			input:    common.MapStr{"message": base64.StdEncoding.EncodeToString([]byte{0xff, 0xfe})},
+			expected: common.MapStr{"message": "//4="},
+			fail:     true,
+		},
+		{
+			name: "decompression bomb",
+			config: map[string]interface{}{
+				"field":                 "message",
+				"decoders":              []string{"base64", "gzip"},
+				"max_decompressed_size": "1KiB",
+			},
+			input:    common.MapStr{"message": base64.StdEncoding.EncodeToString(gzipped(t, string(make([]byte, 1025))))},
+			expected: common.MapStr{"message": base64.StdEncoding.EncodeToString(gzipped(t, string(make([]byte, 1025))))},
+			fail:     true,
+		},
+		{
+			name: "snappy bomb",
+			config: map[string]interface{}{
+				"field":                 "message",
+				"decoders":              []string{"base64", "snappy"},
+				"max_decompressed_size": "1KiB",
+			},
+			input:    common.MapStr{"message": base64.StdEncoding.EncodeToString(snappy.Encode(nil, make([]byte, 1025)))},
+			expected: common.MapStr{"message": base64.StdEncoding.EncodeToString(snappy.Encode(nil, make([]byte, 1025)))},
+			fail:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config, err := common.NewConfigFrom(test.config)
+			require.NoError(t, err)
+
+			p, err := newDecode(config)
+			require.NoError(t, err)
+
+			event, err := p.Run(&beat.Event{Fields: test.input})
+			if test.fail {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, event.Fields)
+		})
+	}
+}
+
+func TestDecodeConfig(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"unknown decoder": {"field": "message", "decoders": []string{"rot13"}},
+		"no decoders":     {"field": "message"},
+		"no field":        {"decoders": []string{"base64"}},
+		"root needs json": {"field": "message", "target": "", "decoders": []string{"base64"}},
+		"invalid size":    {"field": "message", "decoders": []string{"gzip"}, "max_decompressed_size": "lots"},
+	}
+
+	for name, settings := range tests {
+		config, err := common.NewConfigFrom(settings)
+		require.NoError(t, err)
+
+		_, err = newDecode(config)
+		assert.Error(t, err, name)
+	}
+}
@@ -0,0 +1,242 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/atomic"
+	"github.com/njcx/libbeat_v6/common/cfgtype"
+	"github.com/njcx/libbeat_v6/logp"
+	"github.com/njcx/libbeat_v6/monitoring"
+	"github.com/njcx/libbeat_v6/outputs/codec"
+	_ "github.com/njcx/libbeat_v6/outputs/codec/format" // Register codecs.
+	_ "github.com/njcx/libbeat_v6/outputs/codec/json"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+const (
+	sizeLimitDrop     = "drop"
+	sizeLimitTruncate = "truncate"
+	sizeLimitTag      = "tag"
+)
+
+var sizeLimitInstanceID atomic.Uint32
+
+type eventSizeLimit struct {
+	config      eventSizeLimitConfig
+	info        beat.Info
+	stats       eventSizeLimitStats
+	metricsName string
+	closeOnce   sync.Once
+
+	// encoders holds idle encoders, encoders are not safe for concurrent use.
+	encoders sync.Pool
+}
+
+type eventSizeLimitConfig struct {
+	MaxBytes       cfgtype.ByteSize `config:"max_bytes" validate:"min=1"`
+	Action         string           `config:"action"`
+	TruncateFields []string         `config:"truncate.fields"`
+	Tag            string           `config:"tag"`
+	Codec          codec.Config     `config:"codec"`
+}
+
+type eventSizeLimitStats struct {
+	oversized *monitoring.Int
+	dropped   *monitoring.Int
+	truncated *monitoring.Int
+	tagged    *monitoring.Int
+}
+
+var defaultEventSizeLimitConfig = eventSizeLimitConfig{
+	Action:         sizeLimitDrop,
+	TruncateFields: []string{"message"},
+	Tag:            "oversized",
+}
+
+func (c *eventSizeLimitConfig) Validate() error {
+	c.Action = strings.ToLower(c.Action)
+	switch c.Action {
+	case sizeLimitDrop:
+	case sizeLimitTruncate:
+		if len(c.TruncateFields) == 0 {
+			return errors.New("truncate.fields can not be empty if the action is truncate")
+		}
+	case sizeLimitTag:
+		if c.Tag == "" {
+			return errors.New("tag can not be empty if the action is tag")
+		}
+	default:
+		return fmt.Errorf("invalid event_size_limit action '%v' (valid values are: drop, truncate, tag)", c.Action)
+	}
+	return nil
+}
+
+func init() {
+	processors.RegisterPlugin("event_size_limit",
+		configChecked(newEventSizeLimit,
+			requireFields("max_bytes"),
+			allowedFields("max_bytes", "action", "truncate", "tag", "codec", "when")))
+}
+
+func newEventSizeLimit(c *common.Config) (processors.Processor, error) {
+	config := defaultEventSizeLimitConfig
+	err := c.Unpack(&config)
+	if err != nil {
+		return nil, fmt.Errorf("fail to unpack the event_size_limit configuration: %s", err)
+	}
+
+	// Check the codec configuration, encoders are created with the beat
+	// information once the processor is used.
+	if _, err := codec.CreateEncoder(beat.Info{}, config.Codec); err != nil {
+		return nil, errors.Wrap(err, "failed to create the event_size_limit codec")
+	}
+
+	id := int(sizeLimitInstanceID.Inc())
+	metricsName := "processor.event_size_limit." + strconv.Itoa(id)
+	reg := monitoring.Default.NewRegistry(metricsName, monitoring.DoNotReport)
+	return &eventSizeLimit{
+		config:      config,
+		metricsName: metricsName,
+		stats: eventSizeLimitStats{
+			oversized: monitoring.NewInt(reg, "oversized"),
+			dropped:   monitoring.NewInt(reg, "dropped"),
+			truncated: monitoring.NewInt(reg, "truncated"),
+			tagged:    monitoring.NewInt(reg, "tagged"),
+		},
+	}, nil
+}
+
+func (p *eventSizeLimit) Run(event *beat.Event) (*beat.Event, error) {
+	size, err := p.size(event)
+	if err != nil {
+		return event, err
+	}
+
+	limit := int(p.config.MaxBytes)
+	if size <= limit {
+		return event, nil
+	}
+	p.stats.oversized.Inc()
+
+	switch p.config.Action {
+	case sizeLimitTag:
+		p.stats.tagged.Inc()
+		if err := common.AddTags(event.Fields, []string{p.config.Tag}); err != nil {
+			return event, errors.Wrap(err, "failed to tag oversized event")
+		}
+		return event, nil
+
+	case sizeLimitTruncate:
+		if p.truncate(event, size-limit) {
+			size, err = p.size(event)
+			if err != nil {
+				return event, err
+			}
+			if size <= limit {
+				p.stats.truncated.Inc()
+				markTruncated(event)
+				return event, nil
+			}
+		}
+		logp.Debug("event_size_limit", "Dropping event of %d bytes, truncating the fields is not sufficient", size)
+	}
+
+	p.stats.dropped.Inc()
+	return nil, nil
+}
+
+// SetBeatInfo sets the beat information used to encode the events, the same
+// way as the outputs do.
+func (p *eventSizeLimit) SetBeatInfo(info beat.Info) {
+	p.info = info
+}
+
+// size returns the encoded size of the event.
+func (p *eventSizeLimit) size(event *beat.Event) (int, error) {
+	encoder, ok := p.encoders.Get().(codec.Codec)
+	if !ok {
+		var err error
+		encoder, err = codec.CreateEncoder(p.info, p.config.Codec)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to create the event_size_limit codec")
+		}
+	}
+	defer p.encoders.Put(encoder)
+
+	data, err := encoder.Encode(p.info.Beat, event)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to encode event")
+	}
+	return len(data), nil
+}
+
+// truncate removes up to excess bytes from the configured fields, in order.
+// An encoded string is never shorter than the string, so removing excess
+// bytes removes at least excess encoded bytes. It returns false if no field
+// was truncated.
+func (p *eventSizeLimit) truncate(event *beat.Event, excess int) bool {
+	changed := false
+	for _, field := range p.config.TruncateFields {
+		if excess <= 0 {
+			break
+		}
+
+		value, err := event.GetValue(field)
+		if err != nil {
+			continue
+		}
+		text, ok := value.(string)
+		if !ok || text == "" {
+			continue
+		}
+
+		n := len(text) - excess
+		if n < 0 {
+			n = 0
+		}
+		truncated, _ := truncateBytes(text, n)
+		if _, err := event.PutValue(field, truncated); err != nil {
+			continue
+		}
+
+		changed = true
+		excess -= len(text) - len(truncated)
+	}
+	return changed
+}
+
+// Close removes the metrics of the processor.
+func (p *eventSizeLimit) Close() error {
+	p.closeOnce.Do(func() {
+		monitoring.Default.Remove(p.metricsName)
+	})
+	return nil
+}
+
+func (p *eventSizeLimit) String() string {
+	return fmt.Sprintf("event_size_limit=[max_bytes=%d, action=%s]", p.config.MaxBytes, p.config.Action)
+}
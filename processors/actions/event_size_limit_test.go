@@ -0,0 +1,244 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/monitoring"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+// formatCodec makes the encoded size of the test events the length of their
+// fields.
+const formatCodec = "%{[message]}%{[error.message]}"
+
+func TestEventSizeLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]interface{}
+		input    common.MapStr
+		expected common.MapStr
+		stats    map[string]int64
+	}{
+		{
+			name: "small event",
+			config: map[string]interface{}{
+				"max_bytes":           "10B",
+				"codec.format.string": formatCodec,
+			},
+			input:    common.MapStr{"message": "hello", "error": common.MapStr{"message": "fail"}},
+			expected: common.MapStr{"message": "hello", "error": common.MapStr{"message": "fail"}},
+			stats:    map[string]int64{},
+		},
+		{
+			name: "drop",
+			config: map[string]interface{}{
+				"max_bytes":           "8B",
+				"codec.format.string": formatCodec,
+			},
+			input: common.MapStr{"message": "hello", "error": common.MapStr{"message": "fail"}},
+			stats: map[string]int64{"oversized": 1, "dropped": 1},
+		},
+		{
+			name: "tag",
+			config: map[string]interface{}{
+				"max_bytes":           "8B",
+				"action":              "tag",
+				"codec.format.string": formatCodec,
+			},
+			input: common.MapStr{"message": "hello", "error": common.MapStr{"message": "fail"}},
+			expected: common.MapStr{
+				"message": "hello",
+				"error":   common.MapStr{"message": "fail"},
+				"tags":    []string{"oversized"},
+			},
+			stats: map[string]int64{"oversized": 1, "tagged": 1},
+		},
+		{
+			name: "truncate",
+			config: map[string]interface{}{
+				"max_bytes":           "8B",
+				"action":              "truncate",
+				"codec.format.string": formatCodec,
+			},
+			input: common.MapStr{"message": "hello", "error": common.MapStr{"message": "fail"}},
+			expected: common.MapStr{
+				"message": "hell",
+				"error":   common.MapStr{"message": "fail"},
+				"log":     common.MapStr{"flags": []string{"truncated"}},
+			},
+			stats: map[string]int64{"oversized": 1, "truncated": 1},
+		},
+		{
+			name: "truncate multiple fields",
+			config: map[string]interface{}{
+				"max_bytes":           "3B",
+				"action":              "truncate",
+				"truncate.fields":     []string{"message", "error.message"},
+				"codec.format.string": formatCodec,
+			},
+			input: common.MapStr{"message": "hello", "error": common.MapStr{"message": "fail"}},
+			expected: common.MapStr{
+				"message": "",
+				"error":   common.MapStr{"message": "fai"},
+				"log":     common.MapStr{"flags": []string{"truncated"}},
+			},
+			stats: map[string]int64{"oversized": 1, "truncated": 1},
+		},
+		{
+			name: "truncated fields too small",
+			config: map[string]interface{}{
+				"max_bytes":           "3B",
+				"action":              "truncate",
+				"codec.format.string": formatCodec,
+			},
+			input: common.MapStr{"message": "hello", "error": common.MapStr{"message": "fail"}},
+			stats: map[string]int64{"oversized": 1, "dropped": 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := newEventSizeLimit(common.MustNewConfigFrom(test.config))
+			require.NoError(t, err)
+			defer processors.Close(p)
+			limit := p.(*eventSizeLimit)
+
+			event, err := p.Run(&beat.Event{Fields: test.input})
+			assert.NoError(t, err)
+			if test.expected == nil {
+				assert.Nil(t, event)
+			} else if assert.NotNil(t, event) {
+				assert.Equal(t, test.expected, event.Fields)
+			}
+
+			assert.Equal(t, test.stats["oversized"], limit.stats.oversized.Get())
+			assert.Equal(t, test.stats["dropped"], limit.stats.dropped.Get())
+			assert.Equal(t, test.stats["truncated"], limit.stats.truncated.Get())
+			assert.Equal(t, test.stats["tagged"], limit.stats.tagged.Get())
+		})
+	}
+}
+
+func TestEventSizeLimitJSON(t *testing.T) {
+	p, err := newEventSizeLimit(common.MustNewConfigFrom(map[string]interface{}{
+		"max_bytes": "1KiB",
+		"action":    "truncate",
+	}))
+	require.NoError(t, err)
+	defer processors.Close(p)
+	limit := p.(*eventSizeLimit)
+
+	// Control characters are escaped by the JSON encoder and take more space
+	// encoded than in the event. Truncating the message by the excess
+	// encoded bytes is sufficient nevertheless.
+	event, err := p.Run(&beat.Event{Fields: common.MapStr{
+		"message": strings.Repeat("a\x01", 1000),
+	}})
+	require.NoError(t, err)
+	require.NotNil(t, event)
+
+	size, err := limit.size(event)
+	require.NoError(t, err)
+	assert.True(t, size <= 1024, "size %d", size)
+	assert.Equal(t, int64(1), limit.stats.truncated.Get())
+}
+
+func TestEventSizeLimitBeatInfo(t *testing.T) {
+	config := common.MustNewConfigFrom(map[string]interface{}{"max_bytes": "1KiB"})
+	event := &beat.Event{Fields: common.MapStr{"message": "hello"}}
+
+	p, err := newEventSizeLimit(config)
+	require.NoError(t, err)
+	defer processors.Close(p)
+	size, err := p.(*eventSizeLimit).size(event)
+	require.NoError(t, err)
+
+	// The beat name and version are part of the encoded metadata.
+	withInfo, err := newEventSizeLimit(config)
+	require.NoError(t, err)
+	defer processors.Close(withInfo)
+	processors.SetBeatInfo(withInfo, beat.Info{Beat: "testbeat", Version: "6.8.0"})
+	sizeWithInfo, err := withInfo.(*eventSizeLimit).size(event)
+	require.NoError(t, err)
+	assert.Equal(t, size+len("testbeat")+len("6.8.0"), sizeWithInfo)
+}
+
+func TestEventSizeLimitConcurrent(t *testing.T) {
+	p, err := newEventSizeLimit(common.MustNewConfigFrom(map[string]interface{}{
+		"max_bytes":           "8B",
+		"action":              "truncate",
+		"codec.format.string": formatCodec,
+	}))
+	require.NoError(t, err)
+	defer processors.Close(p)
+	limit := p.(*eventSizeLimit)
+
+	const workers, events = 8, 100
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < events; j++ {
+				event, err := p.Run(&beat.Event{Fields: common.MapStr{
+					"message": "hello",
+					"error":   common.MapStr{"message": "fail"},
+				}})
+				if assert.NoError(t, err) && assert.NotNil(t, event) {
+					assert.Equal(t, "hell", event.Fields["message"])
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int64(workers*events), limit.stats.truncated.Get())
+}
+
+func TestEventSizeLimitClose(t *testing.T) {
+	p, err := newEventSizeLimit(common.MustNewConfigFrom(map[string]interface{}{
+		"max_bytes": "1KiB",
+	}))
+	require.NoError(t, err)
+	limit := p.(*eventSizeLimit)
+
+	require.NotNil(t, monitoring.Default.GetRegistry(limit.metricsName))
+	assert.NoError(t, processors.Close(p))
+	assert.NoError(t, processors.Close(p))
+	assert.Nil(t, monitoring.Default.GetRegistry(limit.metricsName))
+}
+
+func TestEventSizeLimitConfig(t *testing.T) {
+	for _, settings := range []map[string]interface{}{
+		{"max_bytes": "1KiB", "action": "compress"},
+		{"max_bytes": "1KiB", "action": "tag", "tag": ""},
+		{"max_bytes": "1KiB", "codec.unknown": map[string]interface{}{}},
+	} {
+		_, err := newEventSizeLimit(common.MustNewConfigFrom(settings))
+		assert.Error(t, err, "%v", settings)
+	}
+}
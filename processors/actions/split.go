@@ -0,0 +1,161 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+type split struct {
+	config splitConfig
+}
+
+type splitConfig struct {
+	Field         string   `config:"field" validate:"required"`
+	Target        string   `config:"target"`
+	CopyFields    []string `config:"copy_fields"`
+	IgnoreMissing bool     `config:"ignore_missing"`
+}
+
+var errSplitNotSupported = errors.New("split requires a pipeline supporting multi-event processors, the event is not split")
+
+func init() {
+	processors.RegisterPlugin("split",
+		configChecked(newSplit,
+			requireFields("field"),
+			allowedFields("field", "target", "copy_fields", "ignore_missing", "when")))
+}
+
+func newSplit(c *common.Config) (processors.Processor, error) {
+	config := splitConfig{}
+	err := c.Unpack(&config)
+	if err != nil {
+		return nil, fmt.Errorf("fail to unpack the split configuration: %s", err)
+	}
+
+	// The elements replace the array by default.
+	if !c.HasField("target") {
+		config.Target = config.Field
+	}
+
+	return &split{config: config}, nil
+}
+
+// Run can not return multiple events. The event is returned unchanged.
+func (s *split) Run(event *beat.Event) (*beat.Event, error) {
+	return event, errSplitNotSupported
+}
+
+// RunMulti returns one event per element of the array in the configured
+// field. The event is returned unchanged if the field is missing, empty or not
+// an array.
+func (s *split) RunMulti(event *beat.Event) ([]*beat.Event, error) {
+	value, err := event.GetValue(s.config.Field)
+	if err != nil {
+		if s.config.IgnoreMissing && errors.Cause(err) == common.ErrKeyNotFound {
+			return []*beat.Event{event}, nil
+		}
+		return []*beat.Event{event}, fmt.Errorf("could not fetch value for key: %s, Error: %s", s.config.Field, err)
+	}
+
+	elements := reflect.ValueOf(value)
+	if elements.Kind() != reflect.Slice && elements.Kind() != reflect.Array {
+		return []*beat.Event{event}, fmt.Errorf("field %s is not an array, value: `%v`", s.config.Field, value)
+	}
+	if elements.Len() == 0 {
+		return []*beat.Event{event}, nil
+	}
+
+	events := make([]*beat.Event, 0, elements.Len())
+	for i := 0; i < elements.Len(); i++ {
+		child := &beat.Event{
+			Timestamp: event.Timestamp,
+			Fields:    s.copyFields(event.Fields),
+		}
+		if event.Meta != nil {
+			child.Meta = event.Meta.Clone()
+		}
+		if err := s.putElement(child.Fields, elements.Index(i).Interface()); err != nil {
+			return []*beat.Event{event}, fmt.Errorf("failed to split field %s: %v", s.config.Field, err)
+		}
+		events = append(events, child)
+	}
+	return events, nil
+}
+
+// copyFields returns the fields of the parent event copied to every event.
+// All fields except the split field are copied if copy_fields is not set.
+func (s *split) copyFields(fields common.MapStr) common.MapStr {
+	if s.config.CopyFields == nil {
+		copied := fields.Clone()
+		copied.Delete(s.config.Field)
+		return copied
+	}
+
+	copied := common.MapStr{}
+	for _, key := range s.config.CopyFields {
+		value, err := fields.GetValue(key)
+		if err != nil {
+			continue
+		}
+		if m, ok := asMapStr(value); ok {
+			value = m.Clone()
+		}
+		copied.Put(key, value)
+	}
+	return copied
+}
+
+func (s *split) putElement(fields common.MapStr, element interface{}) error {
+	if s.config.Target != "" {
+		if m, ok := asMapStr(element); ok {
+			element = m.Clone()
+		}
+		_, err := fields.Put(s.config.Target, element)
+		return err
+	}
+
+	m, ok := asMapStr(element)
+	if !ok {
+		return fmt.Errorf("element `%v` is not an object and can not be stored in the root of the event", element)
+	}
+	fields.DeepUpdate(m.Clone())
+	return nil
+}
+
+func (s *split) String() string {
+	return fmt.Sprintf("split=[field=%s, target=%s]", s.config.Field, s.config.Target)
+}
+
+func asMapStr(v interface{}) (common.MapStr, bool) {
+	switch m := v.(type) {
+	case common.MapStr:
+		return m, true
+	case map[string]interface{}:
+		return common.MapStr(m), true
+	default:
+		return nil, false
+	}
+}
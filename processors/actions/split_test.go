@@ -0,0 +1,211 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]interface{}
+		input    common.MapStr
+		expected []common.MapStr
+		fail     bool
+	}{
+		{
+			name:   "default target",
+			config: map[string]interface{}{"field": "records"},
+			input: common.MapStr{
+				"bucket":  "logs",
+				"records": []interface{}{"a", "b"},
+			},
+			expected: []common.MapStr{
+				{"bucket": "logs", "records": "a"},
+				{"bucket": "logs", "records": "b"},
+			},
+		},
+		{
+			name: "target",
+			config: map[string]interface{}{
+				"field":  "aws.Records",
+				"target": "aws.record",
+			},
+			input: common.MapStr{
+				"aws": common.MapStr{
+					"region":  "eu-west-1",
+					"Records": []interface{}{map[string]interface{}{"eventName": "ConsoleLogin"}},
+				},
+			},
+			expected: []common.MapStr{
+				{"aws": common.MapStr{
+					"region": "eu-west-1",
+					"record": common.MapStr{"eventName": "ConsoleLogin"},
+				}},
+			},
+		},
+		{
+			name: "root target",
+			config: map[string]interface{}{
+				"field":  "records",
+				"target": "",
+			},
+			input: common.MapStr{
+				"bucket": "logs",
+				"records": []common.MapStr{
+					{"user": common.MapStr{"name": "alice"}},
+					{"user": common.MapStr{"name": "bob"}},
+				},
+			},
+			expected: []common.MapStr{
+				{"bucket": "logs", "user": common.MapStr{"name": "alice"}},
+				{"bucket": "logs", "user": common.MapStr{"name": "bob"}},
+			},
+		},
+		{
+			name: "copy fields",
+			config: map[string]interface{}{
+				"field":       "records",
+				"target":      "record",
+				"copy_fields": []string{"cloud.region", "missing"},
+			},
+			input: common.MapStr{
+				"message": "large payload",
+				"cloud":   common.MapStr{"region": "eu-west-1", "provider": "aws"},
+				"records": []interface{}{1, 2},
+			},
+			expected: []common.MapStr{
+				{"cloud": common.MapStr{"region": "eu-west-1"}, "record": 1},
+				{"cloud": common.MapStr{"region": "eu-west-1"}, "record": 2},
+			},
+		},
+		{
+			name:   "empty array",
+			config: map[string]interface{}{"field": "records"},
+			input:  common.MapStr{"records": []interface{}{}},
+			expected: []common.MapStr{
+				{"records": []interface{}{}},
+			},
+		},
+		{
+			name: "ignore missing",
+			config: map[string]interface{}{
+				"field":          "records",
+				"ignore_missing": true,
+			},
+			input:    common.MapStr{"message": "hello"},
+			expected: []common.MapStr{{"message": "hello"}},
+		},
+		{
+			name:     "missing",
+			config:   map[string]interface{}{"field": "records"},
+			input:    common.MapStr{"message": "hello"},
+			expected: []common.MapStr{{"message": "hello"}},
+			fail:     true,
+		},
+		{
+			name:     "not an array",
+			config:   map[string]interface{}{"field": "records"},
+			input:    common.MapStr{"records": "hello"},
+			expected: []common.MapStr{{"records": "hello"}},
+			fail:     true,
+		},
+		{
+			name: "root target requires objects",
+			config: map[string]interface{}{
+				"field":  "records",
+				"target": "",
+			},
+			input:    common.MapStr{"records": []interface{}{common.MapStr{"a": 1}, "b"}},
+			expected: []common.MapStr{{"records": []interface{}{common.MapStr{"a": 1}, "b"}}},
+			fail:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config, err := common.NewConfigFrom(test.config)
+			require.NoError(t, err)
+
+			p, err := newSplit(config)
+			require.NoError(t, err)
+
+			ts := time.Now()
+			events, err := p.(*split).RunMulti(&beat.Event{
+				Timestamp: ts,
+				Meta:      common.MapStr{"pipeline": "cloudtrail"},
+				Fields:    test.input,
+			})
+			if test.fail {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			var actual []common.MapStr
+			for _, event := range events {
+				assert.Equal(t, ts, event.Timestamp)
+				assert.Equal(t, common.MapStr{"pipeline": "cloudtrail"}, event.Meta)
+				actual = append(actual, event.Fields)
+			}
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestSplitEventsAreIndependent(t *testing.T) {
+	config, err := common.NewConfigFrom(map[string]interface{}{"field": "records"})
+	require.NoError(t, err)
+
+	p, err := newSplit(config)
+	require.NoError(t, err)
+
+	event := &beat.Event{Fields: common.MapStr{
+		"host":    common.MapStr{"name": "web-1"},
+		"records": []interface{}{"a", "b"},
+	}}
+	events, err := p.(*split).RunMulti(event)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	events[0].PutValue("host.name", "changed")
+	assert.Equal(t, "web-1", event.Fields["host"].(common.MapStr)["name"])
+	v, _ := events[1].GetValue("host.name")
+	assert.Equal(t, "web-1", v)
+}
+
+func TestSplitRun(t *testing.T) {
+	config, err := common.NewConfigFrom(map[string]interface{}{"field": "records"})
+	require.NoError(t, err)
+
+	p, err := newSplit(config)
+	require.NoError(t, err)
+
+	event := &beat.Event{Fields: common.MapStr{"records": []interface{}{"a", "b"}}}
+	out, err := p.Run(event)
+	assert.Error(t, err)
+	assert.Equal(t, event, out)
+}
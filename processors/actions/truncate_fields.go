@@ -0,0 +1,182 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/logp"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+const truncatedFlag = "truncated"
+
+type truncateFields struct {
+	config   truncateFieldsConfig
+	truncate func(string) (string, bool)
+}
+
+type truncateFieldsConfig struct {
+	Fields        []string `config:"fields" validate:"required"`
+	MaxBytes      int      `config:"max_bytes" validate:"min=0"`
+	MaxChars      int      `config:"max_characters" validate:"min=0"`
+	IgnoreMissing bool     `config:"ignore_missing"`
+	FailOnError   bool     `config:"fail_on_error"`
+}
+
+func (c *truncateFieldsConfig) Validate() error {
+	if (c.MaxBytes > 0) == (c.MaxChars > 0) {
+		return errors.New("exactly one of max_bytes or max_characters must be set")
+	}
+	return nil
+}
+
+func init() {
+	processors.RegisterPlugin("truncate_fields",
+		configChecked(newTruncateFields,
+			requireFields("fields"),
+			allowedFields("fields", "max_bytes", "max_characters", "ignore_missing", "fail_on_error", "when")))
+}
+
+func newTruncateFields(c *common.Config) (processors.Processor, error) {
+	config := truncateFieldsConfig{
+		FailOnError: true,
+	}
+	err := c.Unpack(&config)
+	if err != nil {
+		return nil, fmt.Errorf("fail to unpack the truncate_fields configuration: %s", err)
+	}
+
+	f := &truncateFields{config: config}
+	if config.MaxBytes > 0 {
+		f.truncate = func(s string) (string, bool) { return truncateBytes(s, config.MaxBytes) }
+	} else {
+		f.truncate = func(s string) (string, bool) { return truncateCharacters(s, config.MaxChars) }
+	}
+	return f, nil
+}
+
+func (f *truncateFields) Run(event *beat.Event) (*beat.Event, error) {
+	var backup common.MapStr
+	// Creates a copy of the event to revert in case of failure
+	if f.config.FailOnError {
+		backup = event.Fields.Clone()
+	}
+
+	truncated := false
+	for _, field := range f.config.Fields {
+		changed, err := f.truncateField(field, event.Fields)
+		if err != nil {
+			if f.config.FailOnError {
+				logp.Debug("truncate_fields", "Failed to truncate fields, revert to old event: %s", err)
+				event.Fields = backup
+				return event, err
+			}
+			logp.Debug("truncate_fields", "Failed to truncate field %s: %s", field, err)
+		}
+		truncated = truncated || changed
+	}
+
+	if truncated {
+		markTruncated(event)
+	}
+	return event, nil
+}
+
+func (f *truncateFields) truncateField(field string, fields common.MapStr) (bool, error) {
+	value, err := fields.GetValue(field)
+	if err != nil {
+		if f.config.IgnoreMissing && errors.Cause(err) == common.ErrKeyNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("could not fetch value for key: %s, Error: %s", field, err)
+	}
+
+	text, ok := value.(string)
+	if !ok {
+		return false, fmt.Errorf("field %s is not a string, value: `%v`", field, value)
+	}
+
+	text, truncated := f.truncate(text)
+	if !truncated {
+		return false, nil
+	}
+	_, err = fields.Put(field, text)
+	return true, err
+}
+
+func (f *truncateFields) String() string {
+	if f.config.MaxBytes > 0 {
+		return fmt.Sprintf("truncate_fields=[fields=%v, max_bytes=%d]", f.config.Fields, f.config.MaxBytes)
+	}
+	return fmt.Sprintf("truncate_fields=[fields=%v, max_characters=%d]", f.config.Fields, f.config.MaxChars)
+}
+
+// truncateBytes shortens s to at most n bytes. s is cut at a character
+// boundary, so the result is valid UTF-8 if s is.
+func truncateBytes(s string, n int) (string, bool) {
+	if len(s) <= n {
+		return s, false
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n], true
+}
+
+// truncateCharacters shortens s to at most n characters.
+func truncateCharacters(s string, n int) (string, bool) {
+	count := 0
+	for i := range s {
+		if count == n {
+			return s[:i], true
+		}
+		count++
+	}
+	return s, false
+}
+
+// markTruncated adds the truncated flag to the event, unless it is already
+// present.
+func markTruncated(event *beat.Event) {
+	if flags, err := event.GetValue(beat.FlagField); err == nil {
+		switch flags := flags.(type) {
+		case []string:
+			for _, flag := range flags {
+				if flag == truncatedFlag {
+					return
+				}
+			}
+		case []interface{}:
+			for _, flag := range flags {
+				if flag == truncatedFlag {
+					return
+				}
+			}
+		}
+	}
+
+	if err := common.AddTagsWithKey(event.Fields, beat.FlagField, []string{truncatedFlag}); err != nil {
+		logp.Debug("truncate_fields", "Failed to flag truncated event: %s", err)
+	}
+}
@@ -0,0 +1,162 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+)
+
+func TestTruncateFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   map[string]interface{}
+		input    common.MapStr
+		expected common.MapStr
+		fail     bool
+	}{
+		{
+			name:     "bytes",
+			config:   map[string]interface{}{"fields": []string{"message"}, "max_bytes": 5},
+			input:    common.MapStr{"message": "hello world"},
+			expected: common.MapStr{"message": "hello", "log": common.MapStr{"flags": []string{"truncated"}}},
+		},
+		{
+			name:     "bytes at character boundary",
+			config:   map[string]interface{}{"fields": []string{"message"}, "max_bytes": 4},
+			input:    common.MapStr{"message": "añño"},
+			expected: common.MapStr{"message": "añ", "log": common.MapStr{"flags": []string{"truncated"}}},
+		},
+		{
+			name:     "characters",
+			config:   map[string]interface{}{"fields": []string{"message"}, "max_characters": 3},
+			input:    common.MapStr{"message": "añño"},
+			expected: common.MapStr{"message": "aññ", "log": common.MapStr{"flags": []string{"truncated"}}},
+		},
+		{
+			name:     "short enough",
+			config:   map[string]interface{}{"fields": []string{"message"}, "max_characters": 4},
+			input:    common.MapStr{"message": "añño"},
+			expected: common.MapStr{"message": "añño"},
+		},
+		{
+			name: "existing flags",
+			config: map[string]interface{}{
+				"fields":    []string{"message", "error.message"},
+				"max_bytes": 2,
+			},
+			input: common.MapStr{
+				"message": "hello",
+				"error":   common.MapStr{"message": "failure"},
+				"log":     common.MapStr{"flags": []interface{}{"multiline"}},
+			},
+			expected: common.MapStr{
+				"message": "he",
+				"error":   common.MapStr{"message": "fa"},
+				"log":     common.MapStr{"flags": []interface{}{"multiline", "truncated"}},
+			},
+		},
+		{
+			name: "ignore missing",
+			config: map[string]interface{}{
+				"fields":         []string{"message", "other"},
+				"max_bytes":      2,
+				"ignore_missing": true,
+			},
+			input:    common.MapStr{"message": "hello"},
+			expected: common.MapStr{"message": "he", "log": common.MapStr{"flags": []string{"truncated"}}},
+		},
+		{
+			name: "fail on error",
+			config: map[string]interface{}{
+				"fields":    []string{"message", "count"},
+				"max_bytes": 2,
+			},
+			input:    common.MapStr{"message": "hello", "count": 1},
+			expected: common.MapStr{"message": "hello", "count": 1},
+			fail:     true,
+		},
+		{
+			name: "continue on error",
+			config: map[string]interface{}{
+				"fields":        []string{"count", "message"},
+				"max_bytes":     2,
+				"fail_on_error": false,
+			},
+			input:    common.MapStr{"message": "hello", "count": 1},
+			expected: common.MapStr{"message": "he", "count": 1, "log": common.MapStr{"flags": []string{"truncated"}}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config, err := common.NewConfigFrom(test.config)
+			require.NoError(t, err)
+
+			p, err := newTruncateFields(config)
+			require.NoError(t, err)
+
+			event, err := p.Run(&beat.Event{Fields: test.input})
+			if test.fail {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, event.Fields)
+		})
+	}
+}
+
+func TestTruncateFieldsConfig(t *testing.T) {
+	for _, settings := range []map[string]interface{}{
+		{"fields": []string{"message"}},
+		{"fields": []string{"message"}, "max_bytes": 10, "max_characters": 10},
+		{"fields": []string{"message"}, "max_bytes": -1},
+	} {
+		config, err := common.NewConfigFrom(settings)
+		require.NoError(t, err)
+
+		_, err = newTruncateFields(config)
+		assert.Error(t, err, "%v", settings)
+	}
+}
+
+func TestTruncateBytes(t *testing.T) {
+	tests := []struct {
+		in  string
+		n   int
+		out string
+	}{
+		{"hello", 10, "hello"},
+		{"hello", 0, ""},
+		{"日本語", 8, "日本"},
+		{"日本語", 6, "日本"},
+		{"日本語", 2, ""},
+	}
+
+	for _, test := range tests {
+		out, truncated := truncateBytes(test.in, test.n)
+		assert.Equal(t, test.out, out, "%q to %d bytes", test.in, test.n)
+		assert.Equal(t, test.out != test.in, truncated)
+	}
+}
@@ -74,6 +74,9 @@ func NewConditionRule(
 	if cond == nil {
 		return p, nil
 	}
+	if multi, ok := p.(beat.MultiProcessor); ok {
+		return &whenMultiProcessor{WhenProcessor{cond, p}, multi}, nil
+	}
 	return &WhenProcessor{cond, p}, nil
 }
 
@@ -85,10 +88,34 @@ func (r *WhenProcessor) Run(event *beat.Event) (*beat.Event, error) {
 	return r.p.Run(event)
 }
 
+// Close closes the conditional processor.
+func (r *WhenProcessor) Close() error {
+	return Close(r.p)
+}
+
+// SetBeatInfo passes the beat information to the conditional processor.
+func (r *WhenProcessor) SetBeatInfo(info beat.Info) {
+	SetBeatInfo(r.p, info)
+}
+
 func (r *WhenProcessor) String() string {
 	return fmt.Sprintf("%v, condition=%v", r.p.String(), r.condition.String())
 }
 
+// whenMultiProcessor is a WhenProcessor for a multi-event processor.
+type whenMultiProcessor struct {
+	WhenProcessor
+	multi beat.MultiProcessor
+}
+
+// RunMulti executes the multi-event processor if the condition is true.
+func (r *whenMultiProcessor) RunMulti(event *beat.Event) ([]*beat.Event, error) {
+	if !(r.condition).Check(event) {
+		return []*beat.Event{event}, nil
+	}
+	return r.multi.RunMulti(event)
+}
+
 func addCondition(
 	cfg *common.Config,
 	p Processor,
@@ -98,13 +125,20 @@ func addCondition(
 	}
 	sub, err := cfg.Child("when", -1)
 	if err != nil {
+		Close(p)
 		return nil, err
 	}
 
 	condConfig := conditions.Config{}
 	if err := sub.Unpack(&condConfig); err != nil {
+		Close(p)
 		return nil, err
 	}
 
-	return NewConditionRule(condConfig, p)
+	rule, err := NewConditionRule(condConfig, p)
+	if err != nil {
+		Close(p)
+		return nil, err
+	}
+	return rule, nil
 }
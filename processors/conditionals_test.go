@@ -122,3 +122,54 @@ func TestConditionRuleInitErrorPropagates(t *testing.T) {
 	assert.Equal(t, testErr, err)
 	assert.Nil(t, filter)
 }
+
+type closeFilter struct {
+	countFilter
+	closed int
+}
+
+func (c *closeFilter) Close() error {
+	c.closed++
+	return nil
+}
+
+func TestWhenProcessorClose(t *testing.T) {
+	type config map[string]interface{}
+
+	tests := map[string]struct {
+		cfg     config
+		wantErr bool
+	}{
+		"no condition": {
+			cfg: config{},
+		},
+		"condition": {
+			cfg: config{"when.equals.type": "test"},
+		},
+		"invalid condition": {
+			cfg:     config{"when.invalid": "test"},
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cf := &closeFilter{}
+			p, err := NewConditional(func(_ *common.Config) (Processor, error) {
+				return cf, nil
+			})(common.MustNewConfigFrom(test.cfg))
+
+			if test.wantErr {
+				// the processor is released if the condition is invalid
+				assert.Error(t, err)
+				assert.Equal(t, 1, cf.closed)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, 0, cf.closed)
+			assert.NoError(t, (&Processors{List: []Processor{p, &countFilter{}}}).Close())
+			assert.Equal(t, 1, cf.closed)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dedupe
+
+import (
+	"container/list"
+	"time"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/monitoring"
+)
+
+// window is the time window opened by the first event with a key.
+type window struct {
+	key        uint64
+	closes     time.Time
+	duplicates int
+
+	// summary is the event published with the number of duplicates once the
+	// window closes, if duplicates are counted.
+	summary *beat.Event
+}
+
+// windowCache keeps the open windows. All windows have the same length, so
+// windows are ordered by their closing time in the order they were opened.
+// The cache is not safe for concurrent use.
+type windowCache struct {
+	windows map[uint64]*list.Element
+	order   *list.List
+	length  time.Duration
+	maxSize int
+	stats   cacheStats
+}
+
+type cacheStats struct {
+	Hit     *monitoring.Int
+	Miss    *monitoring.Int
+	Evicted *monitoring.Int
+}
+
+func newWindowCache(reg *monitoring.Registry, length time.Duration, maxSize int) *windowCache {
+	return &windowCache{
+		windows: map[uint64]*list.Element{},
+		order:   list.New(),
+		length:  length,
+		maxSize: maxSize,
+		stats: cacheStats{
+			Hit:     monitoring.NewInt(reg, "hits"),
+			Miss:    monitoring.NewInt(reg, "misses"),
+			Evicted: monitoring.NewInt(reg, "evictions"),
+		},
+	}
+}
+
+// expire removes and returns the windows closed at now.
+func (c *windowCache) expire(now time.Time) []*window {
+	var closed []*window
+	for elem := c.order.Front(); elem != nil; elem = c.order.Front() {
+		w := elem.Value.(*window)
+		if now.Before(w.closes) {
+			break
+		}
+		c.remove(elem)
+		closed = append(closed, w)
+	}
+	return closed
+}
+
+// add returns the open window for key and counts a duplicate. If no window is
+// open, a new window is opened and found is false. If the cache is full, the
+// oldest window is closed and returned as evicted.
+func (c *windowCache) add(key uint64, now time.Time) (w *window, found bool, evicted *window) {
+	if elem, ok := c.windows[key]; ok {
+		c.stats.Hit.Inc()
+		w = elem.Value.(*window)
+		w.duplicates++
+		return w, true, nil
+	}
+
+	c.stats.Miss.Inc()
+	if c.order.Len() >= c.maxSize {
+		c.stats.Evicted.Inc()
+		evicted = c.order.Front().Value.(*window)
+		c.remove(c.order.Front())
+	}
+
+	w = &window{key: key, closes: now.Add(c.length)}
+	c.windows[key] = c.order.PushBack(w)
+	return w, false, evicted
+}
+
+func (c *windowCache) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.windows, elem.Value.(*window).key)
+}
+
+func (c *windowCache) len() int {
+	return c.order.Len()
+}
@@ -0,0 +1,83 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dedupe
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	actionDrop = "drop"
+	actionTag  = "tag"
+)
+
+type config struct {
+	// Fields are the event fields compared to detect duplicates.
+	Fields []string `config:"fields" validate:"required"`
+
+	// Window is the time after the first event during which events with
+	// the same values are duplicates.
+	Window time.Duration `config:"window"`
+
+	// CacheSize is the maximum number of open windows. The oldest window is
+	// closed early if the cache is full.
+	CacheSize int `config:"cache.size" validate:"min=1"`
+
+	// Action is applied to duplicates, drop or tag.
+	Action string `config:"action"`
+
+	// Tag is added to duplicates if the action is tag.
+	Tag string `config:"tag"`
+
+	// CountDuplicates publishes the number of duplicates of a window once it
+	// closes.
+	CountDuplicates bool `config:"count_duplicates"`
+
+	// IgnoreMissing compares events even if some fields are missing.
+	IgnoreMissing bool `config:"ignore_missing"`
+}
+
+func defaultConfig() config {
+	return config{
+		Window:    10 * time.Second,
+		CacheSize: 10000,
+		Action:    actionDrop,
+		Tag:       "duplicate",
+	}
+}
+
+func (c *config) Validate() error {
+	if c.Window <= 0 {
+		return errors.New("window must be greater than 0")
+	}
+
+	c.Action = strings.ToLower(c.Action)
+	switch c.Action {
+	case actionDrop:
+	case actionTag:
+		if c.Tag == "" {
+			return errors.New("tag can not be empty if the action is tag")
+		}
+	default:
+		return errors.Errorf("invalid dedupe action '%v' (valid values are: drop, tag)", c.Action)
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dedupe
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash"
+	"github.com/pkg/errors"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/atomic"
+	"github.com/njcx/libbeat_v6/common/fieldhash"
+	"github.com/njcx/libbeat_v6/logp"
+	"github.com/njcx/libbeat_v6/monitoring"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+const (
+	processorName = "dedupe"
+	duplicatesKey = "event.duplicates"
+)
+
+var instanceID atomic.Uint32
+
+func init() {
+	processors.RegisterPlugin(processorName, newDedupeProcessor)
+}
+
+// processor drops or tags events that have the same values as an event seen
+// within the configured window.
+type processor struct {
+	config      config
+	fields      []string
+	log         *logp.Logger
+	clock       func() time.Time
+	metricsName string
+	closeOnce   sync.Once
+
+	mu    sync.Mutex
+	cache *windowCache
+}
+
+// countingProcessor publishes a summary event with the number of duplicates
+// when a window with duplicates closes. The first event of the window is
+// never held back, summary events are returned by the next call to RunMulti.
+type countingProcessor struct {
+	*processor
+}
+
+func newDedupeProcessor(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrapf(err, "fail to unpack the %v configuration", processorName)
+	}
+
+	// Logging and metrics (each processor instance has a unique ID).
+	var (
+		id          = int(instanceID.Inc())
+		log         = logp.NewLogger(processorName).With("instance_id", id)
+		metricsName = processorName + "." + strconv.Itoa(id)
+		metrics     = monitoring.Default.NewRegistry(metricsName, monitoring.DoNotReport)
+	)
+
+	fields := fieldhash.NormalizeFields(c.Fields)
+
+	p := &processor{
+		config:      c,
+		fields:      fields,
+		log:         log,
+		clock:       time.Now,
+		metricsName: metricsName,
+		cache:       newWindowCache(metrics.NewRegistry("cache"), c.Window, c.CacheSize),
+	}
+	if c.CountDuplicates {
+		return &countingProcessor{p}, nil
+	}
+	return p, nil
+}
+
+// Run drops or tags duplicates.
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	key, ok, err := p.hash(event)
+	if !ok {
+		return event, err
+	}
+
+	p.mu.Lock()
+	now := p.clock()
+	p.cache.expire(now)
+	_, found, _ := p.cache.add(key, now)
+	p.mu.Unlock()
+
+	if !found {
+		return event, nil
+	}
+	return p.duplicate(event), nil
+}
+
+// RunMulti drops or tags duplicates like Run. It returns the summary events of
+// the windows closed since the last call, followed by event unless it is
+// dropped.
+func (p *countingProcessor) RunMulti(event *beat.Event) ([]*beat.Event, error) {
+	key, ok, err := p.hash(event)
+
+	p.mu.Lock()
+	now := p.clock()
+	closed := p.cache.expire(now)
+
+	found := false
+	if ok {
+		var w, evicted *window
+		w, found, evicted = p.cache.add(key, now)
+		if !found {
+			w.summary = p.newSummary(event)
+		}
+		if evicted != nil {
+			closed = append(closed, evicted)
+		}
+	}
+	p.mu.Unlock()
+
+	events := make([]*beat.Event, 0, len(closed)+1)
+	for _, w := range closed {
+		if w.duplicates > 0 {
+			events = append(events, p.summarize(w))
+		}
+	}
+
+	if found {
+		event = p.duplicate(event)
+	}
+	if event != nil {
+		events = append(events, event)
+	}
+	return events, err
+}
+
+// hash computes the key of the event from the configured fields. ok is false
+// if the event can not be compared.
+func (p *processor) hash(event *beat.Event) (key uint64, ok bool, err error) {
+	h := xxhash.New()
+	n, err := fieldhash.WriteFields(h, event, p.fields, p.config.IgnoreMissing)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "failed to compare event")
+	}
+	if n == 0 {
+		// all fields are missing
+		return 0, false, nil
+	}
+	return h.Sum64(), true, nil
+}
+
+func (p *processor) duplicate(event *beat.Event) *beat.Event {
+	if p.config.Action == actionDrop {
+		return nil
+	}
+
+	if err := common.AddTags(event.Fields, []string{p.config.Tag}); err != nil {
+		p.log.Debugf("Failed to tag duplicate event: %v", err)
+	}
+	return event
+}
+
+// newSummary returns the summary event of the window opened by event. It only
+// contains the compared fields, so the first event can be published right away.
+func (p *processor) newSummary(event *beat.Event) *beat.Event {
+	summary := &beat.Event{
+		Timestamp: event.Timestamp,
+		Fields:    common.MapStr{},
+	}
+	if event.Meta != nil {
+		summary.Meta = event.Meta.Clone()
+	}
+	for _, field := range p.fields {
+		v, err := event.GetValue(field)
+		if err != nil {
+			continue
+		}
+		if m, ok := v.(common.MapStr); ok {
+			v = m.Clone()
+		}
+		summary.PutValue(field, v)
+	}
+	return summary
+}
+
+// summarize adds the number of duplicates to the summary event of a closed
+// window.
+func (p *processor) summarize(w *window) *beat.Event {
+	if _, err := w.summary.PutValue(duplicatesKey, w.duplicates); err != nil {
+		p.log.Debugf("Failed to add %v to the summary event: %v", duplicatesKey, err)
+	}
+	return w.summary
+}
+
+// Close removes the metrics of the processor.
+func (p *processor) Close() error {
+	p.closeOnce.Do(func() {
+		monitoring.Default.Remove(p.metricsName)
+	})
+	return nil
+}
+
+func (p *processor) String() string {
+	return fmt.Sprintf("%v=[fields=%v, window=%v, action=%v, count_duplicates=%v]",
+		processorName, strings.Join(p.fields, ","), p.config.Window, p.config.Action,
+		p.config.CountDuplicates)
+}
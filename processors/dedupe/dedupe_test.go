@@ -0,0 +1,330 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dedupe
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/monitoring"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time { return c.now }
+
+func (c *testClock) Add(d time.Duration) { c.now = c.now.Add(d) }
+
+// newClock returns a testClock used by the processor.
+func newClock(p processors.Processor) *testClock {
+	clock := &testClock{now: time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)}
+	switch p := p.(type) {
+	case *processor:
+		p.clock = clock.Now
+	case *countingProcessor:
+		p.clock = clock.Now
+	}
+	return clock
+}
+
+func testEvent(fields common.MapStr) *beat.Event {
+	return &beat.Event{Fields: fields}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		settings map[string]interface{}
+		valid    bool
+	}{
+		{map[string]interface{}{"fields": []string{"message"}}, true},
+		{map[string]interface{}{"fields": []string{"message"}, "action": "TAG"}, true},
+		{map[string]interface{}{}, false},
+		{map[string]interface{}{"fields": []string{"message"}, "window": 0}, false},
+		{map[string]interface{}{"fields": []string{"message"}, "action": "count"}, false},
+		{map[string]interface{}{"fields": []string{"message"}, "action": "tag", "tag": ""}, false},
+		{map[string]interface{}{"fields": []string{"message"}, "cache.size": 0}, false},
+	}
+
+	for _, test := range tests {
+		_, err := newDedupeProcessor(common.MustNewConfigFrom(test.settings))
+		if test.valid {
+			assert.NoError(t, err, "%v", test.settings)
+		} else {
+			assert.Error(t, err, "%v", test.settings)
+		}
+	}
+}
+
+func TestDrop(t *testing.T) {
+	p, err := newDedupeProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields": []string{"message", "host.name"},
+		"window": "10s",
+	}))
+	require.NoError(t, err)
+	clock := newClock(p)
+
+	run := func(fields common.MapStr) *beat.Event {
+		event, err := p.Run(testEvent(fields))
+		assert.NoError(t, err)
+		return event
+	}
+
+	assert.NotNil(t, run(common.MapStr{"message": "a", "host": common.MapStr{"name": "h1"}}))
+	assert.Nil(t, run(common.MapStr{"message": "a", "host": common.MapStr{"name": "h1"}, "other": 1}))
+	assert.NotNil(t, run(common.MapStr{"message": "a", "host": common.MapStr{"name": "h2"}}))
+	assert.NotNil(t, run(common.MapStr{"message": "b", "host": common.MapStr{"name": "h1"}}))
+
+	// the window is not extended by duplicates
+	clock.Add(9 * time.Second)
+	assert.Nil(t, run(common.MapStr{"message": "a", "host": common.MapStr{"name": "h1"}}))
+	clock.Add(time.Second)
+	assert.NotNil(t, run(common.MapStr{"message": "a", "host": common.MapStr{"name": "h1"}}))
+
+	stats := p.(*processor).cache.stats
+	assert.Equal(t, int64(2), stats.Hit.Get())
+	assert.Equal(t, int64(4), stats.Miss.Get())
+}
+
+func TestTag(t *testing.T) {
+	p, err := newDedupeProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields": []string{"message"},
+		"action": "tag",
+	}))
+	require.NoError(t, err)
+
+	event, err := p.Run(testEvent(common.MapStr{"message": "a"}))
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{"message": "a"}, event.Fields)
+
+	event, err = p.Run(testEvent(common.MapStr{"message": "a", "tags": []string{"web"}}))
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{"message": "a", "tags": []string{"web", "duplicate"}}, event.Fields)
+}
+
+func TestMissingFields(t *testing.T) {
+	p, err := newDedupeProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields": []string{"message", "host.name"},
+	}))
+	require.NoError(t, err)
+
+	fields := common.MapStr{"message": "a"}
+	event, err := p.Run(testEvent(fields))
+	assert.Error(t, err)
+	assert.NotNil(t, event)
+	event, err = p.Run(testEvent(fields))
+	assert.Error(t, err)
+	assert.NotNil(t, event)
+
+	p, err = newDedupeProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields":         []string{"message", "host.name"},
+		"ignore_missing": true,
+	}))
+	require.NoError(t, err)
+
+	event, _ = p.Run(testEvent(common.MapStr{"message": "a"}))
+	assert.NotNil(t, event)
+	event, _ = p.Run(testEvent(common.MapStr{"message": "a"}))
+	assert.Nil(t, event)
+
+	// events without any of the fields are never duplicates
+	for i := 0; i < 2; i++ {
+		event, err = p.Run(testEvent(common.MapStr{"other": "a"}))
+		assert.NoError(t, err)
+		assert.NotNil(t, event)
+	}
+}
+
+func TestCountDuplicates(t *testing.T) {
+	p, err := newDedupeProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields":           []string{"message"},
+		"window":           "10s",
+		"count_duplicates": true,
+	}))
+	require.NoError(t, err)
+	clock := newClock(p)
+	multi := p.(beat.MultiProcessor)
+
+	run := func(fields common.MapStr) []*beat.Event {
+		event := testEvent(fields)
+		event.Timestamp = clock.Now()
+		events, err := multi.RunMulti(event)
+		assert.NoError(t, err)
+		return events
+	}
+
+	first := clock.Now()
+
+	// The first event is never held back.
+	events := run(common.MapStr{"message": "a", "other": 1})
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, common.MapStr{"message": "a", "other": 1}, events[0].Fields)
+	}
+	assert.Len(t, run(common.MapStr{"message": "b"}), 1)
+
+	clock.Add(time.Second)
+	assert.Empty(t, run(common.MapStr{"message": "a", "other": 2}))
+	assert.Empty(t, run(common.MapStr{"message": "a", "other": 3}))
+
+	// The windows are closed, only a has duplicates.
+	clock.Add(10 * time.Second)
+	events = run(common.MapStr{"message": "c"})
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, first, events[0].Timestamp)
+		assert.Equal(t, common.MapStr{
+			"message": "a",
+			"event":   common.MapStr{"duplicates": 2},
+		}, events[0].Fields)
+		assert.Equal(t, common.MapStr{"message": "c"}, events[1].Fields)
+	}
+}
+
+func TestCountDuplicatesEvicted(t *testing.T) {
+	p, err := newDedupeProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields":           []string{"message"},
+		"cache.size":       1,
+		"count_duplicates": true,
+	}))
+	require.NoError(t, err)
+	newClock(p)
+	multi := p.(beat.MultiProcessor)
+
+	for _, msg := range []string{"a", "a"} {
+		_, err := multi.RunMulti(testEvent(common.MapStr{"message": msg}))
+		assert.NoError(t, err)
+	}
+
+	// The window of a is closed early to make room for b.
+	events, err := multi.RunMulti(testEvent(common.MapStr{"message": "b"}))
+	assert.NoError(t, err)
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, common.MapStr{
+			"message": "a",
+			"event":   common.MapStr{"duplicates": 1},
+		}, events[0].Fields)
+	}
+}
+
+func TestCacheSize(t *testing.T) {
+	p, err := newDedupeProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields":     []string{"message"},
+		"cache.size": 2,
+	}))
+	require.NoError(t, err)
+
+	for _, msg := range []string{"a", "b", "c"} {
+		event, _ := p.Run(testEvent(common.MapStr{"message": msg}))
+		assert.NotNil(t, event)
+	}
+	cache := p.(*processor).cache
+	assert.Equal(t, 2, cache.len())
+	assert.Equal(t, int64(1), cache.stats.Evicted.Get())
+
+	// the window of a was closed to make room for c
+	event, _ := p.Run(testEvent(common.MapStr{"message": "a"}))
+	assert.NotNil(t, event)
+	event, _ = p.Run(testEvent(common.MapStr{"message": "c"}))
+	assert.Nil(t, event)
+}
+
+func TestTypes(t *testing.T) {
+	p, err := newDedupeProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields": []string{"code"},
+	}))
+	require.NoError(t, err)
+
+	for _, code := range []interface{}{1, "1", []string{"1"}} {
+		event, err := p.Run(testEvent(common.MapStr{"code": code}))
+		assert.NoError(t, err)
+		assert.NotNil(t, event, "%#v", code)
+	}
+
+	// numbers are compared by value
+	event, err := p.Run(testEvent(common.MapStr{"code": 1.0}))
+	assert.NoError(t, err)
+	assert.Nil(t, event)
+}
+
+func TestConcurrentRun(t *testing.T) {
+	const (
+		workers = 8
+		keys    = 100
+	)
+
+	p, err := newDedupeProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields": []string{"message"},
+		"window": "1h",
+	}))
+	require.NoError(t, err)
+
+	// every worker publishes all keys, only the first event of a key passes
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		passed = map[string]int{}
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := 0; k < keys; k++ {
+				msg := fmt.Sprintf("message %d", k)
+				event, err := p.Run(testEvent(common.MapStr{"message": msg}))
+				assert.NoError(t, err)
+				if event != nil {
+					mu.Lock()
+					passed[msg]++
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, passed, keys)
+	for msg, n := range passed {
+		assert.Equal(t, 1, n, msg)
+	}
+
+	stats := p.(*processor).cache.stats
+	assert.Equal(t, int64(keys), stats.Miss.Get())
+	assert.Equal(t, int64((workers-1)*keys), stats.Hit.Get())
+}
+
+func TestClose(t *testing.T) {
+	p, err := newDedupeProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields":           []string{"message"},
+		"count_duplicates": true,
+	}))
+	require.NoError(t, err)
+	metricsName := p.(*countingProcessor).metricsName
+
+	require.NotNil(t, monitoring.Default.GetRegistry(metricsName))
+	assert.NoError(t, processors.Close(p))
+	assert.NoError(t, processors.Close(p))
+	assert.Nil(t, monitoring.Default.GetRegistry(metricsName))
+}
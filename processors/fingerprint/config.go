@@ -0,0 +1,89 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fingerprint
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"strings"
+
+	"github.com/cespare/xxhash"
+	"github.com/pkg/errors"
+	"github.com/spaolacci/murmur3"
+)
+
+type config struct {
+	// Fields are the event fields to compute the fingerprint from.
+	Fields []string `config:"fields" validate:"required"`
+
+	// TargetField is where the fingerprint is written.
+	TargetField string `config:"target_field"`
+
+	// Method is the hashing algorithm.
+	Method string `config:"method"`
+
+	// Encoding is the encoding of the fingerprint.
+	Encoding string `config:"encoding"`
+
+	// HMACKey enables keyed hashing (HMAC) when set. Use a keystore
+	// reference like `${FINGERPRINT_KEY}` instead of a plain value.
+	HMACKey string `config:"hmac_key"`
+
+	// IgnoreMissing computes the fingerprint even if some fields are missing.
+	IgnoreMissing bool `config:"ignore_missing"`
+}
+
+func defaultConfig() config {
+	return config{
+		TargetField: "@metadata.id",
+		Method:      "sha256",
+		Encoding:    "hex",
+	}
+}
+
+var hashMethods = map[string]func() hash.Hash{
+	"sha1":    sha1.New,
+	"sha256":  sha256.New,
+	"xxhash":  func() hash.Hash { return xxhash.New() },
+	"murmur3": func() hash.Hash { return murmur3.New128() },
+}
+
+var encodingMethods = map[string]func([]byte) string{
+	"hex":    hex.EncodeToString,
+	"base64": base64.RawURLEncoding.EncodeToString,
+}
+
+func (c *config) Validate() error {
+	c.Method = strings.ToLower(c.Method)
+	if _, found := hashMethods[c.Method]; !found {
+		return errors.Errorf("invalid fingerprint method '%v' (valid values are: sha1, sha256, xxhash, murmur3)", c.Method)
+	}
+
+	c.Encoding = strings.ToLower(c.Encoding)
+	if _, found := encodingMethods[c.Encoding]; !found {
+		return errors.Errorf("invalid fingerprint encoding '%v' (valid values are: hex, base64)", c.Encoding)
+	}
+
+	if c.TargetField == "" {
+		return errors.New("target_field can not be empty")
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fingerprint
+
+import (
+	"crypto/hmac"
+	"fmt"
+	"hash"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/fieldhash"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+const (
+	processorName  = "fingerprint"
+	metadataPrefix = "@metadata."
+)
+
+func init() {
+	processors.RegisterPlugin(processorName, newFingerprintProcessor)
+}
+
+type fingerprint struct {
+	config  config
+	fields  []string
+	newHash func() hash.Hash
+	encode  func([]byte) string
+}
+
+func newFingerprintProcessor(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrapf(err, "fail to unpack the %v configuration", processorName)
+	}
+
+	fields := fieldhash.NormalizeFields(c.Fields)
+
+	method := hashMethods[c.Method]
+	newHash := method
+	if c.HMACKey != "" {
+		key := []byte(c.HMACKey)
+		newHash = func() hash.Hash { return hmac.New(method, key) }
+	}
+
+	return &fingerprint{
+		config:  c,
+		fields:  fields,
+		newHash: newHash,
+		encode:  encodingMethods[c.Encoding],
+	}, nil
+}
+
+func (p *fingerprint) Run(event *beat.Event) (*beat.Event, error) {
+	h := p.newHash()
+	if _, err := fieldhash.WriteFields(h, event, p.fields, p.config.IgnoreMissing); err != nil {
+		return event, errors.Wrap(err, "failed to compute fingerprint")
+	}
+
+	id := p.encode(h.Sum(nil))
+	if err := putValue(event, p.config.TargetField, id); err != nil {
+		return event, errors.Wrapf(err, "failed to set fingerprint in %v", p.config.TargetField)
+	}
+	return event, nil
+}
+
+func (p *fingerprint) String() string {
+	return fmt.Sprintf("%v=[method=%v, encoding=%v, fields=%v, target_field=%v, hmac=%v]",
+		processorName, p.config.Method, p.config.Encoding, strings.Join(p.fields, ","),
+		p.config.TargetField, p.config.HMACKey != "")
+}
+
+// putValue sets key in the event, keys starting with `@metadata.` are
+// written to the event metadata.
+func putValue(event *beat.Event, key string, value string) error {
+	if strings.HasPrefix(key, metadataPrefix) {
+		if event.Meta == nil {
+			event.Meta = common.MapStr{}
+		}
+		_, err := event.Meta.Put(strings.TrimPrefix(key, metadataPrefix), value)
+		return err
+	}
+
+	_, err := event.PutValue(key, value)
+	return err
+}
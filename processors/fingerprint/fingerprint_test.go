@@ -0,0 +1,170 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fingerprint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+)
+
+func TestFingerprint(t *testing.T) {
+	fields := common.MapStr{"a": "foo", "b": 1, "c": "ignored"}
+
+	tests := []struct {
+		name     string
+		config   map[string]interface{}
+		expected string
+	}{
+		{
+			name:     "default",
+			config:   map[string]interface{}{"fields": []string{"b", "a"}},
+			expected: "edebd55186dfacf5f5d71fa844a74d4fefb6edf2901e2cb375ac97671ffeb858",
+		},
+		{
+			name:     "duplicate fields",
+			config:   map[string]interface{}{"fields": []string{"a", "b", "a"}},
+			expected: "edebd55186dfacf5f5d71fa844a74d4fefb6edf2901e2cb375ac97671ffeb858",
+		},
+		{
+			name:     "sha1",
+			config:   map[string]interface{}{"fields": []string{"a", "b"}, "method": "sha1"},
+			expected: "451f1747afec44a7d8b8ddb7c674d7184d6a4fce",
+		},
+		{
+			name:     "base64",
+			config:   map[string]interface{}{"fields": []string{"a", "b"}, "encoding": "base64"},
+			expected: "7evVUYbfrPX11x-oRKdNT--27fKQHiyzdayXZx_-uFg",
+		},
+		{
+			name:     "hmac",
+			config:   map[string]interface{}{"fields": []string{"a", "b"}, "hmac_key": "secret"},
+			expected: "60733d54f1984e2a751b40f7fd29ae34a2bf9142e05412bcbff172613e6b1121",
+		},
+		{
+			name:     "missing fields ignored",
+			config:   map[string]interface{}{"fields": []string{"a", "b", "missing"}, "ignore_missing": true},
+			expected: "edebd55186dfacf5f5d71fa844a74d4fefb6edf2901e2cb375ac97671ffeb858",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := newFingerprintProcessor(common.MustNewConfigFrom(test.config))
+			require.NoError(t, err)
+
+			event, err := p.Run(&beat.Event{Fields: fields.Clone()})
+			require.NoError(t, err)
+
+			id, _ := event.Meta.GetValue("id")
+			assert.Equal(t, test.expected, id)
+			assert.Equal(t, fields, event.Fields)
+		})
+	}
+}
+
+func TestFingerprintTimestamp(t *testing.T) {
+	p, err := newFingerprintProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields": []string{"@timestamp", "a"},
+	}))
+	require.NoError(t, err)
+
+	ts := time.Date(2019, 1, 2, 3, 4, 5, 6, time.UTC)
+	event, err := p.Run(&beat.Event{
+		Timestamp: ts.In(time.FixedZone("CET", 3600)),
+		Fields:    common.MapStr{"a": "foo"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "d2b803836a29882238a33efd67973a293ed791b0372e636758fe4b4da8df9cbc", event.Meta["id"])
+}
+
+func TestFingerprintMethods(t *testing.T) {
+	for method := range hashMethods {
+		t.Run(method, func(t *testing.T) {
+			p, err := newFingerprintProcessor(common.MustNewConfigFrom(map[string]interface{}{
+				"fields":       []string{"message"},
+				"method":       method,
+				"target_field": "event.hash",
+			}))
+			require.NoError(t, err)
+
+			first, err := p.Run(&beat.Event{Fields: common.MapStr{"message": "hello"}})
+			require.NoError(t, err)
+			second, err := p.Run(&beat.Event{Fields: common.MapStr{"message": "hello"}})
+			require.NoError(t, err)
+			other, err := p.Run(&beat.Event{Fields: common.MapStr{"message": "world"}})
+			require.NoError(t, err)
+
+			hash, err := first.GetValue("event.hash")
+			require.NoError(t, err)
+			assert.NotEmpty(t, hash)
+			assert.Equal(t, hash, second.Fields["event"].(common.MapStr)["hash"])
+			assert.NotEqual(t, hash, other.Fields["event"].(common.MapStr)["hash"])
+			assert.Nil(t, first.Meta)
+		})
+	}
+}
+
+func TestFingerprintTypes(t *testing.T) {
+	p, err := newFingerprintProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields": []string{"a"},
+	}))
+	require.NoError(t, err)
+
+	fingerprint := func(v interface{}) interface{} {
+		event, err := p.Run(&beat.Event{Fields: common.MapStr{"a": v}})
+		require.NoError(t, err)
+		return event.Meta["id"]
+	}
+
+	assert.NotEqual(t, fingerprint(1), fingerprint("1"))
+	assert.NotEqual(t, fingerprint([]string{"a b"}), fingerprint([]string{"a", "b"}))
+	assert.Equal(t, fingerprint(1), fingerprint(1.0))
+}
+
+func TestFingerprintMissingField(t *testing.T) {
+	p, err := newFingerprintProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields": []string{"a", "missing"},
+	}))
+	require.NoError(t, err)
+
+	event, err := p.Run(&beat.Event{Fields: common.MapStr{"a": "foo"}})
+	assert.Error(t, err)
+	assert.Nil(t, event.Meta)
+}
+
+func TestFingerprintInitFail(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"no fields":        {},
+		"invalid method":   {"fields": []string{"a"}, "method": "md4"},
+		"invalid encoding": {"fields": []string{"a"}, "encoding": "base32"},
+		"empty target":     {"fields": []string{"a"}, "target_field": ""},
+	}
+
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := newFingerprintProcessor(common.MustNewConfigFrom(config))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package geoip
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/njcx/libbeat_v6/common"
+)
+
+type config struct {
+	// Fields maps the source IP fields to the target prefix where geo and
+	// AS information is written, e.g. `source.ip: source`.
+	Fields common.MapStr `config:"fields" validate:"required"`
+
+	Database databaseConfig `config:"database"`
+
+	// ReloadPeriod is the interval to check the database files for changes.
+	// Use 0 to disable reloading.
+	ReloadPeriod time.Duration `config:"reload.period"`
+
+	// CacheSize is the number of lookup results to keep. Use 0 to disable the cache.
+	CacheSize int `config:"cache.size" validate:"min=0"`
+
+	// IgnorePrivate skips lookups for private, loopback and link-local addresses.
+	IgnorePrivate bool `config:"ignore_private"`
+
+	// TagOnFailure are the tags to add to the event when a lookup fails.
+	TagOnFailure []string `config:"tag_on_failure"`
+
+	fieldsFlat map[string]string
+}
+
+type databaseConfig struct {
+	City    string `config:"city"`
+	Country string `config:"country"`
+	ASN     string `config:"asn"`
+}
+
+func defaultConfig() config {
+	return config{
+		ReloadPeriod:  time.Minute,
+		CacheSize:     10000,
+		IgnorePrivate: true,
+	}
+}
+
+func (c *config) Validate() error {
+	if c.Database.City != "" && c.Database.Country != "" {
+		return errors.New("only one of database.city and database.country can be configured")
+	}
+	if c.Database.City == "" && c.Database.Country == "" && c.Database.ASN == "" {
+		return errors.New("at least one of database.city, database.country or database.asn is required")
+	}
+
+	// Flatten the mapping of source fields to target prefixes.
+	c.fieldsFlat = map[string]string{}
+	for k, v := range c.Fields.Flatten() {
+		target, ok := v.(string)
+		if !ok {
+			return errors.Errorf("target field for geoip lookup of %v "+
+				"must be a string but got %T", k, v)
+		}
+		c.fieldsFlat[k] = target
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package geoip
+
+import (
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/oschwald/maxminddb-golang"
+	"github.com/pkg/errors"
+)
+
+// database wraps a MaxMind DB reader that can be swapped when the file
+// on disk is replaced.
+type database struct {
+	path   string
+	suffix string
+
+	mu     sync.RWMutex
+	reader *maxminddb.Reader
+}
+
+// openDatabase opens the MaxMind DB file at path. The database type
+// must contain the given suffix, e.g. `City` or `ASN`.
+func openDatabase(path, suffix string) (*database, error) {
+	db := &database{path: path, suffix: suffix}
+	if err := db.reload(); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// lookup decodes the record for ip into result. It returns false if the
+// database has no record for the address.
+func (db *database) lookup(ip net.IP, result interface{}) (bool, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	if db.reader == nil {
+		return false, errors.Errorf("geoip database %v is closed", db.path)
+	}
+
+	offset, err := db.reader.LookupOffset(ip)
+	if err != nil {
+		return false, err
+	}
+	if offset == maxminddb.NotFound {
+		return false, nil
+	}
+	return true, db.reader.Decode(offset, result)
+}
+
+// reload opens the database file and replaces the current reader. The file
+// is opened outside of the lock, lookups only wait for the swap and for
+// lookups still using the old reader.
+func (db *database) reload() error {
+	reader, err := maxminddb.Open(db.path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open geoip database %v", db.path)
+	}
+	if dbType := reader.Metadata.DatabaseType; !strings.HasSuffix(dbType, db.suffix) {
+		reader.Close()
+		return errors.Errorf("geoip database %v has type %v, expected a %v database",
+			db.path, dbType, db.suffix)
+	}
+
+	db.mu.Lock()
+	old := db.reader
+	db.reader = reader
+	db.mu.Unlock()
+
+	if old != nil {
+		old.Close()
+	}
+	return nil
+}
+
+func (db *database) close() error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.reader == nil {
+		return nil
+	}
+	err := db.reader.Close()
+	db.reader = nil
+	return err
+}
@@ -0,0 +1,358 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package geoip
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/atomic"
+	"github.com/njcx/libbeat_v6/common/file"
+	"github.com/njcx/libbeat_v6/common/lru"
+	"github.com/njcx/libbeat_v6/logp"
+	"github.com/njcx/libbeat_v6/monitoring"
+	"github.com/njcx/libbeat_v6/paths"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+const processorName = "geoip"
+
+var instanceID atomic.Uint32
+
+// privateNetworks are the address ranges skipped when ignore_private is set.
+var privateNetworks = parseCIDRs(
+	"10.0.0.0/8",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"::1/128",
+	"fc00::/7",
+	"fe80::/10",
+)
+
+func init() {
+	processors.RegisterPlugin(processorName, newGeoIPProcessor)
+}
+
+type processor struct {
+	config   config
+	geo      *database
+	asn      *database
+	cache    *lru.Cache
+	log      *logp.Logger
+	reloader *file.Reloader
+
+	metricsName string
+	reloads     *monitoring.Int
+}
+
+// cityRecord holds the fields of City and Country databases used by the processor.
+type cityRecord struct {
+	City struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"city"`
+	Continent struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"continent"`
+	Country struct {
+		IsoCode string            `maxminddb:"iso_code"`
+		Names   map[string]string `maxminddb:"names"`
+	} `maxminddb:"country"`
+	Location struct {
+		Latitude  *float64 `maxminddb:"latitude"`
+		Longitude *float64 `maxminddb:"longitude"`
+	} `maxminddb:"location"`
+	Subdivisions []struct {
+		IsoCode string            `maxminddb:"iso_code"`
+		Names   map[string]string `maxminddb:"names"`
+	} `maxminddb:"subdivisions"`
+}
+
+// asnRecord holds the fields of ASN databases.
+type asnRecord struct {
+	Number       uint   `maxminddb:"autonomous_system_number"`
+	Organization string `maxminddb:"autonomous_system_organization"`
+}
+
+func newGeoIPProcessor(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrapf(err, "fail to unpack the %v configuration", processorName)
+	}
+
+	var files []string
+	for _, path := range []*string{&c.Database.City, &c.Database.Country, &c.Database.ASN} {
+		if *path != "" {
+			*path = paths.Resolve(paths.Config, *path)
+			files = append(files, *path)
+		}
+	}
+	reloader := file.NewReloader(files...)
+
+	var (
+		geo, asn *database
+		err      error
+	)
+	switch {
+	case c.Database.City != "":
+		geo, err = openDatabase(c.Database.City, "City")
+	case c.Database.Country != "":
+		geo, err = openDatabase(c.Database.Country, "Country")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Database.ASN != "" {
+		asn, err = openDatabase(c.Database.ASN, "ASN")
+		if err != nil {
+			if geo != nil {
+				geo.close()
+			}
+			return nil, err
+		}
+	}
+
+	// Logging and metrics (each processor instance has a unique ID).
+	var (
+		id          = int(instanceID.Inc())
+		log         = logp.NewLogger(processorName).With("instance_id", id)
+		metricsName = processorName + "." + strconv.Itoa(id)
+		metrics     = monitoring.Default.NewRegistry(metricsName, monitoring.DoNotReport)
+	)
+
+	p := &processor{
+		config:      c,
+		geo:         geo,
+		asn:         asn,
+		cache:       lru.New(metrics.NewRegistry("cache"), c.CacheSize),
+		log:         log,
+		reloader:    reloader,
+		metricsName: metricsName,
+		reloads:     monitoring.NewInt(metrics, "reloads"),
+	}
+	reloader.Start(c.ReloadPeriod, p.reload)
+
+	return p, nil
+}
+
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	var tagOnce sync.Once
+	for field, target := range p.config.fieldsFlat {
+		if err := p.processField(event, field, target); err != nil {
+			p.log.Debugf("GeoIP processor failed: %v", err)
+			tagOnce.Do(func() { common.AddTags(event.Fields, p.config.TagOnFailure) })
+		}
+	}
+	return event, nil
+}
+
+func (p *processor) processField(event *beat.Event, source, target string) error {
+	v, err := event.GetValue(source)
+	if err != nil {
+		return nil
+	}
+
+	str, ok := v.(string)
+	if !ok {
+		return nil
+	}
+
+	ip := net.ParseIP(str)
+	if ip == nil {
+		return fmt.Errorf("invalid IP address '%v' in %v", str, source)
+	}
+
+	if p.config.IgnorePrivate && isPrivate(ip) {
+		return nil
+	}
+
+	info, found := p.cache.Get(str)
+	if !found {
+		gen := p.cache.Generation()
+		info, err = p.lookup(ip)
+		if err != nil {
+			return fmt.Errorf("geoip lookup of %v value '%v' failed: %v", source, str, err)
+		}
+		p.cache.Set(str, info, gen)
+	}
+
+	for key, value := range info {
+		// Cached results are shared, give every event its own copy.
+		if m, ok := value.(common.MapStr); ok {
+			value = m.Clone()
+		}
+		if _, err := event.PutValue(target+"."+key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lookup returns the `geo` and `as` information for ip. The result is nil
+// if none of the databases have a record for the address.
+func (p *processor) lookup(ip net.IP) (common.MapStr, error) {
+	result := common.MapStr{}
+
+	if p.geo != nil {
+		var record cityRecord
+		found, err := p.geo.lookup(ip, &record)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			if geo := record.toMapStr(); len(geo) > 0 {
+				result["geo"] = geo
+			}
+		}
+	}
+
+	if p.asn != nil {
+		var record asnRecord
+		found, err := p.asn.lookup(ip, &record)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			if as := record.toMapStr(); len(as) > 0 {
+				result["as"] = as
+			}
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+// reload reopens the databases after one of the files changed. It is called
+// by the reloader, events are processed with the previous databases until
+// the new ones are swapped in. Cached results are discarded after a reload,
+// the cache is cleared after the swap so results of the previous databases
+// are not cached again.
+func (p *processor) reload() {
+	reloaded := false
+	for _, db := range []*database{p.geo, p.asn} {
+		if db == nil {
+			continue
+		}
+
+		if err := db.reload(); err != nil {
+			p.log.Errorf("Failed to reload geoip database, keeping the previous one: %v", err)
+			continue
+		}
+		p.log.Infof("Reloaded geoip database %v", db.path)
+		reloaded = true
+	}
+
+	if reloaded {
+		p.reloads.Inc()
+		p.cache.Clear()
+	}
+}
+
+// Close stops reloading, closes the databases and removes the metrics of the
+// processor.
+func (p *processor) Close() error {
+	p.reloader.Close()
+
+	var err error
+	for _, db := range []*database{p.geo, p.asn} {
+		if db == nil {
+			continue
+		}
+		if e := db.close(); e != nil {
+			err = e
+		}
+	}
+
+	monitoring.Default.Remove(p.metricsName)
+	return err
+}
+
+func (p *processor) String() string {
+	return fmt.Sprintf("geoip=[city=%v, country=%v, asn=%v, fields=%+v]",
+		p.config.Database.City, p.config.Database.Country, p.config.Database.ASN,
+		p.config.fieldsFlat)
+}
+
+func (r *cityRecord) toMapStr() common.MapStr {
+	geo := common.MapStr{}
+	putString(geo, "continent_name", r.Continent.Names["en"])
+	putString(geo, "country_iso_code", r.Country.IsoCode)
+	putString(geo, "country_name", r.Country.Names["en"])
+	if len(r.Subdivisions) > 0 {
+		putString(geo, "region_iso_code", r.Subdivisions[0].IsoCode)
+		putString(geo, "region_name", r.Subdivisions[0].Names["en"])
+	}
+	putString(geo, "city_name", r.City.Names["en"])
+	if r.Location.Latitude != nil && r.Location.Longitude != nil {
+		geo["location"] = common.MapStr{
+			"lat": *r.Location.Latitude,
+			"lon": *r.Location.Longitude,
+		}
+	}
+	return geo
+}
+
+func (r *asnRecord) toMapStr() common.MapStr {
+	as := common.MapStr{}
+	if r.Number != 0 {
+		as["number"] = r.Number
+	}
+	if r.Organization != "" {
+		as["organization"] = common.MapStr{"name": r.Organization}
+	}
+	return as
+}
+
+func putString(m common.MapStr, key, value string) {
+	if value != "" {
+		m[key] = value
+	}
+}
+
+func isPrivate(ip net.IP) bool {
+	for _, network := range privateNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
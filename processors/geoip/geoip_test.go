@@ -0,0 +1,332 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package geoip
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/file/filetest"
+	"github.com/njcx/libbeat_v6/monitoring"
+	"github.com/njcx/libbeat_v6/paths"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+const (
+	cityDB        = "testdata/GeoLite2-City-Test.mmdb"
+	cityDBUpdated = "testdata/GeoLite2-City-Test-Updated.mmdb"
+	asnDB         = "testdata/GeoLite2-ASN-Test.mmdb"
+)
+
+func TestGeoIPRelativePath(t *testing.T) {
+	dir, err := filepath.Abs("testdata")
+	require.NoError(t, err)
+
+	defer func(config string) { paths.Paths.Config = config }(paths.Paths.Config)
+	paths.Paths.Config = dir
+
+	p, err := newGeoIPProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields":        map[string]interface{}{"source.ip": "source"},
+		"database.city": filepath.Base(cityDB),
+	}))
+	require.NoError(t, err)
+	defer processors.Close(p)
+
+	assert.Equal(t, filepath.Join(dir, filepath.Base(cityDB)), p.(*processor).config.Database.City)
+}
+
+func TestGeoIPProcessorRun(t *testing.T) {
+	p, err := newGeoIPProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields": map[string]interface{}{
+			"source.ip":      "source",
+			"destination.ip": "destination",
+		},
+		"database.city":  cityDB,
+		"database.asn":   asnDB,
+		"tag_on_failure": []string{"_geoip_lookup_failure"},
+	}))
+	require.NoError(t, err)
+	defer processors.Close(p)
+	t.Log(p.String())
+
+	t.Run("city and asn", func(t *testing.T) {
+		event, err := p.Run(&beat.Event{
+			Fields: common.MapStr{
+				"source":      common.MapStr{"ip": "81.2.69.142"},
+				"destination": common.MapStr{"ip": "2001:218::1"},
+			},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, common.MapStr{
+			"ip": "81.2.69.142",
+			"geo": common.MapStr{
+				"continent_name":   "Europe",
+				"country_iso_code": "GB",
+				"country_name":     "United Kingdom",
+				"region_iso_code":  "ENG",
+				"region_name":      "England",
+				"city_name":        "London",
+				"location":         common.MapStr{"lat": 51.5142, "lon": -0.0931},
+			},
+			"as": common.MapStr{
+				"number":       uint(20712),
+				"organization": common.MapStr{"name": "Andrews & Arnold Ltd"},
+			},
+		}, event.Fields["source"])
+
+		city, _ := event.GetValue("destination.geo.city_name")
+		assert.Equal(t, "Tokyo", city)
+		_, err = event.GetValue("destination.as")
+		assert.Error(t, err)
+	})
+
+	t.Run("asn only", func(t *testing.T) {
+		event, err := p.Run(&beat.Event{
+			Fields: common.MapStr{
+				"source": common.MapStr{"ip": "1.128.0.1"},
+			},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, common.MapStr{
+			"ip": "1.128.0.1",
+			"as": common.MapStr{
+				"number":       uint(1221),
+				"organization": common.MapStr{"name": "Telstra Pty Ltd"},
+			},
+		}, event.Fields["source"])
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		event, err := p.Run(&beat.Event{
+			Fields: common.MapStr{
+				"source": common.MapStr{"ip": "8.8.8.8"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, common.MapStr{"ip": "8.8.8.8"}, event.Fields["source"])
+	})
+
+	t.Run("private address", func(t *testing.T) {
+		event, err := p.Run(&beat.Event{
+			Fields: common.MapStr{
+				"source": common.MapStr{"ip": "192.168.1.1"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, common.MapStr{"ip": "192.168.1.1"}, event.Fields["source"])
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		event, err := p.Run(&beat.Event{
+			Fields: common.MapStr{
+				"source": common.MapStr{"ip": "not an ip"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"_geoip_lookup_failure"}, event.Fields["tags"])
+	})
+}
+
+func TestGeoIPProcessorCache(t *testing.T) {
+	proc, err := newGeoIPProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields":        map[string]interface{}{"ip": "client"},
+		"database.city": cityDB,
+	}))
+	require.NoError(t, err)
+	p := proc.(*processor)
+	defer p.Close()
+
+	for i := 0; i < 3; i++ {
+		event, err := p.Run(&beat.Event{Fields: common.MapStr{"ip": "81.2.69.142"}})
+		require.NoError(t, err)
+
+		// modifying the event must not change cached results
+		name, _ := event.GetValue("client.geo.city_name")
+		assert.Equal(t, "London", name)
+		event.PutValue("client.geo.city_name", "modified")
+	}
+
+	metrics := monitoring.Default.GetRegistry(p.metricsName)
+	assert.Equal(t, 1, p.cache.Len())
+	assert.Equal(t, int64(2), metrics.Get("cache.hits").(*monitoring.Int).Get())
+	assert.Equal(t, int64(1), metrics.Get("cache.misses").(*monitoring.Int).Get())
+}
+
+func TestGeoIPProcessorReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "geoip")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "GeoLite2-City.mmdb")
+	copyFile(t, cityDB, path)
+
+	proc, err := newGeoIPProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields":        map[string]interface{}{"ip": "client"},
+		"database.city": path,
+		"reload.period": "10ms",
+	}))
+	require.NoError(t, err)
+	p := proc.(*processor)
+	defer p.Close()
+
+	assert.Equal(t, "London", lookupCity(t, p, "81.2.69.142"))
+
+	filetest.Replace(t, path, readFile(t, cityDBUpdated))
+	filetest.WaitFor(t, func() bool { return p.reloads.Get() == 1 })
+	assert.Equal(t, "Stockholm", lookupCity(t, p, "81.2.69.142"))
+
+	// a broken file keeps the previous database
+	filetest.Replace(t, path, readFile(t, asnDB))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int64(1), p.reloads.Get())
+	assert.Equal(t, "Stockholm", lookupCity(t, p, "81.2.69.142"))
+}
+
+func TestGeoIPProcessorConcurrentReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "geoip")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "GeoLite2-City.mmdb")
+	copyFile(t, cityDB, path)
+
+	proc, err := newGeoIPProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields":        map[string]interface{}{"ip": "client"},
+		"database.city": path,
+		"reload.period": "1ms",
+		"cache.size":    1,
+	}))
+	require.NoError(t, err)
+	p := proc.(*processor)
+	defer p.Close()
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				event, err := p.Run(&beat.Event{Fields: common.MapStr{"ip": "81.2.69.142"}})
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				name, _ := event.GetValue("client.geo.city_name")
+				if name != "London" && name != "Stockholm" {
+					t.Errorf("unexpected city name: %v", name)
+					return
+				}
+			}
+		}()
+	}
+
+	for i, db := range []string{cityDBUpdated, cityDB, cityDBUpdated} {
+		filetest.Replace(t, path, readFile(t, db))
+		reloads := int64(i + 1)
+		filetest.WaitFor(t, func() bool { return p.reloads.Get() == reloads })
+	}
+	close(done)
+	wg.Wait()
+
+	assert.Equal(t, "Stockholm", lookupCity(t, p, "81.2.69.142"))
+}
+
+func TestGeoIPProcessorClose(t *testing.T) {
+	proc, err := newGeoIPProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"fields":         map[string]interface{}{"ip": "client"},
+		"database.city":  cityDB,
+		"database.asn":   asnDB,
+		"reload.period":  "1ms",
+		"tag_on_failure": []string{"_geoip_lookup_failure"},
+	}))
+	require.NoError(t, err)
+	p := proc.(*processor)
+	require.NotNil(t, monitoring.Default.GetRegistry(p.metricsName))
+
+	require.NoError(t, processors.Close(proc))
+	require.NoError(t, processors.Close(proc))
+	assert.Nil(t, monitoring.Default.GetRegistry(p.metricsName))
+
+	// lookups fail instead of accessing the closed databases
+	event, err := p.Run(&beat.Event{Fields: common.MapStr{"ip": "81.2.69.142"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"_geoip_lookup_failure"}, event.Fields["tags"])
+}
+
+func TestGeoIPProcessorInitFail(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"no database": {
+			"fields": map[string]interface{}{"ip": "client"},
+		},
+		"city and country": {
+			"fields":           map[string]interface{}{"ip": "client"},
+			"database.city":    cityDB,
+			"database.country": cityDB,
+		},
+		"wrong database type": {
+			"fields":       map[string]interface{}{"ip": "client"},
+			"database.asn": cityDB,
+		},
+		"missing file": {
+			"fields":        map[string]interface{}{"ip": "client"},
+			"database.city": "testdata/missing.mmdb",
+		},
+	}
+
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := newGeoIPProcessor(common.MustNewConfigFrom(config))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func lookupCity(t *testing.T, p processors.Processor, ip string) interface{} {
+	event, err := p.Run(&beat.Event{Fields: common.MapStr{"ip": ip}})
+	require.NoError(t, err)
+	name, _ := event.GetValue("client.geo.city_name")
+	return name
+}
+
+func copyFile(t *testing.T, from, to string) {
+	require.NoError(t, ioutil.WriteFile(to, readFile(t, from), 0644))
+}
+
+func readFile(t *testing.T, path string) []byte {
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	return data
+}
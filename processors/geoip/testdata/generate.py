@@ -0,0 +1,192 @@
+#!/usr/bin/env python
+"""Generates the small MaxMind DB fixtures used by the geoip processor tests.
+
+The files follow the MaxMind DB format specification version 2.0:
+https://maxmind.github.io/MaxMind-DB/
+
+Usage: python generate.py
+"""
+
+import ipaddress
+import os
+import struct
+
+RECORD_SIZE = 24
+METADATA_MARKER = b"\xab\xcd\xefMaxMind.com"
+
+
+def encode_ctrl(type_id, size):
+    if type_id <= 7:
+        first = type_id << 5
+        ext = b""
+    else:
+        first = 0
+        ext = bytes([type_id - 7])
+
+    if size < 29:
+        return bytes([first | size]) + ext
+    if size < 285:
+        return bytes([first | 29]) + ext + bytes([size - 29])
+    if size < 65821:
+        return bytes([first | 30]) + ext + struct.pack(">H", size - 285)
+    return bytes([first | 31]) + ext + struct.pack(">I", size - 65821)[1:]
+
+
+def encode_uint(type_id, value):
+    raw = value.to_bytes((value.bit_length() + 7) // 8, "big") if value else b""
+    return encode_ctrl(type_id, len(raw)) + raw
+
+
+def encode(value):
+    if isinstance(value, bool):
+        return encode_ctrl(14, 1 if value else 0)
+    if isinstance(value, str):
+        raw = value.encode("utf-8")
+        return encode_ctrl(2, len(raw)) + raw
+    if isinstance(value, float):
+        return encode_ctrl(3, 8) + struct.pack(">d", value)
+    if isinstance(value, Uint16):
+        return encode_uint(5, int(value))
+    if isinstance(value, Uint64):
+        return encode_uint(9, int(value))
+    if isinstance(value, int):
+        return encode_uint(6, value)
+    if isinstance(value, dict):
+        out = encode_ctrl(7, len(value))
+        for k in sorted(value):
+            out += encode(k) + encode(value[k])
+        return out
+    if isinstance(value, list):
+        out = encode_ctrl(11, len(value))
+        for v in value:
+            out += encode(v)
+        return out
+    raise TypeError("unsupported type %r" % type(value))
+
+
+class Uint16(int):
+    pass
+
+
+class Uint64(int):
+    pass
+
+
+class Node(object):
+    def __init__(self):
+        self.children = [None, None]
+
+
+def network_bits(network):
+    net = ipaddress.ip_network(network)
+    if net.version == 4:
+        # IPv4 networks live in the ::/96 subtree of IPv6 databases
+        addr = int(net.network_address)
+        prefix = 96 + net.prefixlen
+    else:
+        addr = int(net.network_address)
+        prefix = net.prefixlen
+    return [(addr >> (127 - i)) & 1 for i in range(prefix)]
+
+
+def write_db(path, database_type, records):
+    data = b""
+    offsets = {}
+    root = Node()
+
+    for network, record in records:
+        encoded = encode(record)
+        if encoded not in offsets:
+            offsets[encoded] = len(data)
+            data += encoded
+
+        bits = network_bits(network)
+        node = root
+        for bit in bits[:-1]:
+            if not isinstance(node.children[bit], Node):
+                node.children[bit] = Node()
+            node = node.children[bit]
+        node.children[bits[-1]] = ("data", offsets[encoded])
+
+    nodes = []
+    queue = [root]
+    while queue:
+        node = queue.pop(0)
+        nodes.append(node)
+        for child in node.children:
+            if isinstance(child, Node):
+                queue.append(child)
+    index = {id(n): i for i, n in enumerate(nodes)}
+    node_count = len(nodes)
+
+    def record_value(child):
+        if child is None:
+            return node_count
+        if isinstance(child, Node):
+            return index[id(child)]
+        return node_count + 16 + child[1]
+
+    tree = b""
+    for node in nodes:
+        for child in node.children:
+            tree += struct.pack(">I", record_value(child))[1:]
+
+    metadata = {
+        "binary_format_major_version": Uint16(2),
+        "binary_format_minor_version": Uint16(0),
+        "build_epoch": Uint64(1546300800),
+        "database_type": database_type,
+        "description": {"en": "Test database for the libbeat geoip processor"},
+        "ip_version": Uint16(6),
+        "languages": ["en"],
+        "node_count": node_count,
+        "record_size": Uint16(RECORD_SIZE),
+    }
+
+    with open(path, "wb") as f:
+        f.write(tree)
+        f.write(b"\x00" * 16)
+        f.write(data)
+        f.write(METADATA_MARKER)
+        f.write(encode(metadata))
+
+
+def city(continent_code, continent, country_code, country, region_code, region, city_name, lat, lon, tz):
+    return {
+        "city": {"names": {"en": city_name}},
+        "continent": {"code": continent_code, "names": {"en": continent}},
+        "country": {"iso_code": country_code, "names": {"en": country}},
+        "location": {"latitude": lat, "longitude": lon, "time_zone": tz},
+        "subdivisions": [{"iso_code": region_code, "names": {"en": region}}],
+    }
+
+
+def main():
+    here = os.path.dirname(os.path.abspath(__file__))
+
+    write_db(os.path.join(here, "GeoLite2-City-Test.mmdb"), "GeoLite2-City", [
+        ("81.2.69.0/24", city("EU", "Europe", "GB", "United Kingdom", "ENG", "England",
+                              "London", 51.5142, -0.0931, "Europe/London")),
+        ("2001:218::/32", city("AS", "Asia", "JP", "Japan", "13", "Tokyo",
+                               "Tokyo", 35.685, 139.7514, "Asia/Tokyo")),
+    ])
+
+    write_db(os.path.join(here, "GeoLite2-City-Test-Updated.mmdb"), "GeoLite2-City", [
+        ("81.2.69.0/24", city("EU", "Europe", "SE", "Sweden", "AB", "Stockholm",
+                              "Stockholm", 59.3294, 18.0686, "Europe/Stockholm")),
+    ])
+
+    write_db(os.path.join(here, "GeoLite2-ASN-Test.mmdb"), "GeoLite2-ASN", [
+        ("81.2.69.0/24", {
+            "autonomous_system_number": 20712,
+            "autonomous_system_organization": "Andrews & Arnold Ltd",
+        }),
+        ("1.128.0.0/11", {
+            "autonomous_system_number": 1221,
+            "autonomous_system_organization": "Telstra Pty Ltd",
+        }),
+    ])
+
+
+if __name__ == "__main__":
+    main()
@@ -0,0 +1,291 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grok
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/njcx/libbeat_v6/asset"
+)
+
+// patternRef matches references to named patterns in the form of %{NAME},
+// %{NAME:field} or %{NAME:field:type}.
+var patternRef = regexp.MustCompile(`%\{(\w+)(?::([^:}]+))?(?::(\w+))?\}`)
+
+// patternName matches valid names of patterns.
+var patternName = regexp.MustCompile(`^\w+$`)
+
+// Patterns is a library of named patterns. The definitions can reference other
+// patterns of the library.
+type Patterns map[string]string
+
+var (
+	defaultPatternsOnce sync.Once
+	defaultPatterns     Patterns
+	defaultPatternsErr  error
+)
+
+// DefaultPatterns returns a copy of the standard pattern library.
+func DefaultPatterns() (Patterns, error) {
+	defaultPatternsOnce.Do(func() {
+		var data []byte
+		data, defaultPatternsErr = asset.DecodeData(patternsAsset())
+		if defaultPatternsErr == nil {
+			defaultPatterns, defaultPatternsErr = ParsePatterns(bytes.NewReader(data))
+		}
+	})
+	if defaultPatternsErr != nil {
+		return nil, fmt.Errorf("failed to load the standard patterns: %v", defaultPatternsErr)
+	}
+
+	patterns := make(Patterns, len(defaultPatterns))
+	patterns.Add(defaultPatterns)
+	return patterns, nil
+}
+
+// ParsePatterns reads pattern definitions. Each line contains the name of
+// the pattern followed by whitespace and the regular expression. Empty lines
+// and lines starting with # are ignored.
+func ParsePatterns(r io.Reader) (Patterns, error) {
+	patterns := Patterns{}
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		idx := strings.IndexAny(text, " \t")
+		if idx < 0 {
+			return nil, fmt.Errorf("line %d: pattern %s has no definition", line, text)
+		}
+		name := text[:idx]
+		if !patternName.MatchString(name) {
+			return nil, fmt.Errorf("line %d: invalid pattern name '%s'", line, name)
+		}
+		patterns[name] = strings.TrimSpace(text[idx:])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return patterns, nil
+}
+
+// LoadPatternsFile reads the pattern definitions of a file.
+func LoadPatternsFile(path string) (Patterns, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	patterns, err := ParsePatterns(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read patterns from %s: %v", path, err)
+	}
+	return patterns, nil
+}
+
+// Add adds the definitions to the library. Existing definitions with the same
+// name are replaced.
+func (p Patterns) Add(other Patterns) {
+	for name, def := range other {
+		p[name] = def
+	}
+}
+
+// Grok is a compiled grok expression.
+type Grok struct {
+	raw      string
+	re       *regexp.Regexp
+	captures []capture
+}
+
+// capture is a named pattern whose match is stored in a field.
+type capture struct {
+	group string
+	index int
+	field string
+	typ   fieldType
+}
+
+// Compile expands the named patterns referenced by the expression and compiles
+// the resulting regular expression.
+func (p Patterns) Compile(expr string) (*Grok, error) {
+	c := &compiler{patterns: p}
+	expanded, err := c.expand(expr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	re, err := regexp.Compile(expanded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile pattern '%s': %v", expr, err)
+	}
+
+	groups := map[string]int{}
+	for i, name := range re.SubexpNames() {
+		if name != "" {
+			groups[name] = i
+		}
+	}
+	for i := range c.captures {
+		c.captures[i].index = groups[c.captures[i].group]
+	}
+
+	return &Grok{raw: expr, re: re, captures: c.captures}, nil
+}
+
+type compiler struct {
+	patterns Patterns
+	captures []capture
+}
+
+// expand replaces the pattern references with their definitions. References
+// with a field name are replaced by a named group, the groups are named by
+// their index, as the same field can be captured by multiple groups.
+func (c *compiler) expand(expr string, stack []string) (string, error) {
+	var err error
+	out := patternRef.ReplaceAllStringFunc(expr, func(ref string) string {
+		if err != nil {
+			return ""
+		}
+
+		m := patternRef.FindStringSubmatch(ref)
+		name, field, typName := m[1], m[2], m[3]
+		for _, s := range stack {
+			if s == name {
+				err = fmt.Errorf("pattern %s references itself", name)
+				return ""
+			}
+		}
+
+		def, found := c.patterns[name]
+		if !found {
+			err = fmt.Errorf("pattern %s is not defined", name)
+			return ""
+		}
+
+		nested := make([]string, len(stack), len(stack)+1)
+		copy(nested, stack)
+		inner, e := c.expand(def, append(nested, name))
+		if e != nil {
+			err = e
+			return ""
+		}
+
+		if field == "" {
+			if typName != "" {
+				err = fmt.Errorf("pattern %s has a type but no field name", ref)
+				return ""
+			}
+			return "(?:" + inner + ")"
+		}
+
+		typ, e := parseFieldType(typName)
+		if e != nil {
+			err = fmt.Errorf("invalid type in %s: %v", ref, e)
+			return ""
+		}
+
+		group := "g" + strconv.Itoa(len(c.captures))
+		c.captures = append(c.captures, capture{group: group, field: field, typ: typ})
+		return "(?P<" + group + ">" + inner + ")"
+	})
+	return out, err
+}
+
+// Match applies the expression to the input. If the expression matches, the
+// captured values are returned by field name. If a field is captured more than
+// once, the first captured value is used.
+func (g *Grok) Match(s string) (map[string]interface{}, bool, error) {
+	loc := g.re.FindStringSubmatchIndex(s)
+	if loc == nil {
+		return nil, false, nil
+	}
+
+	fields := make(map[string]interface{}, len(g.captures))
+	for _, c := range g.captures {
+		start, end := loc[2*c.index], loc[2*c.index+1]
+		if start < 0 {
+			continue
+		}
+		if _, exists := fields[c.field]; exists {
+			continue
+		}
+
+		v, err := c.typ.convert(s[start:end])
+		if err != nil {
+			return nil, true, fmt.Errorf("failed to convert field %s: %v", c.field, err)
+		}
+		fields[c.field] = v
+	}
+	return fields, true, nil
+}
+
+// String returns the expression as configured.
+func (g *Grok) String() string {
+	return g.raw
+}
+
+type fieldType uint8
+
+const (
+	typeString fieldType = iota
+	typeInt
+	typeFloat
+	typeBool
+)
+
+func parseFieldType(name string) (fieldType, error) {
+	switch name {
+	case "", "string":
+		return typeString, nil
+	case "int", "long":
+		return typeInt, nil
+	case "float", "double":
+		return typeFloat, nil
+	case "boolean", "bool":
+		return typeBool, nil
+	default:
+		return typeString, fmt.Errorf("unsupported type '%s'", name)
+	}
+}
+
+func (t fieldType) convert(s string) (interface{}, error) {
+	switch t {
+	case typeInt:
+		return strconv.ParseInt(s, 10, 64)
+	case typeFloat:
+		return strconv.ParseFloat(s, 64)
+	case typeBool:
+		return strconv.ParseBool(s)
+	default:
+		return s, nil
+	}
+}
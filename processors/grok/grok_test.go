@@ -0,0 +1,172 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grok
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/asset"
+)
+
+func TestPatternsAsset(t *testing.T) {
+	expected, err := ioutil.ReadFile("patterns/grok-patterns")
+	require.NoError(t, err)
+
+	data, err := asset.DecodeData(patternsAsset())
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(data), "patterns.go is outdated")
+}
+
+func TestDefaultPatternsCompile(t *testing.T) {
+	patterns, err := DefaultPatterns()
+	require.NoError(t, err)
+	require.NotEmpty(t, patterns)
+
+	for name := range patterns {
+		_, err := patterns.Compile("%{" + name + "}")
+		assert.NoError(t, err, name)
+	}
+}
+
+func TestDefaultPatterns(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		input    string
+		expected map[string]interface{}
+	}{
+		{
+			pattern: "%{COMBINEDAPACHELOG}",
+			input:   `127.0.0.1 - frank [10/Oct/2000:13:55:36 -0700] "GET /apache_pb.gif HTTP/1.0" 200 2326 "http://www.example.com/start.html" "Mozilla/4.08"`,
+			expected: map[string]interface{}{
+				"clientip":    "127.0.0.1",
+				"ident":       "-",
+				"auth":        "frank",
+				"timestamp":   "10/Oct/2000:13:55:36 -0700",
+				"verb":        "GET",
+				"request":     "/apache_pb.gif",
+				"httpversion": "1.0",
+				"response":    "200",
+				"bytes":       "2326",
+				"referrer":    `"http://www.example.com/start.html"`,
+				"agent":       `"Mozilla/4.08"`,
+			},
+		},
+		{
+			pattern: "%{SYSLOGBASE} %{GREEDYDATA:message}",
+			input:   "Mar  7 04:02:17 host1 sshd[1234]: Accepted publickey",
+			expected: map[string]interface{}{
+				"timestamp": "Mar  7 04:02:17",
+				"logsource": "host1",
+				"program":   "sshd",
+				"pid":       "1234",
+				"message":   "Accepted publickey",
+			},
+		},
+		{
+			pattern:  "%{IP:ip}",
+			input:    "from 192.168.1.255 to",
+			expected: map[string]interface{}{"ip": "192.168.1.255"},
+		},
+		{
+			pattern:  "%{IP:ip}",
+			input:    "2001:db8::8a2e:370:7334",
+			expected: map[string]interface{}{"ip": "2001:db8::8a2e:370:7334"},
+		},
+		{
+			pattern:  "%{TIMESTAMP_ISO8601:ts} %{LOGLEVEL:level}",
+			input:    "2019-02-12T10:11:12.123Z WARN started",
+			expected: map[string]interface{}{"ts": "2019-02-12T10:11:12.123Z", "level": "WARN"},
+		},
+		{
+			pattern:  "%{URI:uri}",
+			input:    "https://user:pw@example.com:8080/a/b?c=d&e=f",
+			expected: map[string]interface{}{"uri": "https://user:pw@example.com:8080/a/b?c=d&e=f"},
+		},
+	}
+
+	patterns, err := DefaultPatterns()
+	require.NoError(t, err)
+	for _, test := range tests {
+		g, err := patterns.Compile(test.pattern)
+		require.NoError(t, err, test.pattern)
+
+		fields, matched, err := g.Match(test.input)
+		assert.NoError(t, err, test.pattern)
+		assert.True(t, matched, test.pattern)
+		assert.Equal(t, test.expected, fields, test.pattern)
+	}
+}
+
+func TestCompile(t *testing.T) {
+	patterns := Patterns{
+		"NUM":   "[0-9]+",
+		"OPT":   "(?:%{NUM:a}|x%{NUM:a})",
+		"SELF":  "%{SELF}",
+		"LOOPA": "%{LOOPB}",
+		"LOOPB": "%{LOOPA}",
+	}
+
+	g, err := patterns.Compile("%{NUM:count:int} %{NUM:ratio:float} %{NUM:flag:boolean} %{NUM}")
+	require.NoError(t, err)
+	fields, matched, err := g.Match("12 3 1 4")
+	require.NoError(t, err)
+	assert.True(t, matched)
+	assert.Equal(t, map[string]interface{}{"count": int64(12), "ratio": float64(3), "flag": true}, fields)
+
+	// The same field can be captured by alternatives.
+	g, err = patterns.Compile("%{OPT}")
+	require.NoError(t, err)
+	fields, _, _ = g.Match("x42")
+	assert.Equal(t, map[string]interface{}{"a": "42"}, fields)
+
+	_, matched, err = g.Match("abc")
+	assert.NoError(t, err)
+	assert.False(t, matched)
+
+	g, err = patterns.Compile("%{NUM:n:int}")
+	require.NoError(t, err)
+	_, matched, err = g.Match("99999999999999999999")
+	assert.True(t, matched)
+	assert.Error(t, err)
+
+	for _, expr := range []string{"%{MISSING}", "%{SELF}", "%{LOOPA}", "%{NUM:n:date}", "%{NUM::int}", "%{NUM:n}("} {
+		_, err := patterns.Compile(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestParsePatterns(t *testing.T) {
+	patterns, err := ParsePatterns(strings.NewReader(`
+# comment
+A  [a-z]+
+B	%{A} %{A}
+`))
+	require.NoError(t, err)
+	assert.Equal(t, Patterns{"A": "[a-z]+", "B": "%{A} %{A}"}, patterns)
+
+	_, err = ParsePatterns(strings.NewReader("A"))
+	assert.Error(t, err)
+
+	_, err = ParsePatterns(strings.NewReader("A-B x"))
+	assert.Error(t, err)
+}
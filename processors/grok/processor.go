@@ -0,0 +1,204 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grok
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rcrowley/go-metrics"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/atomic"
+	"github.com/njcx/libbeat_v6/monitoring"
+	"github.com/njcx/libbeat_v6/monitoring/adapter"
+	"github.com/njcx/libbeat_v6/paths"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+const (
+	logName          = "processor.grok"
+	flagParsingError = "grok_parsing_error"
+)
+
+// instanceID is used to assign each instance a unique monitoring namespace.
+var instanceID = atomic.MakeUint32(0)
+
+type processor struct {
+	config      config
+	patterns    []*pattern
+	failures    *monitoring.Int
+	metricsName string
+	closeOnce   sync.Once
+}
+
+// pattern is a compiled pattern of the processor with its metrics.
+type pattern struct {
+	grok    *Grok
+	matches *monitoring.Int
+	misses  *monitoring.Int
+	time    metrics.Sample
+}
+
+func init() {
+	processors.RegisterPlugin("grok", newProcessor)
+}
+
+func newProcessor(c *common.Config) (processors.Processor, error) {
+	config := defaultConfig
+	if err := c.Unpack(&config); err != nil {
+		return nil, errors.Wrap(err, "fail to unpack the grok configuration")
+	}
+
+	library, err := DefaultPatterns()
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range config.PatternFiles {
+		patterns, err := LoadPatternsFile(paths.Resolve(paths.Config, file))
+		if err != nil {
+			return nil, err
+		}
+		library.Add(patterns)
+	}
+	library.Add(config.PatternDefinitions)
+
+	groks := make([]*Grok, len(config.Patterns))
+	for i, expr := range config.Patterns {
+		groks[i], err = library.Compile(expr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Register the metrics once all patterns are compiled.
+	id := int(instanceID.Inc())
+	metricsName := logName + "." + strconv.Itoa(id)
+	reg := monitoring.Default.NewRegistry(metricsName, monitoring.DoNotReport)
+
+	p := &processor{
+		config:      config,
+		failures:    monitoring.NewInt(reg, "failures"),
+		metricsName: metricsName,
+	}
+	for i, expr := range config.Patterns {
+		patternReg := reg.NewRegistry("pattern_" + strconv.Itoa(i))
+		monitoring.NewString(patternReg, "pattern").Set(expr)
+		pt := &pattern{
+			grok:    groks[i],
+			matches: monitoring.NewInt(patternReg, "matches"),
+			misses:  monitoring.NewInt(patternReg, "misses"),
+			time:    metrics.NewUniformSample(1028),
+		}
+		adapter.NewGoMetrics(patternReg, "time", adapter.Accept).
+			Register("ns", metrics.NewHistogram(pt.time))
+		p.patterns = append(p.patterns, pt)
+	}
+
+	return p, nil
+}
+
+// Run applies the patterns in order to the configured field. The fields
+// captured by the first matching pattern are added to the event.
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	v, err := event.GetValue(p.config.Field)
+	if err != nil {
+		if p.config.IgnoreMissing && errors.Cause(err) == common.ErrKeyNotFound {
+			return event, nil
+		}
+		return event, err
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		return event, fmt.Errorf("field is not a string, value: `%v`, field: `%s`", v, p.config.Field)
+	}
+
+	for _, pt := range p.patterns {
+		start := time.Now()
+		fields, matched, err := pt.grok.Match(s)
+		pt.time.Update(int64(time.Since(start)))
+		if !matched {
+			pt.misses.Inc()
+			continue
+		}
+
+		pt.matches.Inc()
+		if err != nil {
+			return p.fail(event, err)
+		}
+		return p.mapper(event, fields)
+	}
+
+	return p.fail(event, fmt.Errorf("no pattern matched the value of field `%s`", p.config.Field))
+}
+
+func (p *processor) fail(event *beat.Event, err error) (*beat.Event, error) {
+	p.failures.Inc()
+	if p.config.TagOnFailure {
+		if err := common.AddTagsWithKey(
+			event.Fields,
+			beat.FlagField,
+			[]string{flagParsingError},
+		); err != nil {
+			return event, errors.Wrap(err, "cannot add new flag the event")
+		}
+	}
+	return event, err
+}
+
+// mapper adds the captured fields to the event. Existing values are
+// overwritten. If a field can not be set, the event is restored.
+func (p *processor) mapper(event *beat.Event, fields map[string]interface{}) (*beat.Event, error) {
+	copy := event.Fields.Clone()
+
+	prefix := ""
+	if p.config.TargetPrefix != "" {
+		prefix = p.config.TargetPrefix + "."
+	}
+	for k, v := range fields {
+		if _, err := event.PutValue(prefix+k, v); err != nil {
+			event.Fields = copy
+			return event, errors.Wrapf(err, "cannot set field `%s`", prefix+k)
+		}
+	}
+	return event, nil
+}
+
+// Close removes the metrics of the processor.
+func (p *processor) Close() error {
+	p.closeOnce.Do(func() {
+		monitoring.Default.Remove(p.metricsName)
+	})
+	return nil
+}
+
+func (p *processor) String() string {
+	patterns := make([]string, len(p.patterns))
+	for i, pt := range p.patterns {
+		patterns[i] = pt.grok.String()
+	}
+	return "grok=[" + strings.Join(patterns, ", ") + "]" +
+		",field=" + p.config.Field +
+		",target_prefix=" + p.config.TargetPrefix
+}
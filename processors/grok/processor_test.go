@@ -0,0 +1,198 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grok
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/monitoring"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+func TestProcessor(t *testing.T) {
+	tests := []struct {
+		name     string
+		c        map[string]interface{}
+		fields   common.MapStr
+		expected common.MapStr
+		err      bool
+	}{
+		{
+			name:     "default field",
+			c:        map[string]interface{}{"patterns": []string{"%{WORD:verb} %{NUMBER:code:int}"}},
+			fields:   common.MapStr{"message": "GET 200"},
+			expected: common.MapStr{"message": "GET 200", "verb": "GET", "code": int64(200)},
+		},
+		{
+			name: "first matching pattern wins",
+			c: map[string]interface{}{
+				"patterns": []string{"%{NUMBER:code:int}$", "%{WORD:verb} %{NUMBER:code}"},
+				"field":    "log",
+			},
+			fields:   common.MapStr{"log": "GET 200 x"},
+			expected: common.MapStr{"log": "GET 200 x", "verb": "GET", "code": "200"},
+		},
+		{
+			name: "custom definitions and target prefix",
+			c: map[string]interface{}{
+				"patterns":            []string{"%{STATUS:status} in %{DURATION:took:float}s"},
+				"pattern_definitions": map[string]interface{}{"STATUS": "(?:ok|failed)", "DURATION": "%{NUMBER}"},
+				"target_prefix":       "job",
+			},
+			fields:   common.MapStr{"message": "failed in 1.5s"},
+			expected: common.MapStr{"message": "failed in 1.5s", "job": common.MapStr{"status": "failed", "took": 1.5}},
+		},
+		{
+			name: "pattern files",
+			c: map[string]interface{}{
+				"patterns":      []string{"%{APPLIANCE_EVENT}"},
+				"pattern_files": []string{"testdata/appliance-patterns"},
+			},
+			fields: common.MapStr{"message": "reboot level=high"},
+			expected: common.MapStr{
+				"message":   "reboot level=high",
+				"appliance": common.MapStr{"event": "reboot", "level": "high"},
+			},
+		},
+		{
+			name:     "overwrite source field",
+			c:        map[string]interface{}{"patterns": []string{"%{LOGLEVEL:level} %{GREEDYDATA:message}"}},
+			fields:   common.MapStr{"message": "INFO started"},
+			expected: common.MapStr{"message": "started", "level": "INFO"},
+		},
+		{
+			name:     "no match",
+			c:        map[string]interface{}{"patterns": []string{"^%{NUMBER:n}$"}},
+			fields:   common.MapStr{"message": "abc"},
+			expected: common.MapStr{"message": "abc", "log": common.MapStr{"flags": []string{flagParsingError}}},
+			err:      true,
+		},
+		{
+			name:     "no match without tag",
+			c:        map[string]interface{}{"patterns": []string{"^%{NUMBER:n}$"}, "tag_on_failure": false},
+			fields:   common.MapStr{"message": "abc"},
+			expected: common.MapStr{"message": "abc"},
+			err:      true,
+		},
+		{
+			name:     "conflicting field restores the event",
+			c:        map[string]interface{}{"patterns": []string{"%{WORD:a} %{WORD:message.b}"}},
+			fields:   common.MapStr{"message": "x y"},
+			expected: common.MapStr{"message": "x y"},
+			err:      true,
+		},
+		{
+			name:     "missing field",
+			c:        map[string]interface{}{"patterns": []string{"%{WORD:a}"}},
+			fields:   common.MapStr{},
+			expected: common.MapStr{},
+			err:      true,
+		},
+		{
+			name:     "ignore missing field",
+			c:        map[string]interface{}{"patterns": []string{"%{WORD:a}"}, "ignore_missing": true},
+			fields:   common.MapStr{},
+			expected: common.MapStr{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, err := common.NewConfigFrom(test.c)
+			require.NoError(t, err)
+
+			p, err := newProcessor(c)
+			require.NoError(t, err)
+
+			event, err := p.Run(&beat.Event{Fields: test.fields})
+			if test.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, event.Fields)
+		})
+	}
+}
+
+func TestProcessorConfig(t *testing.T) {
+	configs := []map[string]interface{}{
+		{},
+		{"patterns": []string{}},
+		{"patterns": []string{"%{UNDEFINED}"}},
+		{"patterns": []string{"%{WORD}"}, "pattern_definitions": map[string]interface{}{"A-B": "x"}},
+		{"patterns": []string{"%{WORD}"}, "pattern_files": []string{"testdata/missing"}},
+	}
+
+	for _, config := range configs {
+		c, err := common.NewConfigFrom(config)
+		require.NoError(t, err)
+
+		_, err = newProcessor(c)
+		assert.Error(t, err, "%v", config)
+	}
+}
+
+func TestProcessorMetrics(t *testing.T) {
+	c, err := common.NewConfigFrom(map[string]interface{}{
+		"patterns": []string{"^%{NUMBER:n}$", "^%{WORD:w}$"},
+	})
+	require.NoError(t, err)
+
+	proc, err := newProcessor(c)
+	require.NoError(t, err)
+	p := proc.(*processor)
+
+	for _, msg := range []string{"1", "a", "a b"} {
+		p.Run(&beat.Event{Fields: common.MapStr{"message": msg}})
+	}
+
+	assert.Equal(t, int64(1), p.patterns[0].matches.Get())
+	assert.Equal(t, int64(2), p.patterns[0].misses.Get())
+	assert.Equal(t, int64(1), p.patterns[1].matches.Get())
+	assert.Equal(t, int64(1), p.patterns[1].misses.Get())
+	assert.Equal(t, int64(3), p.patterns[0].time.Count())
+	assert.Equal(t, int64(1), p.failures.Get())
+}
+
+func TestProcessorClose(t *testing.T) {
+	// No metrics are registered if a pattern fails to compile.
+	next := logName + "." + strconv.Itoa(int(instanceID.Load())+1)
+	_, err := newProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"patterns": []string{"%{WORD}", "%{UNDEFINED}"},
+	}))
+	require.Error(t, err)
+	assert.Nil(t, monitoring.Default.GetRegistry(next))
+
+	proc, err := newProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"patterns": []string{"%{WORD}"},
+	}))
+	require.NoError(t, err)
+	metricsName := proc.(*processor).metricsName
+
+	require.NotNil(t, monitoring.Default.GetRegistry(metricsName))
+	assert.NoError(t, processors.Close(proc))
+	assert.NoError(t, processors.Close(proc))
+	assert.Nil(t, monitoring.Default.GetRegistry(metricsName))
+}
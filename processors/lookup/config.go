@@ -0,0 +1,130 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package lookup
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/cfgtype"
+)
+
+type config struct {
+	// File is the path of the dictionary in CSV, YAML or JSON format.
+	File string `config:"file" validate:"required"`
+
+	// Format of the dictionary file. Derived from the file extension if not set.
+	Format string `config:"format"`
+
+	// Separator is the field separator used by CSV dictionaries.
+	Separator string `config:"csv.separator"`
+
+	// Keys maps the event fields to the dictionary columns they must match.
+	Keys common.MapStr `config:"keys" validate:"required"`
+
+	// Columns are the dictionary columns to add to the event. All columns
+	// except the key columns are added by default.
+	Columns []string `config:"columns"`
+
+	// Target is the destination where the columns are added. Columns are
+	// added to the root of the event if empty.
+	Target string `config:"target"`
+
+	// Default holds the values to add to the event if no entry matches.
+	Default common.MapStr `config:"default"`
+
+	// ReloadPeriod is the interval to check the dictionary for changes.
+	// Use 0 to disable reloading.
+	ReloadPeriod time.Duration `config:"reload.period"`
+
+	// MaxEntries is the maximum number of entries loaded from the dictionary.
+	MaxEntries int `config:"max_entries" validate:"min=1"`
+
+	// MaxFileSize is the maximum size of the dictionary file.
+	MaxFileSize cfgtype.ByteSize `config:"max_file_size" validate:"min=1"`
+
+	// IgnoreMissing ignores events that don't have all key fields.
+	IgnoreMissing bool `config:"ignore_missing"`
+
+	// OverwriteKeys allows columns to overwrite existing fields.
+	OverwriteKeys bool `config:"overwrite_keys"`
+
+	keys []keyMapping
+}
+
+// keyMapping associates an event field to a dictionary column.
+type keyMapping struct {
+	field  string
+	column string
+}
+
+func defaultConfig() config {
+	return config{
+		ReloadPeriod:  time.Minute,
+		MaxEntries:    100000,
+		MaxFileSize:   100 * 1024 * 1024,
+		IgnoreMissing: true,
+	}
+}
+
+func (c *config) Validate() error {
+	if c.Format == "" {
+		c.Format = strings.TrimPrefix(filepath.Ext(c.File), ".")
+	}
+	c.Format = strings.ToLower(c.Format)
+	switch c.Format {
+	case "yml":
+		c.Format = "yaml"
+	case "csv", "yaml", "json":
+	default:
+		return errors.Errorf("unsupported dictionary format '%v' (valid values are: csv, yaml, json)", c.Format)
+	}
+
+	if len([]rune(c.Separator)) > 1 {
+		return errors.Errorf("csv.separator must be a single character, got '%v'", c.Separator)
+	}
+
+	// Flatten the key mappings, sorted by field to build stable keys.
+	c.keys = nil
+	for field, v := range c.Keys.Flatten() {
+		column, ok := v.(string)
+		if !ok {
+			return errors.Errorf("dictionary column for key field %v "+
+				"must be a string but got %T", field, v)
+		}
+		c.keys = append(c.keys, keyMapping{field: field, column: column})
+	}
+	if len(c.keys) == 0 {
+		return errors.New("at least one key is required")
+	}
+	sort.Slice(c.keys, func(i, j int) bool { return c.keys[i].field < c.keys[j].field })
+
+	return nil
+}
+
+func (c *config) separator() rune {
+	if c.Separator == "" {
+		return 0
+	}
+	return []rune(c.Separator)[0]
+}
@@ -0,0 +1,169 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package lookup
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+
+	"github.com/njcx/libbeat_v6/common"
+)
+
+// keySeparator joins the values of composite keys.
+const keySeparator = "\x1f"
+
+// dictionary holds the entries of a lookup file, indexed by their key columns.
+type dictionary struct {
+	entries map[string]common.MapStr
+}
+
+// loadDictionary reads the dictionary configured in c. Loading fails if the
+// file is bigger than max_file_size or has more than max_entries entries.
+func loadDictionary(c *config) (*dictionary, error) {
+	f, err := os.Open(c.File)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > int64(c.MaxFileSize) {
+		return nil, errors.Errorf("dictionary %v has %v bytes, exceeding max_file_size of %v bytes",
+			c.File, info.Size(), c.MaxFileSize)
+	}
+
+	d := &dictionary{
+		entries: map[string]common.MapStr{},
+	}
+
+	switch c.Format {
+	case "csv":
+		err = common.ReadCSVWithHeader(bufio.NewReader(f), c.separator(), func(row map[string]string) error {
+			entry := make(map[string]interface{}, len(row))
+			for k, v := range row {
+				entry[k] = v
+			}
+			return d.add(c, entry)
+		})
+	default:
+		// JSON is a subset of YAML, both are parsed the same way.
+		var data []byte
+		data, err = ioutil.ReadAll(f)
+		if err != nil {
+			break
+		}
+
+		var rows []map[string]interface{}
+		if err = yaml.Unmarshal(data, &rows); err != nil {
+			break
+		}
+		for _, row := range rows {
+			if err = d.add(c, row); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load dictionary %v", c.File)
+	}
+
+	return d, nil
+}
+
+func (d *dictionary) add(c *config, row map[string]interface{}) error {
+	if len(d.entries) >= c.MaxEntries {
+		return errors.Errorf("dictionary has more than max_entries (%v) entries", c.MaxEntries)
+	}
+
+	values := make([]string, 0, len(c.keys))
+	for _, k := range c.keys {
+		v, found := row[k.column]
+		if !found {
+			return errors.Errorf("entry %v has no key column '%v'", len(d.entries), k.column)
+		}
+		values = append(values, keyString(v))
+	}
+	key := strings.Join(values, keySeparator)
+	if _, exists := d.entries[key]; exists {
+		return errors.Errorf("duplicate entry for key %v", strings.Join(values, ","))
+	}
+
+	columns := common.MapStr{}
+	if len(c.Columns) > 0 {
+		for _, name := range c.Columns {
+			if v, found := row[name]; found {
+				columns[name] = v
+			}
+		}
+	} else {
+		for name, v := range row {
+			if !c.isKeyColumn(name) {
+				columns[name] = v
+			}
+		}
+	}
+
+	for name, v := range columns {
+		switch v.(type) {
+		case map[string]interface{}, []interface{}:
+			return errors.Errorf("column '%v' of entry %v must hold a single value", name, len(d.entries))
+		}
+	}
+
+	d.entries[key] = columns
+	return nil
+}
+
+func (d *dictionary) get(values []string) (common.MapStr, bool) {
+	columns, found := d.entries[strings.Join(values, keySeparator)]
+	return columns, found
+}
+
+func (c *config) isKeyColumn(name string) bool {
+	for _, k := range c.keys {
+		if k.column == name {
+			return true
+		}
+	}
+	return false
+}
+
+// keyString formats values from events and dictionaries the same way,
+// so numbers match their string representation.
+func keyString(v interface{}) string {
+	switch n := v.(type) {
+	case string:
+		return n
+	case float64:
+		return strconv.FormatFloat(n, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(n), 'f', -1, 32)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,203 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package lookup
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/atomic"
+	"github.com/njcx/libbeat_v6/common/file"
+	"github.com/njcx/libbeat_v6/logp"
+	"github.com/njcx/libbeat_v6/monitoring"
+	"github.com/njcx/libbeat_v6/paths"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+const processorName = "lookup"
+
+var instanceID atomic.Uint32
+
+func init() {
+	processors.RegisterPlugin(processorName, newLookupProcessor)
+}
+
+type processor struct {
+	config   config
+	defaults common.MapStr
+	log      *logp.Logger
+	reloader *file.Reloader
+
+	mu   sync.RWMutex
+	dict *dictionary
+
+	metricsName string
+	stats       lookupStats
+}
+
+type lookupStats struct {
+	Hit            *monitoring.Int
+	Miss           *monitoring.Int
+	Reloads        *monitoring.Int
+	ReloadFailures *monitoring.Int
+	Entries        *monitoring.Int
+}
+
+func newLookupProcessor(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrapf(err, "fail to unpack the %v configuration", processorName)
+	}
+	c.File = paths.Resolve(paths.Config, c.File)
+	reloader := file.NewReloader(c.File)
+	dict, err := loadDictionary(&c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Logging and metrics (each processor instance has a unique ID).
+	var (
+		id          = int(instanceID.Inc())
+		log         = logp.NewLogger(processorName).With("instance_id", id)
+		metricsName = processorName + "." + strconv.Itoa(id)
+		metrics     = monitoring.Default.NewRegistry(metricsName, monitoring.DoNotReport)
+	)
+
+	p := &processor{
+		config:      c,
+		defaults:    c.Default.Flatten(),
+		log:         log,
+		reloader:    reloader,
+		dict:        dict,
+		metricsName: metricsName,
+		stats: lookupStats{
+			Hit:            monitoring.NewInt(metrics, "hits"),
+			Miss:           monitoring.NewInt(metrics, "misses"),
+			Reloads:        monitoring.NewInt(metrics, "reloads"),
+			ReloadFailures: monitoring.NewInt(metrics, "reload_failures"),
+			Entries:        monitoring.NewInt(metrics, "entries"),
+		},
+	}
+	p.stats.Entries.Set(int64(len(dict.entries)))
+
+	log.Debugf("Loaded %v entries from %v", len(dict.entries), c.File)
+	reloader.Start(c.ReloadPeriod, p.reload)
+	return p, nil
+}
+
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	values := make([]string, 0, len(p.config.keys))
+	for _, k := range p.config.keys {
+		v, err := event.GetValue(k.field)
+		if err != nil {
+			if p.config.IgnoreMissing {
+				return event, nil
+			}
+			return event, errors.Wrapf(err, "failed to get key field %v", k.field)
+		}
+		values = append(values, keyString(v))
+	}
+
+	p.mu.RLock()
+	columns, found := p.dict.get(values)
+	p.mu.RUnlock()
+
+	if found {
+		p.stats.Hit.Inc()
+	} else {
+		p.stats.Miss.Inc()
+		columns = p.defaults
+	}
+
+	// Check all destinations first, so the event is not modified if any of
+	// the columns can't be added.
+	for name := range columns {
+		if err := p.checkDestination(event, p.destination(name)); err != nil {
+			return event, err
+		}
+	}
+
+	for name, value := range columns {
+		if _, err := event.PutValue(p.destination(name), value); err != nil {
+			return event, err
+		}
+	}
+
+	return event, nil
+}
+
+func (p *processor) destination(column string) string {
+	if p.config.Target == "" {
+		return column
+	}
+	return p.config.Target + "." + column
+}
+
+// checkDestination returns an error if dest can not be written, because it
+// already exists and overwrite_keys is false, or because one of its parents is
+// not an object.
+func (p *processor) checkDestination(event *beat.Event, dest string) error {
+	exists, err := event.Fields.HasKey(dest)
+	if err != nil && err != common.ErrKeyNotFound {
+		return errors.Wrapf(err, "failed to add target field '%s'", dest)
+	}
+	if exists && !p.config.OverwriteKeys {
+		return errors.Errorf("target field '%s' already exists and overwrite_keys is false", dest)
+	}
+	return nil
+}
+
+// reload loads the dictionary after the file changed. It is called by the
+// reloader, events are enriched with the previous entries until the new ones
+// are swapped in. The previous entries are kept if the new dictionary can't be
+// loaded.
+func (p *processor) reload() {
+	dict, err := loadDictionary(&p.config)
+	if err != nil {
+		p.stats.ReloadFailures.Inc()
+		p.log.Errorf("Failed to reload dictionary, keeping the previous entries: %v", err)
+		return
+	}
+
+	p.mu.Lock()
+	p.dict = dict
+	p.mu.Unlock()
+
+	p.stats.Reloads.Inc()
+	p.stats.Entries.Set(int64(len(dict.entries)))
+	p.log.Infof("Reloaded %v entries from %v", len(dict.entries), p.config.File)
+}
+
+// Close stops reloading the dictionary and removes the metrics of the
+// processor.
+func (p *processor) Close() error {
+	p.reloader.Close()
+	monitoring.Default.Remove(p.metricsName)
+	return nil
+}
+
+func (p *processor) String() string {
+	return fmt.Sprintf("%v=[file=%v, keys=%v, columns=%v, target=%v, overwrite_keys=%v]",
+		processorName, p.config.File, p.config.keys, p.config.Columns,
+		p.config.Target, p.config.OverwriteKeys)
+}
@@ -0,0 +1,387 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package lookup
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/file/filetest"
+	"github.com/njcx/libbeat_v6/monitoring"
+	"github.com/njcx/libbeat_v6/paths"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+const csvDictionary = `code,description,severity
+404,Not Found,low
+500,Internal Server Error,high
+`
+
+const yamlDictionary = `
+- code: 404
+  description: Not Found
+  severity: low
+- code: 500
+  description: Internal Server Error
+  severity: high
+`
+
+const jsonDictionary = `[
+  {"code": 404, "description": "Not Found", "severity": "low"},
+  {"code": 500, "description": "Internal Server Error", "severity": "high"}
+]`
+
+func TestLookupFormats(t *testing.T) {
+	tests := map[string]string{
+		"codes.csv":  csvDictionary,
+		"codes.yml":  yamlDictionary,
+		"codes.json": jsonDictionary,
+	}
+
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := tempDir(t)
+			defer os.RemoveAll(dir)
+
+			proc, err := newLookupProcessor(common.MustNewConfigFrom(map[string]interface{}{
+				"file":        writeFile(t, dir, name, content),
+				"keys":        map[string]interface{}{"http.status": "code"},
+				"target":      "error",
+				"default":     map[string]interface{}{"description": "unknown"},
+				"max_entries": 2,
+			}))
+			require.NoError(t, err)
+			p := proc.(*processor)
+			defer p.Close()
+			t.Log(p.String())
+
+			event := runProcessor(t, p, common.MapStr{"http": common.MapStr{"status": 500}})
+			assert.Equal(t, common.MapStr{
+				"description": "Internal Server Error",
+				"severity":    "high",
+			}, event.Fields["error"])
+
+			event = runProcessor(t, p, common.MapStr{"http": common.MapStr{"status": "404"}})
+			v, _ := event.GetValue("error.description")
+			assert.Equal(t, "Not Found", v)
+
+			event = runProcessor(t, p, common.MapStr{"http": common.MapStr{"status": 200}})
+			assert.Equal(t, common.MapStr{"description": "unknown"}, event.Fields["error"])
+
+			assert.Equal(t, int64(2), p.stats.Hit.Get())
+			assert.Equal(t, int64(1), p.stats.Miss.Get())
+		})
+	}
+}
+
+func TestLookupRelativePath(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	writeFile(t, dir, "codes.csv", csvDictionary)
+
+	defer func(config string) { paths.Paths.Config = config }(paths.Paths.Config)
+	paths.Paths.Config = dir
+
+	p, err := newLookupProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"file":   "codes.csv",
+		"keys":   map[string]interface{}{"http.status": "code"},
+		"target": "error",
+	}))
+	require.NoError(t, err)
+	defer processors.Close(p)
+
+	assert.Equal(t, filepath.Join(dir, "codes.csv"), p.(*processor).config.File)
+	event := runProcessor(t, p, common.MapStr{"http": common.MapStr{"status": 404}})
+	v, _ := event.GetValue("error.description")
+	assert.Equal(t, "Not Found", v)
+}
+
+func TestLookupCompositeKeys(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	content := "host;user;owner;team\nweb-1;root;alice;ops\nweb-1;app;bob;dev\n"
+	p, err := newLookupProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"file":          writeFile(t, dir, "owners.csv", content),
+		"csv.separator": ";",
+		"keys": map[string]interface{}{
+			"host.name": "host",
+			"user.name": "user",
+		},
+		"columns": []string{"owner"},
+	}))
+	require.NoError(t, err)
+	defer processors.Close(p)
+
+	event := runProcessor(t, p, common.MapStr{
+		"host": common.MapStr{"name": "web-1"},
+		"user": common.MapStr{"name": "app"},
+	})
+	assert.Equal(t, "bob", event.Fields["owner"])
+	assert.NotContains(t, event.Fields, "team")
+
+	// events without all key fields are ignored by default
+	event = runProcessor(t, p, common.MapStr{
+		"host": common.MapStr{"name": "web-1"},
+	})
+	assert.NotContains(t, event.Fields, "owner")
+}
+
+func TestLookupMissingKeys(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	p, err := newLookupProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"file":           writeFile(t, dir, "codes.csv", csvDictionary),
+		"keys":           map[string]interface{}{"code": "code"},
+		"ignore_missing": false,
+	}))
+	require.NoError(t, err)
+	defer processors.Close(p)
+
+	_, err = p.Run(&beat.Event{Fields: common.MapStr{}})
+	assert.Error(t, err)
+}
+
+func TestLookupOverwriteKeys(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	path := writeFile(t, dir, "codes.csv", csvDictionary)
+
+	p, err := newLookupProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"file": path,
+		"keys": map[string]interface{}{"code": "code"},
+	}))
+	require.NoError(t, err)
+	defer processors.Close(p)
+
+	// The event is left unchanged if any of the columns exists. Columns are
+	// added in random order, so the check is repeated.
+	for i := 0; i < 10; i++ {
+		event, err := p.Run(&beat.Event{Fields: common.MapStr{"code": "404", "severity": "unset"}})
+		assert.Error(t, err)
+		assert.Equal(t, common.MapStr{"code": "404", "severity": "unset"}, event.Fields)
+	}
+
+	p, err = newLookupProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"file":           path,
+		"keys":           map[string]interface{}{"code": "code"},
+		"overwrite_keys": true,
+	}))
+	require.NoError(t, err)
+	defer processors.Close(p)
+
+	event := runProcessor(t, p, common.MapStr{"code": "404", "severity": "unset"})
+	assert.Equal(t, "low", event.Fields["severity"])
+}
+
+func TestLookupTargetNotObject(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	p, err := newLookupProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"file":           writeFile(t, dir, "codes.csv", csvDictionary),
+		"keys":           map[string]interface{}{"code": "code"},
+		"target":         "error.info",
+		"overwrite_keys": true,
+	}))
+	require.NoError(t, err)
+	defer processors.Close(p)
+
+	event, err := p.Run(&beat.Event{Fields: common.MapStr{"code": "404", "error": "text"}})
+	assert.Error(t, err)
+	assert.Equal(t, common.MapStr{"code": "404", "error": "text"}, event.Fields)
+}
+
+func TestLookupReload(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	path := writeFile(t, dir, "codes.csv", csvDictionary)
+	proc, err := newLookupProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"file":          path,
+		"keys":          map[string]interface{}{"code": "code"},
+		"reload.period": "10ms",
+	}))
+	require.NoError(t, err)
+	p := proc.(*processor)
+	defer p.Close()
+
+	event := runProcessor(t, p, common.MapStr{"code": "404"})
+	assert.Equal(t, "Not Found", event.Fields["description"])
+
+	filetest.Replace(t, path, []byte("code,description\n404,Page Not Found\n"))
+	filetest.WaitFor(t, func() bool { return p.stats.Reloads.Get() == 1 })
+	event = runProcessor(t, p, common.MapStr{"code": "404"})
+	assert.Equal(t, "Page Not Found", event.Fields["description"])
+	assert.Equal(t, int64(1), p.stats.Entries.Get())
+
+	// invalid dictionaries keep the previous entries
+	filetest.Replace(t, path, []byte("code,description\n404,a\n404,b\n"))
+	filetest.WaitFor(t, func() bool { return p.stats.ReloadFailures.Get() == 1 })
+	event = runProcessor(t, p, common.MapStr{"code": "404"})
+	assert.Equal(t, "Page Not Found", event.Fields["description"])
+	assert.Equal(t, int64(1), p.stats.Reloads.Get())
+}
+
+func TestLookupConcurrentReload(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	path := writeFile(t, dir, "codes.csv", "code,description\n404,v0\n")
+	proc, err := newLookupProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"file":          path,
+		"keys":          map[string]interface{}{"code": "code"},
+		"reload.period": "1ms",
+	}))
+	require.NoError(t, err)
+	p := proc.(*processor)
+	defer p.Close()
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				event, err := p.Run(&beat.Event{Fields: common.MapStr{"code": "404"}})
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if _, found := event.Fields["description"]; !found {
+					t.Error("description missing")
+					return
+				}
+			}
+		}()
+	}
+
+	for i := 1; i <= 3; i++ {
+		filetest.Replace(t, path, []byte(fmt.Sprintf("code,description\n404,v%d\n", i)))
+		reloads := int64(i)
+		filetest.WaitFor(t, func() bool { return p.stats.Reloads.Get() == reloads })
+	}
+	close(done)
+	wg.Wait()
+
+	event := runProcessor(t, p, common.MapStr{"code": "404"})
+	assert.Equal(t, "v3", event.Fields["description"])
+}
+
+func TestLookupClose(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	path := writeFile(t, dir, "codes.csv", csvDictionary)
+	proc, err := newLookupProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"file":          path,
+		"keys":          map[string]interface{}{"code": "code"},
+		"reload.period": "1ms",
+	}))
+	require.NoError(t, err)
+	p := proc.(*processor)
+	require.NotNil(t, monitoring.Default.GetRegistry(p.metricsName))
+
+	require.NoError(t, processors.Close(proc))
+	require.NoError(t, processors.Close(proc))
+	assert.Nil(t, monitoring.Default.GetRegistry(p.metricsName))
+
+	// the dictionary is not reloaded after Close
+	filetest.Replace(t, path, []byte("code,description\n404,Page Not Found\n"))
+	time.Sleep(20 * time.Millisecond)
+	event := runProcessor(t, p, common.MapStr{"code": "404"})
+	assert.Equal(t, "Not Found", event.Fields["description"])
+}
+
+func TestLookupInitFail(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	tests := map[string]map[string]interface{}{
+		"missing file": {
+			"file": filepath.Join(dir, "missing.csv"),
+			"keys": map[string]interface{}{"code": "code"},
+		},
+		"unknown format": {
+			"file": writeFile(t, dir, "codes.txt", csvDictionary),
+			"keys": map[string]interface{}{"code": "code"},
+		},
+		"missing key column": {
+			"file": writeFile(t, dir, "codes.csv", csvDictionary),
+			"keys": map[string]interface{}{"code": "status"},
+		},
+		"too many entries": {
+			"file":        writeFile(t, dir, "codes.csv", csvDictionary),
+			"keys":        map[string]interface{}{"code": "code"},
+			"max_entries": 1,
+		},
+		"file too big": {
+			"file":          writeFile(t, dir, "codes.csv", csvDictionary),
+			"keys":          map[string]interface{}{"code": "code"},
+			"max_file_size": "10b",
+		},
+		"nested values": {
+			"file": writeFile(t, dir, "nested.json", `[{"code": 1, "info": {"a": 1}}]`),
+			"keys": map[string]interface{}{"code": "code"},
+		},
+	}
+
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := newLookupProcessor(common.MustNewConfigFrom(config))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func runProcessor(t *testing.T, p processors.Processor, fields common.MapStr) *beat.Event {
+	event, err := p.Run(&beat.Event{Fields: fields})
+	require.NoError(t, err)
+	return event
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "lookup")
+	require.NoError(t, err)
+	return dir
+}
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+	return path
+}
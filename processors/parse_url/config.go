@@ -0,0 +1,45 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package parse_url
+
+type config struct {
+	// Field is the field containing the URL.
+	Field string `config:"field"`
+
+	// Target is the field the URL parts are written to. Use an empty string
+	// to write to the root of the event.
+	Target string `config:"target"`
+
+	// DecodeQuery adds the decoded query parameters as `query_params`.
+	DecodeQuery bool `config:"decode_query"`
+
+	// PrivateSuffixes uses the private domains of the public suffix list,
+	// like github.io, to compute the registered domain.
+	PrivateSuffixes bool `config:"private_suffixes"`
+
+	// IgnoreMissing skips events without the field instead of failing.
+	IgnoreMissing bool `config:"ignore_missing"`
+}
+
+func defaultConfig() config {
+	return config{
+		Field:           "url.original",
+		Target:          "url",
+		PrivateSuffixes: true,
+	}
+}
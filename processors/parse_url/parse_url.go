@@ -0,0 +1,188 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package parse_url
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+const processorName = "parse_url"
+
+// hasScheme matches URLs starting with a scheme, like `https://` or
+// `mailto:`.
+var hasScheme = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+\-.]*:`)
+
+func init() {
+	processors.RegisterPlugin(processorName, newParseURL)
+}
+
+type processor struct {
+	config   config
+	suffixes *suffixList
+}
+
+func newParseURL(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrapf(err, "fail to unpack the %v configuration", processorName)
+	}
+
+	suffixes, err := getDefaultSuffixList()
+	if err != nil {
+		return nil, err
+	}
+	return &processor{config: c, suffixes: suffixes}, nil
+}
+
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	v, err := event.GetValue(p.config.Field)
+	if err != nil {
+		if p.config.IgnoreMissing && errors.Cause(err) == common.ErrKeyNotFound {
+			return event, nil
+		}
+		return event, errors.Wrapf(err, "failed to get the URL from %v", p.config.Field)
+	}
+
+	str, ok := v.(string)
+	if !ok {
+		return event, fmt.Errorf("field %v is not a string, value: `%v`", p.config.Field, v)
+	}
+	if str == "" {
+		return event, nil
+	}
+
+	parts, err := p.parse(str)
+	if err != nil {
+		return event, errors.Wrapf(err, "failed to parse the URL in %v", p.config.Field)
+	}
+
+	prefix := ""
+	if p.config.Target != "" {
+		prefix = p.config.Target + "."
+	}
+	for key, value := range parts {
+		if _, err := event.PutValue(prefix+key, value); err != nil {
+			return event, err
+		}
+	}
+	return event, nil
+}
+
+// parse returns the ECS url fields of rawURL. URLs without a scheme, like
+// `www.example.com/index.html` or `example.com:443`, are parsed as host and
+// path.
+func (p *processor) parse(rawURL string) (common.MapStr, error) {
+	toParse := rawURL
+	if !strings.HasPrefix(rawURL, "/") && !strings.Contains(rawURL, "://") &&
+		(!hasScheme.MatchString(rawURL) || isHostPort(rawURL)) {
+		toParse = "//" + rawURL
+	}
+
+	u, err := url.Parse(toParse)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := common.MapStr{}
+	putString(parts, "scheme", u.Scheme)
+	if u.User != nil {
+		putString(parts, "username", u.User.Username())
+	}
+
+	domain := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	putString(parts, "domain", domain)
+	if port := u.Port(); port != "" {
+		n, err := strconv.ParseUint(port, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port '%v'", port)
+		}
+		parts["port"] = int(n)
+	}
+
+	putString(parts, "path", u.Path)
+	if ext := path.Ext(u.Path); len(ext) > 1 && !strings.HasSuffix(u.Path, "/") {
+		parts["extension"] = ext[1:]
+	}
+	putString(parts, "query", u.RawQuery)
+	putString(parts, "fragment", u.Fragment)
+
+	if p.config.DecodeQuery && u.RawQuery != "" {
+		// Invalid parameters are skipped, the valid ones are still returned.
+		values, _ := url.ParseQuery(u.RawQuery)
+		params := common.MapStr{}
+		for key, value := range values {
+			if len(value) == 1 {
+				params[key] = value[0]
+			} else {
+				params[key] = value
+			}
+		}
+		if len(params) > 0 {
+			parts["query_params"] = params
+		}
+	}
+
+	if strings.Contains(domain, ".") && net.ParseIP(domain) == nil {
+		subdomain, registered, tld := p.suffixes.domainParts(domain, p.config.PrivateSuffixes)
+		putString(parts, "subdomain", subdomain)
+		putString(parts, "registered_domain", registered)
+		putString(parts, "top_level_domain", tld)
+	}
+
+	return parts, nil
+}
+
+// isHostPort reports whether s starts with a host and a port, like
+// `example.com:443/path`, which would otherwise be parsed as a URL with the
+// scheme `example.com`.
+func isHostPort(s string) bool {
+	i := strings.IndexByte(s, ':')
+	rest := s[i+1:]
+	end := strings.IndexAny(rest, "/?#")
+	if end < 0 {
+		end = len(rest)
+	}
+	if end == 0 {
+		return false
+	}
+	_, err := strconv.ParseUint(rest[:end], 10, 16)
+	return err == nil
+}
+
+func putString(m common.MapStr, key, value string) {
+	if value != "" {
+		m[key] = value
+	}
+}
+
+func (p *processor) String() string {
+	return fmt.Sprintf("parse_url=[field=%v, target=%v, decode_query=%v]",
+		p.config.Field, p.config.Target, p.config.DecodeQuery)
+}
@@ -0,0 +1,26 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Code generated from publicsuffix/public_suffix_list.dat - DO NOT EDIT.
+
+package parse_url
+
+// publicSuffixAsset returns the public suffix list of
+// publicsuffix/public_suffix_list.dat, encoded with asset.EncodeData.
+func publicSuffixAsset() string {
+	return "eNrEvVv3LMdVJ/hen6KaXrOwQfX/n3MsSzLY8hGSMVpjZCO5e1Y/nRWZGZUZlZeoE5FZdaqeeoZb09BcuoGB1Qba3RhjmqEbewzGXPpBX4A30WtekYS/xexLZMaOqNJxzfQaRvqfjNi/jIzrjh07dlzq/n795cb49Tt2cqVev24rvf5x6/o1YH4qdroc16Ndj41ej9r1fm23RPykPZuuU+svTUVnytX9/foLptSD1y+sD3frR3cP7tZvbtdqXdr9afnmS19YH5VfD3ZcV8aPzhTTqKv10YwNBDAeo9maDuL4F3Zal2pY22JUBpxBr9W4bsZx73/k/r7nxO+sq+8h0ntI7v5uhV9/qdPK6/V+6jqKcd1BOuuts/0LazVUEFF3InKJa08F8NN2a55RhPhFQJ8w/AShu0qNL2ASTkFZHMQO2VMDFI7If/76O2tvRu3voEq6zgw1JxND/LO3v4CVioWvJ+XUMGooO9RtoaGm93vroC64FG8OUDlTORo7QIUPVByMEUswefTFsmEtQQxbO8FLUUfXy8Xxf+Yzn/mxz33+zbfWb77+2ltvrd/44k++9uZb7wBKb1W5/hGKBmIZTHmnyns3dVCyZuxXqlyVtgdspasJndoe0Bn0iE5vOnQgPXQotirEhpnSw93RtGavK8Oth9T9napW8DdgvBV/pMVHY+XUHSWjIYJ7pSsFj83eQvmM9iulIUvwkvOgOXG98mWDjirxyZ9z9nRIwllIxGu9JHQ8Hu/MsAXuV1j1dxjk3gyVfna3b/afNdVnXnpphSBEWppKD+PGDAftR1PHD+K7vdMHcOMLeBQQsIxk2U1FpCpgGB3IGr70wW9c6dR2XCjgBb0QyDcLsfGTO2jsG0MZg4zw9XZJFkIFYFPaYXS2Cy/6YpLf9ZPXPeQikN7b0shiTmNjHfsL1XXWDsCYgXa21eFdqdTscbWdvSBLltCldqOB7IjIy0b1e+T+xuwXBPrHHKc5mE4dZHZiTUKhttrppSBA+6kb1VySQMfkZSWU0I1KMxNOH9lXaW/qkFJVl6FE0AMg03MlQGU5aLXyFMihXpqJ/XPuoU6hxCDWYu3qp5PZC/IZFHeo56+fASf5wAxbXWknyr3tTN2Ez7aTDjmvO1MtBQTe104kVjuUFZBA1cUwgIWaxpST7xvgymIyXfjcQPW5yCc7GDgG1SUEikuiURov8XS2hhcgk5jsVa3OSxX1UB+jHmK8PckI9poSGiiWs4dBqot5H4ATZAfcg3Atmym2MAJJifbAzdAm2m0u2HpvOhuSEbW+h87J8phpp4E3lKT3ha1OM+EXj1aOxBBSFlhFdGSvtnoMn3gQZCAp5mrz2h1gNA3R+MYGNvTtqQLWn4vh7XY8KhcqzI9TtbQx9PBq5jf0L98AAXUe3kzDUoKjdS0EEnyASMgBycttHBVQUNLIsL1vdLe/2/n9Sm1ZyG55fNiyGN6yUN7yaLHlmOorMdX3e0dFpkGm5khqjqTmSGoS8zWPEzVHZdKhir4y9yDkVna7BS/HYzgCw9EZ/rRLcwFdkgeZ7h68T1RX3G/V0ycVfAhZ6j77ZvWZTzxcqY5j7LhAHZe544Gl42Q6TqbjZHoxkmE6qseMQsB7GsBOOKR9iXxPPvfW3b7aguSlgvacEjn9hP2k5/h7jr/n+O0Nw6vFkKKs1QANa+/fBh3gnS+/fffGF19fKRRnGLI+4NPW+MRsAPMX+DQjPinJpzck+XQFfxTaidDU1O5ee/RtFMpL6KbqfuAx96BWyq0KLKTj0qNj9+hSfTuo74KdAzpmoKBU++CAboSyGX3jpAgbODKqMQcda1CeQoyTQ4dzuFc3lAhCYejXYXgxDqTTujitnUaR5k7rTxs1qM3TCZUBGF8eQz6GAb9+df3owYNXNg9e2jx8ZUVx6IcvvcixmWGjqsoFYv9S8Djj2Tc5M3sG9lB2/Q2ZBa3IcyX58JG5pYwQakUP+ma84YvxeXUyPqYGH0UlvLyCT1AnG4m7RuK3cYWD6AgiDGQlvaP0pxvSnzLWVlNF78EDYoDePvrCG565aWJ2mJgdJuapietpgoIP6JgqxPoTUAwLQokiWH+s7KxnhX3Qx7mUNAa8sIZ5DGv/a2h/mHroZ/Dy4ytUJjEyVEko2XOI+vXPv/GWX29kxsu6GmLGS8rm4I/kEPG0o4wh+06rUXl0Dli90+qoQryfwKLix6FkoJ40KFtmGoLop4GA5N/WPegGNG3A+ZnTxMDzdO2nJg0Tug4nHW9oGENHVCHw5edI74GCUwZD1MOSKGY0eCGzwYcZDt5DzM9RiZxhvrkp1usfm+r1p1558ZVHL6bZFFn8PDTB+stqaJWr8HPMi/z8ky++/KlXPvmRn3/6nUbpuzdgZgklfLyMuDGWVynSLEsvPnjx5VeenyXoDCDO/PrTSN0F6vES0atUP4HlvJp9WD/Bi/UTvMflPeTlRWxcbMG5+u9ipX7fxvyIFoQK9L0aoMfDdMnajisgtAk1yvGGTnhcwR/1sCN/9OyGj56t4I9Cn28IfV7BHyVx5k58ZuF/5t575i595i59Dj3vvNrv8UnjA3yncHp1Rm0OncKc0cEsFCrVIwp1D7Mf55/ApF/do0nCg34CemY3mPaJfTL3/tLsDI3ZhaLMgYMZAAdzBQ6mDA7mGBzMY8HCtSi+f6GLYoV/kE1wQFrSs0eHEik4kYILCy6lUnAqxQqFl0bPSIEo0Rvm4kW1+iF8UHh9Q3j9nBFg1GXzGLWNQscx4MErMMTjGFDwTLzY3pAKVDErmAUrkUV9w0f1PDjMyhfnDNTfoqYGvvcjTsjZvoGaGM/2UeFbwdcKoyjwUeKjwofGxxYfNT4afBh87PDR4qPDR4+PAR8WH3t8PMUHJr7y+BjxMeHjgI8jPp7h44SPMz4e4OMhPh7h4xP4eBEfn8THS/h4GR+v4ONT+KDKaW6onGYFf8RPDfNTwwzUMAM1XNsNR2huiNDIobixoMVQVwJ1vDDEvqyNg0PJoTbOzxodTuZ8SzrUdfmDXaZcFrv7YrdhqxdI8/EZ6Sd+Knozjjl34swfBp3H/OGrq2IHsxiHKiT6YCpELkzgYGQv0bSHpIfZL3kOFkQoYaXlZ4+OZgqcgT1Y1l3oozuYBBtvHH3Ww9hzQg9V+44rApxRowOTx9HOHoUzbgo0wgzbeEoJZm8HdKki+nQ2U2jXgyZEJevvq8FtRv1spPooeGIBDmWt53bmuUXBc4uC5xbFkEU6UHwDToz8qhg4noHjGTiegeMZOJ6B47F5K9l7mMHrGuSnHR49ePhJtK/902bz8O7hg1VhOV7L8VpS+QtL4h4citdyKpblqyURBzNWTQEhxXdwUt+t37BoVkCNCGbDPXBRYUO7guuwnu2q6CxFWICedOAgOBkvgxdmH2RvORAJsdgSxX6gyGhLWS0tmjdU8MMUNfh7tCIozn9lYL4z+ye0fwdiSzlCi0dpOAAM5aZHmwC/4YkNFlvBxEVRmSGNwmn2gQwzoAsUaNqYo8Xp5Rg+gxFvqz1UNn+674CJBqr9AEwa6gF9Th+gd9BHXnVTRbWryyEWb2y9orqH8W/wSw1g50SXGtxFxgndDSJ39xVUy2DsPdr/aov5JEmLDXa9h24HfdD+sYgDeqlbfaqeVAm84VaqKMmpenYO5NSOnB0H4VcDdAbygB6kSwOzBCL25Lin7IzkYKU7lPvwUG7Sjj4sgGMpqqI5k2M4hOHQxEbg9hU5VoV6BL+ngCWaNAfIGyjUWgCe/c9M8BlKpBxqdihL1CEcmSpVrYPf7tml3JScyXIyqlDsc9D87K/0lh3PzsgOVRa0LTtUDOpyDtmWnGHLDuVFewbHkh1KcKuorrfaOEpqG+oBXGf2DFF+twNVzBb4BXkOfSM7lP+tJ6p++IgcTbmpa0q/tgZVVPZSpoFj2Ld+9NIax3CYef3wutp+n7HjHQr5xG6f/JhTZ9OhEhJihDzNvn7x7WdfoWZfqWdftZ192s++2s6+fvmirxffEq4fZ99+CbcvFt+Sxt4sPjf73G7xDYtvSdfFcEtqfimlX2L2S9nG5dvaUeObnvjVcIMZ5oGdKtjpuVF3FcPWDKAVd5oJ4oXdRGwG8p0dau3AFp0dKkcyDquoVPvZp7kbwVxE8ee9whWC8Jp4A+QjOYYi7EG7DfEQW/b8GQhIZq+BuXyAsdtZYtUfuhu4Gw3MecNI2bUVpWyZyWl4Accrzz1ir7qee+beUnL7fc0Nwq99yQ4lsT806Dz1lAW0u1IgEDjBMVvNPsqzMwX2Gw5jZqdA6/pMwPDB4gik8UFVXMN+bgUPWoHCmlooC9LFaaYsaAEDzI7D97a2Q8kBuWn8jrLlO6Y66ojeOlBsWG54xxwCwolcCj7q4FC0MLckhxthdNREaFxDh76eKvr4wO1xMM/ICfzAo4ZbnTkPNHj4S/Nw4eMSJGjmbM4BhxrZc6N5Fl5s9irY7FXcYMIqQCUaOcKRoxg5ipGjHzl6tkkVB4jwLZuYfzxPuY1fjwYmmM8ZznbKdfox6G0Q8QjcCIPZgaO9YZZdHDNTF+hFZqAFSxxYQdHB+e19cbyrLC3I0/IwTPZ5+fnRUK07GE279dhVfv3ZVXEkxfXIxeOJO+T2++fjJPIBcQHAjfMEJngvPYGv5kH9+2XgxPV84i5N0X4R1yVRdRPrsOvKal43RxUKdQdcfB/X1GQnXFNXa1xqcQdNhiCPCli14bS4ZthCZ8Yf9DCnxfXzCU15ytPGgo/hGjBXqK1BkoV4oSNhbPjipBprA/7CGjK21s+gC3b64y+svV0fNRfVjGsoqKYAvLoD6XnVAk/wtytRc43liejpfmW387vilvnPOeMDUEzMWVM3OcNci80kBZtJCjaMFGwYKdhaUvDkqbzBIlwqUE1CYBiUQXnZN9AE3Rp5DRTrAkOADgbPnvwDP7f07Ojp6TnSc8KnHfAJoxw8n9K3vsXniZ44tiP4ffNGobjB9Vp13uY654mD4LweOiRzwo+/9lMr/pBLNd5SByMaUvmD8obw5arkvQ/lDfaWsrosgheir6xC776HmYx2I89pnjzCRbfPwuz1Mw9XEAU2a8lGm/IGc0q5XZVsRylvsKOU9apkw0J5g2GhbFYlWw3KG6wGpcm4mcpsxLaLPWi8n+Hlf1COiZ3JwSdyOjn4RA4nB58KY6EuAE5N73Gtl9x3v+EsbZsAAmeV4NBar0ZfX/EX0wFdKkZ7QzFaUC/g8U/IlN/yd936SnN2F+ayu9e/IMaIBgb6Ggb6xxz61VXZUfk6mgeXvOJY8opjyWuMZX9DBvs1zPpA/JFh/eGnHr28KmmdsWRzQMl2gJLtACUbAMrhhoiH5wx4oxpOClfqH5dkOygHKM9AjcPWg5KtByVbD0q2HpRsPQCHCjms3v/ZP37/V76Dvg//+tc+/MuvkO/Pvvnht78akoeMRuk0y6YGXxY7SuopPrfkryt6enqe6fkMn42iZ0FPTU9DT8pCM+BzR/4dfbujrzrCByrJQMhTStdTET2l5RmhkJ7CjJSTZ/ykPJzo7ZmQpqWyWwp5xCeV8YaV3tI+rzFgqmdKi/oHTkF56aAEJaQka4jHr6lN2PICDvIoutQ2wVBRsiWmZNtLyZaYkswR6FDD0QYJdNAiU9qQ/Vu41BI3hg/s/pYv7J7mwvyNu9QdS4c2p9I94R1x9h73U7wdLApvsEXCv1M6DSlUuOmH2NNR33AkTWB2avBZE4KSB9VpfFKaNyxTltMK/7CKeNWx5DXIklckS16DBAdnWyWvvJSHG+I9JCHF8n55QN2sPDzZ04QbxpDPQvKfeeOdH/3kiy++9OInX3744MGPPnz48Ed/+Euvff5zH3vxAfz38JWP//D/vHn9tS9vXv/iG29+/osfe/uNL/7kx3/47dff+vLHIPjHf3T96U2i+a5J71tBLqhoBy7agRnkgCyh0aWSsqJbHtOsTjCFK4/35XFeUgF16KOXE2bfY/wMKg7XFB5+YvPgE5tHL61KXoICh3Jx5Jpl9bZk9ba8YV2qfHaD6gqBqMF4Aas8XeE6Lsj1rvglmOWejB3ttP5xOxq1/nR5gkZ7POHmMfyW1x5RjO1haryetzrSHtKLEeV0H9r4npag7yu9VVM3hmUrf+q3Fnr8kxr+6Rpk5JaXrMoTMfqJFpjAoco7rXQLUzMQDiVr5+B0Y4UOdfcT1+qJa/UU9kiRx6IzUhxUKTeosiXoqqyLVjesNFXPW2mye/+4IiW40q+uP8ZbinXZDKit0rZdmiHwhO3jYS3q5c2Dh6uKV6Gq3Q052K0qtrdXN+gEVfs8VsbJ+fi4ajc4EegVTQaqNtkpUbEeUd0gOKt+VfFAXvEIXrElv2Irf8Wje8WStbphJAFJWNGwQDIR4+VRoWJ7fMWDQsWjQMWjQMXiHxwP08KKxX/F4r86X/aR6nxvetDu/D3w4xNA7lnNI/aszpz6mfW2iqc1FU9kKp7IVDy7qXiuAw50FHS8xciRGStmL11epq5LnCfc75W3D++U3z9n5DyoorBT9Zg/e3WleRM0ODQkat7+DM4WJuTgtA8foYMGK3Cwa2jeE61527TmbdPkFBQSKlHznAHef//WgUAYEX+gs41smjKj7z/3ubf0iJMHkK0a9z/SxPyfgmhTT35spTVnhfdGg+OMadHtTIEOZV5zOTWUwaCjzB4dKoqG0mp0KBM3TCN0vdK8sQ99mDY404AOZaHmaqh5nV7zrj/NewDB8aVBh1JzN6SGBj/NQ7T2VzRxlI0gL5+AdopyMjhhicLLlWDN9iZwBnYoR54bj81Omu1N+obppB5Xmu1Nmg1Nmi1Mmq1P4KBABoerYQw8xtYozdNQfQuPAIswh2xvmIdtDTQn74sE5fDulk8UjZR0eoRnqGiKYfPKvlFoYLFodBnWW3WwDkdT+ORu/ZrHDSkO5rvwj7dL9TBNYMFE63XrCereLTl5AQ0sHLDVeo9mFlA9cFMLjdLTAK9itvkUCVTvvtOj7rAb074Ysst9+YtvfPFH1q83GuZ0aKWZ9hWtHXxMP9vjEu98HkPkX9Fu6fVPPbwHyfypj6/mhLhmd7HvhczfbXfPGfk/XYNQa+56NJm7x6UtS96tNpxR8j96sHnwaPPwIcxUcGyGJ7LClteWwUFu2Ya1ZHCxu4BDfLLlZeQtLyODg1Jny4PV9obBaosT2C0PONsbBpwtjzZbHl+2POhsedABZ8sjzfaGkWYL8wweI7aZ9q622E+37j7yBrQVzFxKZ/aj2ea9eglPe8exL5cT7njy96+ryuknuOLc3b+GoZ68paCf10/CztuHjx4+AlXypSf/POzB3Toedra89rZ1bBHYOhIBWzT/V+iMRMjseeAj64zuPjJ3ergP+8n9BnhpM+89uweW3mzdBnTMe45lUyAbIhaYa/MiSyTclU0WDMwnbUQIvgk0N8wzyEp0GtUXqnaBMG5ytdEQTcW7zTkw8j5M/zbYZ0ZVdIzCfLrXo9Mbfk2Q06NCTzMZ7412m92EG/o1YlDnuoThj6poVMZxNNCTXK9wRZ2okOeDpgMgGApJsvndYI2s1apmw12N26dI9CxdC/x6u4V6Mwfs9hWaj4fxOV3xDYUr7HfvNOr4eMfziVXNG6XqGwx3dUWsX7PxDR22v9X6UtfAzlHrsOMbTeTIYzWPrjUPxDUPxDWPrjWfVar5UBM4+wM5lMANBr4aomcDX53ttseTJYMGyYntjNvQ5z1RM489bwI2mFp3j/MowjTs4cPNo1dWNW3Tr3n0rnn0rnnYrm+wHtbNVYvuXJF1g/l9Mtgj2gYx6Gvd2NgJop4HjwqYqhyBA9QYlgLC3A3HG1xF2FtgXmDzeadkb1FI0Nk+IE/rRh00DAJ6iCHJog/BaIWHjuH16oSDDARUZXu3qnkrVc1bqWrePFXzVipwqC3ZKFqbS/aojVzeqtmuCQ7OvGrDcRk89oIOJcB20JpHobq7oV47YWelNCEhsizWfKSh5iMNNR9iqPkQQ80Gxrq/kuf+HnOL1ln/P33y9brnA3knOsBRs/ivxQaivUfDEO5F8vf1AH/z5qyP6J24TeL0OHwFHZOshjVbDWu2GtZsNazZXFiz8bBmaxm8uKFS7AFj4A/2V8q4F3ZoGPfrz2hIcs952HNye2iXwqBLWdpzXvY8fNRslqpvODFRP13VfGKiltpt7XAShYemcOdEc1fj+AHKvG/uH7708OXNj21ALfnk99tH4zXI7uqxjAaqlMe2mnd91LxkXvMSeM1bLeogm284clCDPsxKcD1mI189oqZt7L20HT0J7JJULevFNWvC9Ugadj3SVgRwqBexHlyzzlyzOlxPse1qVSne0VBPi2xb6uefDTA6OMgNHUr+/HRxPGeyNc2u8roEfLDl49GZ0Q5LqJqNejUb9Wo246EzKUJJU6vZyFezyQ8cbNGaVfP6hoXf+ngRkpjziMVzoOesajZv1Tes3tZy9Tauk50gkhNJAzYEgUMlYiNQzWafms0+dVjHbLOqa1rjSng+hw+b9o62BM9BX11BcEwPHEwPHEwPHFORg8mCg8mCE9YBwPfBb/z2P/xv32TfL8++X/9v73/3KxTuX/7i33/3u+j7++/8UfB9+Cc/P/v+7Ju4hoA+Xk1A31/+zId/8evz229/dX4bfPD2L3+efN9ewn17xqgyblCZm37VsFxshktZ0wz38449D8LywaMXXpj7dcNSr2Gp17C4a1jcNbxI0gzUVxoWfo27NAg3TujDoH7c/8TbX/7CGxtom6ljqxgpJY1bwZQDnnh6HV2aXTQsKhoXVrCvZH+8p50IwYJTwgSg4d4Mjm/sHl1aT2jCjBZcVU0dHq/jkow0Z2m4Z4ODFggM5Ogd7XfDMLYjFMVrw4ICHKcJJSUdA0Efp9eU3SmrDVIU7pppXlj9XBCoaLWaBcVHKEB75c+gmD824EAMwlz3CLRi7EBN6PUNd3dw9s4c0KW9kuiBSQM84csH6OKOQIeewnb0usQzsxRBCXIKXdD2aSfn7G3JuzUdRQQSfSJPjQZj9DR2xPrAnNRqBOmK/p2tIfPoa+1wAoWIkuhUq+gbPm/boKw6EuB02ymK16MhZAqtCO7UGXLPjI/27CuNvmlUZ47tYCpN8WNdGqlN72FcN3emwmlQqH85LGzw3ApocMynIAZw2IcnToTBgQqGZ4Vbag0q3vhE/kfnhE/kJHCsw+feDnuoEfDhZQDg0FjKarq5weJs9MqwWm5Y9Taoac3/CZYyaHHE5jbdvVSjox4bwofEZHjTbcoS53xpNdDKCt4F8c4XX9+8+QVO8mO8GzmsG0NuwjrW6eMr3s1oSKUzvEoMjqm26KBBEpx+Ggy6VEms4Rni9mfDZvNiVbj2QanXH/uBN71TuvuBF9Y/oQunjx+HQr/5hdV7v/3eN977w/d+9b2viE/qajy9VN7JCO5WEObr7/27934Xvvj1u8vPPlkVTfdKlX+E4X7jvV+78sErVfH0kcrD/8F7v/7eV66EbnRRqVeKPPjvwv/fSD+gJu2v2AZN/zwbDvbvxxzs1ZXpqd5pLd2wUQQcWjMhgOqa7SLg7Lsy4CPB4wGflI8b1trNcH2vyDKOGx5E6FqMeBsKTHr0C7xninI9zPeQ4AUr84YrDaMBb5OivVFQ4N76MajS4f4AyCTM98eJhwuQLqtP1pipl+iJ1QBPQ88en4WB0YA9Z3Lw8hJcMAI/iDN8DvS0/OzZATGDLgWrdNdQjBVFhOIeHSJoOAG31hQLsTw4005BH0Ev6o3kbC27AQWNkJoF/PQCRjjD46jhURUdqilut2G15yfUCLmOPkMTBzjQZUGako8yMLX0pEJMFILb9xZjGIai/IZvxls+Gf9HzyVjFHqimChZezm+o/a+XALz/RdIDS8amVCOp5kNoocIny4TGfCap7Oq/pBSME+5OZ9yiz/lxnnKkT7lVnnKTcWTJnNl5d+4+y/jnUVPYMh5AvVTGaqEJ8a98OS1/V4D8OzJwydhR9Xbs8R9DfrWci9MGt2bxDokoRVuuqvmj9+iLSdoYT5aqHSYvL4K4dfQZxyu26qqujyAiprwZtSqZ1niXn0B7SgPNg9e3Dx8aWVoH4KhfQiGZ2PgmAqfVG6eqBnHY5ubpV9fF+oT6sXtw5cU5uBjS2ZeWH8JZz7Q5//F537i46u/+/r/9Ut/96d/9/X//nNXPnYX377mVGHK+dP//q/jp1T72Z5d46lIfhnhiXWCxeYjOBW3MU/AoR5UFuiPPnDow0fApCvDe30Nr8QYXnQxvNfX8IIMONTBeRJqbuk5oInyEoxhRdJQT3o77CXFLUUbWoRffwyzSTt9gdGh9x/MUGr/8R/JzxdSqcer6/B0juxgcK9u6e/fXtTuJ6DJmpp56snh5buHpIZTNijJlSocZgyc6Xy25AUxpDYH1XX6tNCRLEAFY8d0uF1WIVWqLjgQkQlQH5w9Ha/ASoBuZtQGVH8FWYpIAlB2ttBYndkcNjW6SkL6GnYFGvQZ0vqoF5d4jlymfSXpy5Q/KuGPSFcme6jR6dQ5OIaaozN1cKZQs53tg1MoVy0YEtRC3VTONd4rF5yy0eSzXXCMJ2BvdNVb5Gzyo5fxfiBnqoMTcuk5RnAqHdoM/SYk6IlF0THdgpiO8jUqAkbrgwPZZMTRRR0bP1Wb0TjO4gK++1fX0Km6GvJKQH31c30R1G7URuEhuhS7AnWj3VyHL1G/uUzHX4GuFN1eL7u9Vnh7tfT2evHt9fJL/5WquKyJ6xVxtR6uVMNlLVythOt1cK0KrtbA9Qq4Vv5rqV9N/Era15K+mnKa8IRdgPrG1BfBCZ0cBO6m2pDsXUhJpe+SV53eZGR1AaR0SuWhs8Acu57pd7+RAXpOLwlS5YHyENnrKn8vAWLVQ8V50kNw8CgTj7FfmsfQlSLxhYuUNR13RaLjJ8wU8DQTV3fKygkLq4GfpWVpxR9tCjxtM8JIiQdVjXhB9PI6vpiRLDyRSeg4Cs8ezseenk/5OZmOX/Pw7fQ8evsSZPtmj9dlCkDSI6dOIl8d+Ek3nlke2PnZQe0kkmaGJKsvwXSOsc+Z4Iqq2sRaT6pEwJCbKeRGD3TNYSBcrXr2UrsWHL3RHVdGwRngxDkcnizmdgPvWeVyOoDsPeusxIwkBQ6BdAaRx/FT+zKUw+GRIs+ZZK2JKr2YLit3ulK505XKnZbKPbN6xU8cnrmyQQcboTa9n/mJDgJXWHI0wtkEShBbkNmTKFfgrs6NwV1LhjW+GcwwB9PhTQ9fKgEIGk+VBO84qyXkPSvHiZHkKzU/8Q6tzda6zmT0u38cgey9eN3w02hm8pKfrJxStZWcpu2DC9+fOU+On6gCBcCOduBscWGp8cpp0PwtNQDeFbixWP1oXJuRBGDu0ANHuyUFaav56Tgf4MFqIy9x9tbwE0aNMwftLN86iX7S/7a2roPmiNWx4bqY6Xf/OANM/l7QQee2aBnmEtfhCcP57Dmwz/LTmTMnXjt+WuQ4ejkzyGbmGAkmWM/PvQ4d33h+wiyYgdaxLrzxex3S66Ls65SE8ao38lEFd5qfZbl4gjrNz4N13O278Kz4xcgadNCjy6BFl2gXDX4QRofgBSbfBI5fgISG+Tz7iMd7ylnPijj2vM3SERcoQ2D2xqXqDT877v3k4TBMs99WoUmBic8wRkG9BvZmAMZhlJUXLxZP/kJfABSBRKnNeqo3TntQe5yPs89yhXNekYm4QoaJn5ZlgK35KXqN7QocTDXe1LrQgnT8xGsQOHLLcxjFzyo0Evim4Onm3rbHTUPsOTD37Kll9hU9ef6j3RS6117DDMduJleEIZKBhC5DufY8XTL8BL4JtbQ3fnZHG6LlCRbHYGE6Nfe9vR2Xzxw/FfesPReR6m5PI/eehBCn7VQ9+eA7zOLGUcmc5idIDGS6OEsPEM/DI3AZRISgIvJg42ZB6wxubCUfdWpHhePGxel9cDkboJyxGPFh/ggtMwQAehBH7dUhCGIeQL3RM+lUGQrKw5WnY//sJSXJ8xyVeDAZPpPx1R94VspPvAKHp0A8cSFO2xDXRVqQLmgiaLKjDI4DT2v56eYJ1Z61f35G9SdREFOVUShIQjG6CD8DSeh90C1pujHP6A7GB6/RQZkceQ6OF7WQh6piqvhpmA+ZwTfM7RGI9CFMCvDwA/lIyh1K1sdnrTwI6AMenwigK2ZNAPwl6p7BH1r8wLQpLNqhcOKkZiCll/51wIsYWOU7UF0fqIAH6jAHKh1ODHb6H3e72o4uD9/xPrsdb77b8WLfLtv1hJbHXZ/tKfkhgDi0vVxz39n7t69seYFB8dlqxxbyHZ+Z2PEJih0fsthZMuru+JDFjg9ZYBBci9/ZkF5xw64cDLWiB31zw4G63V5sENntHR4LBBCXa3f7een8+buNaH2MPt3tX11xhJA0pAFMdQ7W8/V42uv5qGaJyaoKn5QaHruDZ01+0F/g2dX4BL7f4RaruyXWvdO46XNyOonRgIpJkbYGeiR6QKF0BMGbgiDd4H0N4NlO7WRmj23V7PUQgIjabCdyp4GBBkTU8raxbatMRZltTpZzjb0fEicvhGzVkcKaI1q3wdOqOkDgi3G1aogvbChEO/Ugy0aKtz0FT280OydVm9l3DkliJIOdfX5BHUU8GLwwm7w2VA+U+qT64AWNhnNg4UvyeIiFXTOGYJDlevaAzqWD9zxX4Ii555yNVtQlECfLnnEMTTLaOfGjihlBd84n+adQH0gMyjPxwX/46Q++8ofk+5l//+HvfS343v+jf4++93/22+9/9z+j78Of+9cffOWP0fcPv/SH7//eL9PbX/rfP/j2n3/vf/13RPybn/6HX/sF8v3bX/rg//il9//0T5H43m/8+fv/5y8H3/d+nnwf/OZ//eCvf4+w3/m3H/ynP2Hfb7//za/S29/507//LqX04e996/0//x2K8vf+6sNfpsj//ju/8L2f/zfk++4ff++n/5p8f/2r//Ctf0nhfv/r7/+rnwu+7/3WH5Hva//qH37hv5DvT/5kzjn4OFfv/5c5p+C8/yv/afb99X8Mvg/+I9XO+9/8xge/8Ivs+9Xv/dZX2PfV+dtv/dkH/+EvyPfdnwaCfH/zreD74Ju/+eF3foZ8f/mLIacffu13IWOheB/+wa/8/Xd/I/hCNaCPP//w67/44a//V/L9ze9/7w+oar73B78dvv3ef/6t0Gzf+9bX3v+V3yTfX/y393/2V8Pn3NHFAW/R0TNZBYKqsnyf4z3wqgfG2zycJTX2wbkrAAWc3J6AL6dA20KzD7oM8CP7vcJf5rCB0EOlwucn29omMOo/oY06MvqAJEkELCTDVEyKaZFcAJYkmZbJ4p2wYxMWXuXuERB/UPi7KAQhfCSGnRWUn1odSTwkcJoSehTfbicvvq2xT2IPXhD8UQElybOIq9EoWAYBgACBTjw58Qm6eHveSWIDyLVjAkynpEzGo4gVGQEx2ybxglw1WpIuSaKFeqhllbUm+dqcrBdFaWEclMm1NilYa2Wl9aZJMttjZI34esABwiY0ACI+W4i0LYvBSDfKydctDwIROOL9UF7Jb8gQECkcnkZZPkze4xiXQKiCR2BUTUa3Ki3oaAvDYl9A2kiqtpJqVfISa7XXEjlZ1WZAo5K6RKhNmAWRIftINg8UPMvkCSZzvew+pEMsmgT0R+iVkd5OO+huWKEzUtsd/ZLLTDcK+5WlsUyA0NUnQXNvgDF68vJbS/1VkidBs2KxkK3qTWtxipFAOCQLYIRhtU+CnNGaG2lws0K3rApE+mRlun1WA6AWokoQaRATgetnaICsN+KTwQaOmwGLZ/MEWauEkLnzui9A1EcAxaT8+ATzqKwmg0IY9cLaDrbRAqBmu8Cot/Tywwa3SagMsTQURAhSPaFuJzEQUdZQpSwQViRWbAQm6pkLPUAzoBqeZB0zuZOAhSx5mW9orUEGcFBdWdE8TJMypDGXNYA8it12AaDjtwkNaVWyCCPKVjeliJtGGUYVwLd3i2rO8mohy4YErKS9Tt/7NPwkIttOA0zOkwDbacRqXWgYuKBNSfFeMCw7ibgEaS/ChOEqYjDjFUkZP/WCbEmpleEBsWnKLWY2BUhTlrSfJpm11kCXkyVqgZEcjr8RsTQwRHrawRwnifaUVnOPyVSiJnuY5cgPwtAmaKzGwnr5jS2SSiSGPiflxTmKy2l9SqLGuQuynk0g7MuRBokRKQs5QYmiSDJFOG9mkApQDYIek1pCsacTMql3HDrsFo1dEoKZn00BaxLaT5K2la5VGq2VMY5JdHiJaVo3MI6itBV0g9JUfmODhF4QSO6kJMOcUNr1SlYoQSfxEY6thimJYecrFPYNyRwwzIJySRPj2K9JA1wm3titTSRRDVGC7BUtUEYA8rIQKIR3OG5HBEQ8tGSkYdrcWtwdukA9a+iChjwONgOodhcIWTrNGMyfmySIPYtkQfXeiRhBUMls4/ABuSQJtYAjTgoEZUWWUdtrRdjpqNJin4CdR5VmSMNHuOi5WDa0aTT+WEFi6hCkaTV0noVsl2pYoNDXBY0Vc5EQ9HYZyjYiEbwSfSE8NK6WJGp/C7lokxHBMaMWAJoKvKBRPJyYDiaIYpqzFgCsyqm2F1BzCe0uIRY/OXqRxJSEqUAdR+tRZloqQ70EqFHEYRJhdTCFHOu2CebV0k4BM+YM/S5BBmzPNomNtK+E9nP1CuSUIUcU1BLRpAxLxGaxTHhiIUNsbTJk6pMwzF0pckIxmCFpyRFBtkix80SzNYlNw0WFI1JntYtYmgLN1RKAVVGBWJoTSwAjbnMoTclm7/spi3SoM9qmHIpWQUGCEMoL46G34BbFBENlbsoR0tRpDpS8IGEtETvLjBmYkjYccfgAfjEpmOdrvGgKGL9sStNYkkAwgqZVBuzdJLFMaf4nPyU08k6a/ZNKefA022IlBt4m75gKWKygH2xMrMYIh2H3Aj8SZ82Fiq9QDIYUF7BQZANKsGruUwsSiQx2oMINNkdHvOM5wVAHzpOWUkiAbm4wiV0kYsx4kUsyLfNEL8VnG3mKHud+EyGs0/MF3NKU1lzktQXFaJESAj2q/qIMZK67iqJ9ppglX3xj544uIGeuJDcNps9C4nDqphy70oA0yubQUGf1R3IJGesKnIe1qBhlUG/xJxkyMG9QO14UzUOfqS/ajbTiPDrWi6+EtReQvUhmEsI5LiUoQ4ayDOyvpDJiX57yFhz1oLN2Oaliai+w/qITn7j7uougw2UrnKbLvKthj+Lxbl5awrXzZZkJ3UigyrxQeF19XI7aRYKkUh8jobm7F+/xNy8iQf06Nsn8whQ8biw06YUz1aLUggA0LY7gYATh87c+CLqIHBUMFAtpRl7lmYEetSxJ4ARZACPaACN5nkhTWAAYCEViNM77KSmTpdF4plDiNqiPLIBuUyIr72WljaiRG0GGNawIuEm8tq0kcHKxkEc12EmyxbIGFgErK/OEqwUzoQYs7t2ySkmzt0oAaKq0MkTgA0zCo1BYXoBWRzOlCIw8812AFlNLyPTtyNZMCR1xzIk0LTVEEibRVLIZ6JVmq01ENFpFIsnWCEGTviYQXgbl1osYZBWVJ4EMPU4cIzDR4LDQlgYdQcv4rAyJE+GJeUNAvR1MFgr3fgjayi9GmtjIyo8cFZFe9Ul74Fhb4Jxe1hiyV9LgNA04oWSXEK7jiK8mLd+TNphEgl2a9fNkHRyUFChGAumRJ6sJuMXZbw61PN1MF9YVWxCwQ6QvmG0jlq3H4/CYQDu8PL01bA1I3rS4op1Bk8mBHlfTM8zpFOnZWphhbPVOQOqkCUI/GHVRSjIhL2aO9BUJwQTyF7F67a4gPINJYeDHy+SBK4uLAmG6l8Vk8yr0RbkVQhW4hpYgptDYxROsvRKubTVlUmJeBQ0xRZtrcULW8SqJBPIwsKcQizwJFaZQJkN0Dmxxcp1CF8lhkchkK0Go4f1+uswuLrJarxOM9HsJ6MvvdH8FG0xWRdqB/EkR3KCeAXl1b2eFKwODApOijnpHCk0XrdqoIq+mBmb0FyVtSNsaLsuGGkxW9UEQtKzbXX1zzHFns0yA7MeRKMfGPNi1LFk0yQwZ0yA6oElPgqxJJQgqqkMWygczagLCbMWc85IAmieMwuJKc9CqYP55y/bxC9YlfBouWx/VMH2ZIijMaRFa4EvSYRLQtPbiW2DMyypFfVAI9uxNn0cB8lVlLUX2aFQMU3QaBg39LwPDfC0DLRuG8wxPPHNNsRE3GKYYLY5KpCde1BlGAigDDRk1U2i43qy9vSIAepAkU96b++mi+UF5ADUmzTeUV2fAFSkwkHpypXpI176WJ1CwVB7xsM8Zb1CnC0hjNlPIQA3lzTigLSgFSGex+32WrgUZdGXECBqfhGxhLhrbXgg12+qM+66kanECkCG5eABkO7UXmLnkWTv1GXC2Wa72Jv8Imrq1OVs4XUwpBzjTXhmgnLkc3QOGM4oE93RYPIem7ZRH6lH4jxkHApilQwM89LBL8DJC4IysVgmzFzH2iizCOZqLDFLdL3pO2Bwz2CsZGHCrYw5C3ecqA6GX+XLmQnD6KyLyqngeVd4JaB4x5dDlSIAgTDvTmhs1j6tZ0Cu5GW3T56Fw4a3PxMPIuxZS6KQugNbmkCVbdA7mIz7k60rmcL5T5317ChP4DJuyjy/UgilMatPMHEFfVZcgaJxZR4WyTZkudrImb3FlkDHmfcSqZd0s0lYQ/by7N0Js3Yi0V7X8ghfJIo2riZEkDS/5nvYmSwB7aC9iwCVHGcWySSIiM7cuAI/jM9niKm0SgKxMpD5LpM3C4B5HSeLylaCP81RnhvqkHDysZuWnL8KGjhQ8JrWCE+iF8LTrUZJosRN0WKITyEl+zmswCW0HXFWTkJdfTLQbfKHZDirpVqUU7gTJsZQxWAhIui+SD3ihPALQA62M8kTzq0gFVWkB0sA2bUzkB7Fjnrf/CACXQKwEeEFWJ4EqRaeWIkA78ygbAsR9jGUae4BoXn4JMzdcwccEnb0SGlRGcl+SGO3tkACuTEhgh7tpBM36eYr47Js22IJTaELTLUpNCeNqraB7mBCk9JjStMAhkbzWBloRSOvG0iqgBKi/S6DngwUCcmg291mV8jaBFIAi5dHRyE0zyxzkSX+KOp5DSNBm8U1oYhUA70+oTYqRSk97qiRssyzDaIbaX4IMGe1MlSIeNYOs8SixIoGmedOaxJDrktzPG3sSKBiaE+yYRXWa0uagbXNnvIopHnEBvW4yFwgdihcYqRYpQLt3Uijs/E7BI+/hTECesCUYG3qxXRNwUBmCukUKDDwop2AWEXlTZMoqAhUSlWWUd7GkcU8ke6o0YNjlI6HthMvAywki3neMtTMjtIuROsyCgJrJxmsJ4ei10MsWzwyhZZCIHfN42LOQO5hZ7cRrXN6mUUkgg547dMTc1KsEOCYkLcnKZNspSWVKs95OYbPHgoRtI4I+T2mtoUjDwWkBhqw+aF/hQlncTjqKGC2e74gU99QION4jFJaWF5h3eEryjBuZkhpDiS2/GWkxI0GSpjyBSCqUpGmLw0KDGkKCMp49i/azFMeeM0BFCwQ6AHKTgHrcMJzQuEGr1xIyqCcIAHofD00R8jg9kkmNqqK5lUTm3RICs7zHMSJT2IwroRG3wEYaG8JPScFANg2UZWHO7hXHIlccbJtBeGA8A0BnSxHqMtkBvzrWQHLuD+dCGYYWviLLHKhprc0gy4snCdjTwEg7JTJ8DPpjCpM6TLv2E5w3Sl2grCfTtoH0jWerVji3lL6yWZpoFqHhI0FPtFMnbRCWgfJwJK3qmhQ64Z53iZTE31wFEdUFKmYSWUSpBBtFtzykUGuHNAnaDIOrVAkKrECjcHKcE8+NmAziVkpBE7RjCfJW7AzjGQwdxHT5qzzWgRSnBJknOhIEUXfMI7MmI31aK5723lyUF6e/F+UglSWN70Rj1qguwDFNhjYa5xXNWyuylM/cGQRAFtGEV5An5vO1teaWscvBNFpoEDRJSZ4BLaiRBO8/knRCmbDtLSLkWci5+hbahiOxEZkc9dmZHhSZygTd8oKwQAbcaSwAQ9v6vAyDGwRsmrU0Z4EVF5LleSRpW+lCh+1rkZ7I4iFon9R8mhukZjucAGWCWRuApNmJ6HBHeyUiRFGSVix1OExTHKmGADYFfEqXDeVBIPWJF4iSk9nYGa9E3xre7ptgs1+CfZgeJhjMO1DiJNDAm9cuQGNrvOFb4nT8e0ohWvahWhHo5ae4qV3S86ghIBqX0U4jwSkrQl5PPNO4gC5C8cE8lzUNLTcth+DDVmruKjMYumsK4nwqEoMgdtS4p9iKGrVBCcjXJKgihXV0io2aJYoesqOkyJCUgaFaJNKjru1EzGG3RUZjWqGG4ouTklkCCSzeIjNA2dpTgoHoSoEBrfILTXKI1YgZ8mkUIDtlheXlI3VLEGMQ2DN0ZNvUQodiDQl0VLLe2T4UbkAwlNxM+MWLLUnNsdBH0Cnia7R0LH7P5/UW+hg/AyVniCF5K8hCoYY2UzRG0zaMGdAinnB+YqEG2q+qI0D6jLjVIQYe+BxMoFgrmomJKi9QqDlHv4kFGpX095KyRfwcJEXw8p7hmXCazz4EGhsDDTqBPJHxuowtgsv/NA7EyylCn+zVctw2vgs2V87UAkLNTkkwYFwU7hLoTUKG8/fiSgxUMK1LoSs54NaAiQhO1hKcDEMCmGjrcwQG3uYRARv0EYHQtEnQQQsRSJZtXFYTpNc9CiwB4HGqJBt8UhPNflNSAD6smoW09QUUzqFGDPWVnU0Ak+R5xBPOCYmqZATwAH4GjCqpKeRwEusROitJqfD9fKmJLpgHI1KDNPM6gRrUCBMEz8suFR7B47yHLYHNzmbAqC4/njeK5+DkMszOO/ISFEZaWtFKwciU58X42uepsDmD9i/m8HlmonO01fIRvASlnV64QJGCmLrOso97WGz2Od4Ak6YNX5pC5dBwUUI2mbrpAgzyOwUvSsOan0TIjB9vulG1ZhuBgLCcgsQ5vCBpFBE0ztbBG5FwOKydcgwaKkGSr3A22cuI0f5ZqARwCWnwMKYADO7VliTZAiVAw4MEcBu0BGi3kaR5ZBJIEh4NcbzFSGJHNr4JCOd+KRk3hUl8VDT8CAivkEc7QgLhho8IsPk8zUQ0VwgM77NIaJoIpwkySKPKBQjtdcwiyIGTqjIA13sEgDOK85TEHnwpkMSLFwD4NER7GabNYrHYMLwkGFFb4D0Rgs6ioRudJN1n/cUmLWstKjMJwCZugYxpZVoS6JHmo9Ip3WYBpoxsZEFpgcan2SBNcLjIPA5jO5Mmn304nVOGR6lSmwwg7SCFsq6F+6pZHU0wkkYCwWV3sjIk2JT08zGt8intoseU45YFmRQK5wMkmHVsnATbNhNQOxMqxy9ro7Oo9MtgGjrZDDS0zzuFQFJVaai51y00HgA5pYm1pL8PGcj22jwb/Xy3QXpzWfBLkI8aJ0iff2jpqhuJeDQmmgzyushCadNkgRo+j65ydODdClky0PgmL9qieUpwGs6hX/tlUY2q2IW7ZKiXM9FoOsC5kGGia30Ygdx8JwydlI607SNFOynoNpIEHIKkdPEGikKldBi1XLyCwnGHciou3UgqDB4R4A0ZC2LdKeYad12OMg90oYUW5An3vC932MnM4GpVEH8M4FLU4p8q8QLtXQvFi8dG0DgPXygUR6YUOeYNYXnlYe3vxGd2iF+QomidBHB6EAY0N59Ca2XmgRYfyNyznWQ5ZJjCmFQW9mxjheVcgjYYcRFgGLoigIw3SAD0Pj4bJjFQmfhaEQHuLG3DEgjZUBLamTSicOVJHhWP15OlqWCCj3RxmYQmGq0k4AIHCgyNyBIIJpEECl55S+IOJzcSSKP1JBQlTQwhAI1mmhyYUiRszZNYmOgJKNzdlCC0I00iqJvYFMFpILGyAHF9utcZRCpzRC7r/qToQgqJ0C2SEuALXgRS0DbW+bbJYoJ+hgaGBAgMbJcLsmhby0Li9RWR6DWL0oiMkcBmJ+GxAHiZmBX0tJsEAXIJGS8CPr61MmJPSuZM4WEY8ZLWNvr4Gg3B0T+JD0/TNiaACzNQWeIGThwJaJQXWEHXbAig4Zlln3xJWzMkwOsSWNMS88TzEjKFoZsrJIY/FquGFHLBRi1A0Emy/PehOiNCF19J2uAMKQHwGGK4tS+5jJS9AkKTDC78SggG+bzCglROEJvSdpdEzBNUn0Yzks6VIOFmFAEdcWSy8uZSqPcEUWReF1er1jTlGHLorBJkmm8bE2CD5yJhGi2hcEw/gRyzwoKYRp9SgFYOW5NjJIUkhjt3k5wiLyZBoOPmiBlScoS+jaaDDKQJEPaOpEDIezvmbYENps7qgseHi4hxeC2SCPGizZRuVEpfywagIHWGFGKuisCynCIvzq1NmtHZK6EhrTFSLnbJVyRgbJ9hTqUVg8J97pUCBKX0okCjHbIGxx6NglEiUxr/SeH14RKg3XpDCpGResqxnO3PvLdCIKrA06bLTcO0f+2k+AiskvgkQoUWn0m0uJgKzVcL1OCNckbG3PC+3Ehzp7FpOvPJ+Gk+upm/WRZMxQvH5sAFmXfIRYDMMZGkfSEpSROhFPJK5p/v/ZM03/MjgKR8QaVySYgxi/LIG1/rFOYNzzLHtO4VKTwug1rtgtCsrUgSw74pGwmHoUaSPqkinInz1aYLhFd3pFXNE4hI4uRoocgT9lqLm6txtifI7LVOmQJ/lEe2AyS3s8iqo07AYLqO0JRUEAo72yZAmnM8F+0Sur0I0YZLmyJkh0rNFmWBYkdLErN0wnuhT0oQeBssjFbzjd58h95MoTGvQoEzAzXOTSJJZzOxAheA1JKZMnTCY6ZaNrVpAQyk4Eea9s4ImhWahZ6vhpBAkwInmZvWsrV2oU88RZ1p1jKSElvxOliZFnKSFLkLsSyZzAhejyAIF8PSfRQzEWZhtawnFU6jiMvVWfgJoKQFnmKSWLAzXyDQ9AlGhkCBNLRvLwVOUwrQPooEQQvX6QIL1R8x3jqTICQ2rxVALgpeRES3tQoALXCSpFFKAsfQXQSGHMiyNgPz+qZN9SitEgyN0YVOMV5DzqHAKQm4M32WxjmNH302S9OSTpbWM3D1wDwUobygU7hVVGAskief5qwnc2qK5CVHUwtvLEhBm8dl3QUD4Fgy2vTTYb4kSGLQMAlg6yxfNv/Eki1MAu1FtLwMKYFRpVXlUAgNCYL77VIemb0JhANwioyjToG8NlgtSwFHI4PEcnqqTdogo017I14hk5ec7EK0UpCgfGviLFcXHORiGhAVhSLJKvFAkg00npx1igxZCGfSSJBn0syf7DzHyMG0DXA8hMjnn5mgHxZcqFl5w08iRrszF7qleo0UDvSCxC1/ghxF7PNdNAvQK55ZR2C2wS0IMn6TIjTa4GXAEbJjYOsZsbgvhG5eFZmzSeIwXsnMudMEetRCjmoZNiUUZOECWafoclCBhKPzC0L3wS4Ur5aIH/cIZZvJes7kDDS821wi3EhkJEzxNtSBQEyfpHbxUUsH1NokUB/GKYmQ3UciZi7ljITNy0ulzbht0rhwknIlVDtd5G32Cgi5+JTUz4gLVEPy3TQEnpyBcEI0QWhfUILwor5sGsdMtvz4iqITlhKhg9o5oLnACTY3TQKGncIpTNeXJxjwhMbtbhJrYtcRWB/28yfowi4JbLj1Ik17wRIk/wKvs0dzQYICA+k6LxzdYtXnAaGdNCggF2iWDu7svIzR+qwxeuLcJq8EqgDWhyXKG8OylOabARPM4kFcUjwESsydf44m3AwCXVdfJE6JZDwxb8jNMXsZjv0JOHk7pM13UnlsyxghGXg+fS5+RqjAfizoJnvPW6BMAh0V7Z8VUKv4TrmjSlEycyeIU0EtlShd2Z9kg7ftphAqupLGUiX0qOskowPK3oxebp2UuGnTpAa22I1pKJvGzlvQEiDswpRYXtZw13mCDEni84nKVmconmWRPwBFF92cszqfvRKiQ9wCQMNOEvlEK+8kjQR6olu8BBBOqyW/PEXmkQQJd5mn2MiFTK4LNRefhhOHTCdvZrzWGX4ZkjegZRir2Sk41Rmy7BvJUDeNWeY9nnqg7e8pTLc/4lmM/Ne5rpUJZzNtXp2VCrGews0EMCfjqluAJarTcjlBDoXJ7wIU04C+hZ4vMRSA3U6SnERoHYxzC8CXzQkSf/d4IUn12tkMQCusQHruBTMdjszxldU53NO9+v1l+LDFXcBDRlU6pRslkzW0hBLJMfykxYK0ge1a8VHLvWKhTjiBijRUq5FptPQ7AjJOaG9oj10C4ZVFgpSFgpnqecoamHY7ppxCo5Zs0h73NYh4gulQ0HSlgaDPuHMo0pO/XvnhhazqsL0q0tol+UUWnWXjDLXTmERrZcvbrMBW1rnXI0/MI4JWkAwYVMq0tHaFpvuITPUiLGakT+qQ6jjtSiNZyQUpsznm+ab9bHfiV/lCvS00Rh6YMmKWxJygRzulQVAB9wkCwrRIUlr2pAqMDLcLI4ifCmSvgMIPAQiEDkFI2tL6j0B472f83cFZTEQal3JHn2JDWB+MGE1aI8mqkqBDm830zuIZbQGQhapMokQVo9ACII41c9uehFHFXYIDVddCn+j3CSJg6fxMpMcgAeQaKCsrEQk/NyIgW6dkVsrRZh9AxdFWSYHYpJ7CSeUIKIfMLX8NkhDqJhKtbWETgK9BRqmUoGyWTCFnL6Lj9kqQowLBLJGgDaQQHnQeshzHLVIJyhbaFAoKaQqGbUYJiIpVCpwvgYtioVH/Mq7pWk2FYyopRDNoCQ0sbMJOPfkm3GaTQU5dxAqctzMZQjvBJbT4k98FnbC7p0iWC+7e8gdEt3RnFvglOP/mmk5QXEpMgHA1RQphK1JyKRyum0/BeeebROn+XubABOYunUDzsCZBLI3JkPZasAGke4IsS/wShYlGuGsjQdN64DOCCdLQFCGBDM/mJIYrHTpD2rwx/Dw/T0DexZFBeAinzQPS/ZIS4v0cWSqjxt2VCWIvyk1WU5ulsPhzkK76TEAbJjsJCHP9BMD7kpKftaX7ZFKE7wJNMdR+E4R+7HLIguFZKTaxJDDKqQxii32KBd0gwfgia74XJHtBm8YSDNRT3J6eYjZc8pyhtLM+waYi+/Q0ZZkmrT/5HeDKzj1sgbaT3k7tBbiL7H+B1uHnli5ezkaK5AXuVLiMK1wem2DzrWhBlibvWpVlsZ2bWCB4yUgOTbVOMZ5QblS39xcvbJFFwJell3mlsXKWQY7vTElR+m21vHpne4Sf5cLyJuw/TTE2UycY34aeQCOtevc5ir00hfDmclJRE5QJvGbuGn7BNvjDum32O/StBh31zjrw3Juh0s/u9g39KHqrl1+ij95Vq/FHzVv6cXl41vTE30VHF29I0fi7eAbdgSiKeeXpG0q/zn5Onn95va3vq354En6Cva3px+PBwR+PBwd/UR4c/A15cPDH48HBH48Hh2Jt0lj7/VjeUbDmvhr8E/kjwpgE/ixyw1+arD4MfhCqgjNjOF2DWxXRoTwZzqHhzJhQB4Zzajjq/vv/Kn3bi196jvUBVdfjDz7jTsXR35fQ9KOGd+UKPqCEe7zfCR1Kv1/tXYXOSBjlt+cK6vGKcHRKDg/JfbnRXq+V02s7dKc1pgH1oqu18uu9s3sQhdUaOmGtPb7wazWuY75eWBfTOOf6uUVbe3Xy67HRpx+EtNRBmU4VnV5vrUt+1vlHIG92H7JquTTa45UnUAZd6RIZBAuMSr2mwuyhRmC2bfRA5EGPMGcY8DXXyUSVQq0w3NAKw0UrQBMP91zot6cOEmVeGLj1B26EgWt64EYYOL19xlBlCXnCLvVk6V+rds/NsecI9hzBnmPF+2v36Ix4mjx0W3dDKRyG9BqquvN2yUToYQMKeeyNDr6v75MesfOQJUc921HPdiusZkf9260a8rc1PomnwCEI+cFRF3erPfkdPX0ZMgPZjj9vrrrw2+YFXZqGScEAWxdTu/jhPforpUHVZs9OWwpbq6E+Bi+onfWOAtQneD1HwQRenjP7Q3xmKJsQzU7zhxht+Ay9IaDXdqLyTV3IIpXiKCof2xRFZnu839vOAAv6ewz0uh22xgG7rovTzN2n9acx6KjL5nFpsDmpnY+vrtojM8CRGeCId96jw+8hwxU6xGtH5oojZ+WUcpcp6fqA+0o9aU8o5p5AcxOLfXSW2pOf8NfYx8d7NBFh16Hf+bT9q+tHDx68snnw0ubhy6v2xFk8cRZPnJsT5+bEuTnfwJXnq1y5VOOZftR+lrjEiWdO48wJnznhM9fNmTnwzHk7UzY69f2z0SkM+c5UgKI45jVSqwP0kMLZ4/AYM9WpV1fwgRlG/A6TJwokPLiYKXAwN+BAFaKDuQEH8w0OZaq4IVPFczLl1FCdHu99TS2zgrCUSMEZKDgDBeeu4JQLTrm8IeXyua3SldwqNFZCWEq55LSQwielWHJuSs5NyembG9I3q45Hya690rm6VigjLMA2UmLdd21QSTaoGA71Bt5M5Tg5vepazkwLYqhBh3Ldcmu2XJSWc99y7iEICDr8wGLSq6Mu0OnGCh0YkwZ0a7dHp7Gj7ginsFQEFztlaC9SIjoHf3fjs/H/QSs7zqDjnDkuiuPsOi4KC6XOry+b7bmiCERaBXXFHO4hLU9l8KTXgIPN6jldz+n6wPSeE/acDY/yHZ6Ui/GGth6hKjEwxTmm2WaMW/sJRICiizGOf8rURRjvuwn101U3cYjDlXo43L/x1jv3nxvua41m8JKi7Q5ctwcu44HTOXChDiRXwDEVPqm8B2j74Y4+G7boUnqnK+mdcDydOuJNz4mx9OxYbIJDiZ1W+w6Dcw5OzKEnVHLQoYycKAcsX/sbBFuvMt1FDW4E9H7r7qm9of8ch86q6n7ak7M1nX6ydS+/8uhuX21XPUovjAYz2rNg61mUgQMcAk/Sx9BDuSova6Av71d9ieonPEmL61kY9NUNRahW8Eeh9Q2hcaJBWdacZc151VSpPU1Qes3FgLnJ6NHZO0MkJSKmIJT3Gh14fnavav3EVJ/5xKdWPU9BwEE9u+dJBzioZ4MzEkYJ8kSk50kKOfikhJobStOsep6JQCw3BDcdJscftDeEb6/rhAO2psPqoypsQZfxo9q3ipi3ZzHZs5jsWYT2LC17Fq89CtQtvcOjdj1Lwr7Le3cBAysKkSDFMfB9321G3e87FeY0Fzl8XoGg/B3nruP8dKzuk0sOZbbjrHfzVKIPdXbDnKzH6WHPs4f+htlDP6x6ngL0PCHoeXrQ84Sgt9nc1CLTUSYtdBrLhbGcbctfWo7Hcqw2xFPcMLBiKJqC8zf7fLYNaeyfN0hUJZ2ntI+9MntQgXOtsOf5SP/0hqw8XcEfhb5h9tLDpIKHu54HuP7KANf7exBZ9z/5zpM3iJ2evAX89IQnaCTLPNcmD2M9D2M9D189D189D1z9eEXtoPfjfW1ZtT89Z9xudLd/HL95ddWPnPTISY+c5shp8mDWTzdUw7SCP4pp4igmjmLiskwk3iaSMvgGnxy311Mfa0wVdsKPEb1nHeleDBSsXYXXK3YhZlXp/nQ3kzWMnFOHStUCGSe89aRQGgYa5vZ0/dJC+jZSfaGdIGCoHMoYKy74lXhzT0qrC2AYzdMJ2jrH3RghD/OZSvULMMQsDoPe4g/GRGBsnE0paHlbnwSWpvgUymymGLsrrNOjBMpGaYwDJ7yX6ElCBmaGSfWKcrgRcl5pb+pBYKUeRlGTbqx0aQUJvFfynD5iteo67UTCo5d+SKZ0aitAj2dhRFa8hz9csJbxAuKNoGxpsgAj3sct2Gns8Aa+hZz8aAZJOdUZ8Rpvkx/NIVbOIUvgmVmyXKhK7ew5knVTqSqSzRD9nRb+0fTWCcCVurOR8Qq8QAOqT9KCGBuRg0m7mIpW0zNZ0YXWXaWHWneTifnS0DCHKSavXQ3xCLLVnT4JujMiCZGcKUSpTFcoK6iYYQMdI7YrkGMDo3DsiIUdYpygEFhBATtKjl6AGmSAzsNdAWMxaMpzNIM+IGNK3JsYvwNBayU5Gt9kZGm7qS8i08AMXsEA5mMR3TSIBnOT9xekF/QzbJEITKarQHBG2tWN3UZShPRNLAmIUSWFBtSS2Vo3xIzCIAtFqGodgUF4ccvAQu11ARLGivfOmSgDgBytlSIQKgDkeKd6tOmKiPzYaUk5kUMNPaGMlBQyILlG3DqIeyMWCMLroYlSFhAPOVUt/lRrBIFBaivIrnKRLWbaXwAp+5S4o2HvVCmz2FhofFQgFwAZxqjBYystqBlKVDpHIxAdR6nSuDK2YmkOpsN1LCFlGDtfYkcVa6jDW+Hi686WyxBT2thnwA9KNsiBrRajX2mrWGO2q2TEFtixTJKGMQT4qDtCtzYw1iWFhXd4ixEp1lGM5J2EaVFq2/cTduU0HQHmQUeR334/SV4JdIOd18lgFNm7X3/324Lp7IDTAMizDBkxydJ2OABTRnKP3FcLHrEOP0qL4Jw21m80zDagGj8iD+6gR8FGIPVw++NCTgOoe9qPktUQjDlOxk6gBpBCNra/02muSKESPBHorM+lald5EupTeerd0rgViBbhB6UrUrSTcomjqpYYUGfAujpoOUShyADe04LuoZati+IUOiXMGttI7q14J1WVSsPob+JLPHCippgD40t7EEpJBZwec4Kr8WPk2GpyQtRo7ERb1PskQn1rAXItaAFixev6tEd5sdD4KwdSU9Cd7Fy6AwYE4RRHUw0i3OLh/KUQ0KtP0PlRpS8jVndCBdV4fWSiB+rhgD9c0gv2FhAwBt6P5w5pcfam03sf44Bx7FkkJLPqsRnS9J5pwWj6WWMKI+PewlyhO0XK9dKvn05mL/NKoPOSBAU86l5bA4pPJGrQkmLv2xoUVULrAyAmB5JaiIBAim8HWbFbJGRGOks77WbSuihxgRh7AyorTEMkdoR/TQSmoUpqHacOpTKxS9FcYjuJPOIZTONxs5SLmIYBx9sh8glARvIWMDPM1iRtox9zURobadAjpGzItPt06Kyheo9xQK5BCz3AkNhGoM+nKnU6TQHS1bGBaoO8FuMXAgf9kYCvYhZR43NqbyovoEVbR54AHl+SbKBEdrtVUQw3OGWM9dNAdD62S6NAhoMEACFfpENAgze3LfKx0aDvN5HC89lQu1MjdBJQbESRgIJ5Tyti6ItTXXlglmKKvNdoPHkfy0ujn3Ep7WQlRwTnTTqOJvOLxgLtMxStxRdIHiqL6mS3HpfJI/fjDyPLFqakFgKvAQd5EGMFRWNaFopnrIfSUiOIWjBDJcQpUyoj0aziUwwHFD1GDORBZ2qZYcRI+4tzQePU0+gXGfOdtts+ZmOHG5Pi+53ebkFISADXPjvdR+Cou1iJQIppB1NCKO22S1faQQ+G4cX4GBX+rFUcpYA8Qe+UQms36Sptw91klvkF3SKhBYXbj+IY2oru1FpdoEZ0GATSRWIa4uBIhFd9301xakPglBscOvxlc0lMgtrBkK0iCaIRNzBqgVSxXEBF/7u/LwjlvGiPDmsYBqAuClBQrkFlHQR5jv6DmJ4xlfUC0MVVd4ENlUjS4u2IWsz7OjsdREHsCZt1qb9uKkFexdcwaewT8d1NZzE37+NYCF5nBAVizkX+AK5FThCDMyBe9LpAekE/i34nJSReVzKaKEADbQV9koxIdDMJVa8nu6QghPRC8myGazKo13J87zWe5ZQfegVaXxXzZeiXjGIRYcgmM1FUoIAZQLjGxuuFQQMvYYlDPlCCG4AatLfR4IQjvum0JKUC0NtKtprtxZshDk5A9HaKwwjQkFmQWVYioPTHTFrQdY+RgjpDNTFmZNLY8IOkEy6YukREwzQGBHqkvI7ik510LsEOVLseTC0raPKxlodMO57pLfRoVOlyPB/yBp5OZIbcQdHcJut+Kcpg9i4MWT6BdUJd4z58MbkjKLgD4Gq/1yIrU6JDDOogSouKgzBXD1pYbwZ9BP1jnwg3wGDkEDoLAL1+ZqItFgDcYiNIv1f72CoAnGycSg1G7+OIN1i3tV0ryMhwg1t4aTjF9jtFA5stYWyeN11dA59GkyOwebMU2w6diYIN2BikxlIgnHIL8791uo6iiSnQEqIBD3peNP3YZ6AyLdJmDwrtNmZtr6DnwUAzREAaJYHSVhC93ztgYx+hQaw77CHP4pXn+/tmmncNniINExCY54KrrmEwG05xUE7ExxZ61DNBjly/MYTpbJyw7M04kr1maco9SDo9JusJe7KRS12LEC+oc8wS8EVkYqSkTCe6w4tKIwID/waCzAaRKyYeXC7Np5uImSqyQtgNMBN2p8uYxlR0ommnItpwge0KvbxCXkFzraTFVAWzgUskgoYOL1eNgDcrvcNznXEJx9lsCAWgFfqaE2Idrb+x2b0CNRMG/sg8iVoIxEFVwMPC3gLYWYoUXEkxOjI90DRlRFuHwNAs4+j4u8RKN50TYKsjCaJphIIdo2T1anwW/Qe87KGJ9lkQfh3Wl5SNQAC/okF8kFBrkdsTKLYYUEdtYr7SiUQgodSZgI8vQMXHyUP+Jh2gEtBn6AYEheTFAF9PMf/Y87ghbfrQFkkvAS6TchqvyYNmFwYORkS+GmEDAsKRuS9WigFF3LSRjGLcx4mkh2qPn6RTQI+zs0G8RQvAUB2M1AU96iClgpmcieKNwKOOShwMOaK59jEJXMWML0ZVjYLoCqGsA+0GXJgSwKgTwm5z06FPRRgahHpBGO2kGdGPO9uIwNBlG9uJD/ZosUpM3TBXGcdadGwPHTeqSB6CahBqjVi6A8zF8dofzPmsRR88atkLTpVQ+UaxYjyWS75wV29qWkOp2rJUbZMKwBdp+436WTQzADEKzXRstBLdQ+rx6G+13gs1bsxWrFEpGmykpihDR3uMGQDJ5CXng84ak3FWrsyNICrHhIhBE+vJFFttImtcHD6hTkAF9WLtAPccR2+2zg6IsPVN/qohHuDUTj7566btKVtRwgOiifwAYFJG5OfqXHIa1VKX0yGOcuk65gGl9xIVFloZKYEOeH1rHSmYRUc9+mAc6OgxJ0CPUxzjiYwrmIdOkdUzluwAaqMeWqz9GToqkfgRJ52DES9d9NIRVzzuW0YMxh3cICCXtAgkNSKFaeAdJLmFpCMAvVEU/Gi6qjNxlLu2onWE8UPO946gMfvG7mdaatAneid0dLwTojejoMW07WxzuycjSyu+99vv/eF7v/HeN977Cvh+Z0b/9jt/+92//Zu//auZpp03hxt29Rww5A/0hx9Ym6Hspgq3E+lSQSwvrEvSnNf/y/zdC+va2hrlwWENcwrQ2e5WEIHSqOccaLduz1tZycHq4C2tPW9pBYe27ZI7okMbFA+reap1CHv2eJtrzztgwdlzAlSoY7r5at58DdxyvF/1R9qFdOS8HGkzEh9oIAdzxAcbej7R0B85J8clC3y2oeezDT2fbeifXdnw9ez+OZuwtnjnq3/MAV9d9c84D8841md4iB4dyskzTvEZJ5Vt4u1PFMeJEnttOagEqeBMIzbvEg7UUTs50DDu50NyxXTCfegq7EuHSjpx9fBG4J73/Pa8ERgcapNTaAneJdzzFuA+bP49p3mcUBs9P6868I6EwdjHHBLq40zNBM/qgA42Ex+q6Pk0Rc+nKXo+YtHzuYueD1UMKmsNtcGyY1kGdZ/tOSZ+A3gu+xCOSwyKmAocKie4QcEccIMxPit6Th6f/TN8lvhTHys8sQT+Ep/jAZ9HDoOnCwfasjzwsYuB9yoPKmSb9xArv34EEgrGXd2tx67ydGwND6M5/YN+Pdg12vTWdotQT9nj77PNzWUJH98NJZQpbGwGF7cED7y/ebhhx/KAG5VD6PGW4LR5kD/Y3hB+u4I/qootNSQ4aGkAx8F8Cxw80AAODYng4r5PdLmJtjBVbzg4jnYaPZR0nXdHR6neDbU4nLGzZthMPpwn024DfSBwwcNXPrXBjzYeqn818AbpgXdND7yhGhyDD+RCdKhx68AsfPpz4I3Y4ODyx8A7qwdzKSoGA21kkOMHPqo5GOISPpM58CnOwZBQGAwxWDi2Se6IDuWDT3iiw99R8oYrkbfVDjfs1B46DEn/yW2mMJGGhLv75WVZfvkLb9CRSGiF9Vsa2wInKFBjvGV5sPlOVVzZhCeegrA91rYe9KAOAzYCrlO7dgPxbSAEfv8WBk+P4XnQkoZqzZ0jCLD/94kcdNXput4UIrkS1LQaVKf/j5IqPzqpTZoUHn+uWVDugf+N9mtid6htNYQY/oezU1F23n7nnfVW6ypGN+p2ML5NosQQwKj2H7Outg30e7s61ORsT6AjetwzSBRqjDMRhmfwmcrBxBd9dHTiOfn9/6nCqatammqiW+k9Ojz90uiFNFWVZPwfrQ/QbAVKvoJ5AGQAZg3wLCaqbqrdhvLekH+nhk2vTvibVNAADp8DvR6oYHZLT0Ks7yy6jou+5Qqg5wGn7Y7KO1K0I8V0oAwctqEe9nglGLScwpLbI7QKVwNiPDL7NQwdXABQVfwdlwA9TfBwOcDDRQEPlwY9M5KUCU21LniGOSgXDjxcPvTMr+ZSgtfNmJ9D+fk7WWIgxznpcU7qMOd8KX1puFFapxvVU97e/ZogeDMrw1397tfYr9xAMRVu6nGFVHVMnZjNCmeHwZ48roQT+e53huHd7yxA5WyhWva9+53gBfZxc4At/qhY8Hb0u6Hsefc75HO6cqZFGw2xjG3bOXRjB721nvp0A6kuxE57j9sbyG/dXpPBC4l3vyOo1jiYK2rP/QaaHWam5HeW0gMOp4J3uDx6UHX0v/s1JrSZv+l3yEVdYGJIR1B2YzZOcSX21u/sjHsIx35cqasq/LV4pmEuh37HTG9PXCvWQ72y16nGdsQK7t2vzV40wEJMSwoLPSfjW135Hu8T95q/6NQ4ssDz+53uWhXKg2IFDX06EAec2ZJ3R0ZgNC15PUNQrSmGNz7N+UdLS3GibgjtAxU5F5IEBW+oJLbcYtTMfYtX1dXcRMx3wJlM6JkNAFn8kAH8IYmpZmKkOn/3q9347lcZUY1W1W4KsKBUdwicrfrOcLzBg5f5B4A9aHNgKYC/6HgKHmib4MPf6eQurFw1Z3v2KafJ+MfeYXnPZfTaLcDs/b9pe9MfSZLrTvB7/BW+LYCq1nZmZWZdXVqqVZFHVWVXXszM6mZzMGhYhHtEeIZf5eYeUREYLCiKOlYarRYLaWal0QDTK7JFcthks3mo2LvT+lAzX2ebCwhoar7kCENKs8D+D/suMzfzzIzM5HBRWeHm5r9nbm7Hs2fPnj1TUG2lBLhRQ2CSJ+ah5ECPTQ60lBikYcquDtEWOuFuXo9L5suoOuKr6RMQhASHS9Ck2jEvXzRRIOnaDKCC2/Q2bCeSHQhJfnDLAc6dhUGglaZpAnDDw1cPauPle5XEaVv/PTXq99WkzithQi/fa0WotMetFJkhVUovakKxKuVFJ8C5SkmiCau4iY3Dl+9xGNW9cpV8gkxqWACHhQXgu3BpZDSOnVvIpNzHpoJ7DrPpnUAD4GKioCmnExhQSynJ3kk95FfTuZZ0ZY6Iez3C8ESK4+sh/IUnL7/OQ2wYnXB77uVjJsfmZrqi8GqPT9PNbGjqtjcb2+JkxtB7+b4J5cuoyTXNtwf58e4xn2VoU6LsOvcxlGBlQqay85R7CqBNSFVO/b/8euW3hnZjcO7DfMINOixVmnLNQRC+XiohnEnrhIB8ekindWEAy4NCEQk9eLEvhrA2rQLD0CFM0PSDKHbGNBKOuNCg0mHQ41EP60GyElU8fOLVRE1MA8EQbjAy4Zfv/Qf4J7cn0RJ10DIT9EA1Yyn0xjGlO6iB2Y5zCYIQztJXFJ5EMujSJTbtAUNSJIO4OuFOMThRSSShiD9zkCg7GEO4sm1rkETjcWRLDG5tieForoSAL9xcQfRO5NtBjNTSTmAUNx8GnYGvOfcFCJjWBEHN7H1gxtZB+fJ9E0J3ihz1QkKobifhv4x089KQSwMHMAoNVSojIPpbgdGQzh6FO6iCP3TvVZ1wGUHIpDeMkzDSY+9GxqthLPUyPIlS+TwIlqG0EgybYoWwTfEkN9mBQdYEk5wsOzAoQmOpMnONTABdenCwkSuGIP/a1wAzGLK8owAw4vyNQZ5o7oZlzddahJmRCnEHEYVwQ5eEuGzRFWRpA9yuRqqXcgMZvXzPBlWRxibSBrHPloOIJVy8U3CnpJYBmKbAn50YPC9PAtJlIWQFRaWluNQ4y5M6HxthYPwf/tC5x8091LjN8Ew7GiueQYEgYUWLEdabDWgJadN5RzTw4WY67J1lnurmAfarFJrYOY+okBoeOTKDphNFfnMwcILbHYXyBDcnWEje43YAARilOTTgi9wxT8dlRWcoRrnRSKkYRklKbsrMjlwjLA6Org33GtXSbkcTwyFGM1zksaU/jHADndy9fN+7hc5puMYI+6e9yckoj1kTPnHupBEQ34h79kWoiJOWRkFhYfGkkfmjSaZEgGIhnUMJmUnwpMCG6WyAk3zshEfc4cfQ9pANNxGqTDS/eWg6AHCJRLOoCY9TfoohydYYd3WOIzqtGTtVDjLnMAIZJCPuDCNvwbP4RPUk5uX7EoLOahkq3eACneS6hFR4XsBBFhTGZkeYme+Y+9rc56G2WgLo+C/cW6hYA4TJBrD2Uk1YtChRvIH7l+9JBGBTvsaZZV14kznJxVWkpZvijRQdBU3pTJopwHjy8n17M4yjUYXjvJKBHpCZ5WkIbe4yRx6AcbO5GTuJOxOMoYobDNSfcwcMXlhIoppZC4aBm1MwVz2WaDAkEk0SQevk+U6oJpNIx7gNg2eLzaQOp442DzByCmJsmFaCe2Ak5A1EcOsNRNDnTiQwaVKPmyszrQSXYJnD2lvTLhLsOWbMxRtlQzItSfKUL0UhJTMqChqaQWi3QWktcM3kLbV0TQhIHaMtvTycZUNpKhjkwo0nw1qK3jyzEhN0BRvMcVuq1Ac02+Ymx+2TIp0lyHKbu7yyBBJKQVSX6buS9pjCF0tW0xqPUTIhqd1UGQ5CdvulhDKbX0iJ6lXeYNkQhAwiSka1lpC8NUrMS6HDmGShh33dhHMY43hITnOYS5owyJ9S6hCsYIBBucS8J3dUECGz6zRPQklF2+lIKmoTuNrigIl8MpECacI4W4P+UaQxP3rPu89UiloA6eQqOinjSOJ1rk1I02EEfIfe8E5wB3gqyJdfh0m2H5coKBDtYNCLgWH/me2iFJI+keGcVEoBpGsoFuh6XJD4yVjAWqoA73soM5d1KPfCO0gjY7UzOuIuXWcwCg+VBGFMGCqLoAaSoR2OeTetUUoY2sSSsgMo3Q5w54a5yZ0nTnCseEggXtK8XG6bDAAUvtO0Nr6NeVQqlQkAe9MGTsJIPbGvqprPNbJbBj1O2iU8ryIb/QJv5AmQUZL52KodXtggMAx5AYqsRrtIYaNezMuxgVgtBHRcE8xL04Eh0gRxT6YycRyCBjaKQjMVxTvSU5lURC+Va/sVFDTfgJvBlyrDIV6+8O9xpyaaUvGLJm57AqHcvc1nJnpmIsTyhiObm3xG79AJLjTI01ZMQXMiYelyU5swzUfs3ZDMGqmuRSEFAfk4YChj22HMXKkE2YOZGwqDyggWpcLDkjAQNQpMCGei76KgsEoOm5dYcREtBgyaJJSYeRVZEhjeBpKINMlY5yYGKpNCObMHtMFijUs5Gw45DbKgkVy9fN+5yc1nw2hgsgSCtrQXiG3CecnMCCJNKNeiSn0hgXw2Nm95YYP5zDAbjLVhxRwVmDUHtGr0WzrG/XRjiTY8SRutl260XpqYKT9NG+UpjtHRedMKeXDOrIKf4I5AmF+HNsZKG6IVNkEuN90oMLUqC93LuUFoVXP3hoAV5mQGqHGHsARYq4AB85bYjO46Tk6UlIZhfzBJrl2NNAdjuRhldVxUE9FTj2F+PJGsUthkFtAlq+k5yNI3YKTUxycwiHIoF90H7k81jAEn6M4N7v2T6PdMEMZMTj9FDSiFMhCfmvLMlJZoEB4klNs4hXvQ5akJ5kPTW3Q+boKSem4kKZ2TiCTFYczbSVc/CyPTZzVN742evmk5FWqkMhPMsNRcDb6nuuebfDyWdPJyKbIKcbwNbaCJsyWQg9DTiM3N/JJDJnLGeanlK62cYiQYuNqiwK2EJjyJ4lwCRlzTs/F4hhvSTWlytl++MKE8lbxAx5dQnrkDpMYVGvcevrgZnZEVubfw0IzV9MjewIPcpckdiokqm8rAh25Eij5FMObkRGRM4AMYAyO6idK0C8ZktwmTXwdtvs+5yWVSjrEcqoTdhxFMnaivkjUtXbOhUV1CmFdtTkBItet2uHE9VFkNr4v5aS6k0GgkZFpMlScsh1Q4XssaEHwt91gMSX+tkFHlNsQTVQoqw7OVRGWhWVWocEgIY0l1pnnluzJjWWX1fgAcmpEOYl80N5ModBQf1UzqqZplIrdVs0bNHMaTilp0KKUH95XbxAEsHBRCE9Z5YQjkJS6EzE7VK2ho9qaGWVdmB0G+wx7Ld9K460rHrPGZqJDLCgNcVH016kd2eenf/xFqwJv7iRqbUYBscjnA6cPVdhnIcpibACcMoeGQ2x22weFQxqVJNNBCowrNerFJxJWN1xJXWuUGRLrmDoWDRGCmI0FINJU4PMmiIISkMDHoSUASYQUxvIfZd24JcRlGWtYEDRlMfUtRwoUrbILiia1faPfMlSYy85kYiXfSSLzwHskqvENCuTJFpIbaUHx9qB0SE8eaKtaO5c1QPEEdW7mMgoaNIowjvArGiSEzqKLtRlGzMWJhbc/YR/uvQRRTOG55+1FWKPa6mJVtD/8XW1SSjX/cj1X2wEvhjU5Wso1ZKSZk7LowY7+9GTvszdhhb8Y+fTN2a5hdwQlgVncyduuXXcHDdjZf8AUnavYgm5eaMz6HjJNRaEbWoPiLzG2OniHGfM3okk8QbVwmQCieEm4s11TlTEhmOHD5978lEfwe/v45bsdDq27cTwN3xviTLUzzK/jizHGLImY15wMMcj7cIOdzD3Lai0wXNlzKU3p/zock5LQ7DS/0uizOXW+eFVq7LMdRNaD3YUu4WQ769+6+frdDWKYqh1fIZUlGikRQqLOmiQUbzB7BABKoMIx5D61vgvSK2CC9EqBtMXCqIIzLqF8lMzyWYQR9iJznvxbouj+CKH5Xhp9bKIjNgyklHqgAXXeTReErhXplGeoAa7xQZPlYsNFswUazcNHQCwv2al6wD+ACrW/pmerleMEyxmdMRy+OzvGeWUQ3t6EvlGn0MIbP28hT3vKCjjkL9s5bRJyJiBOLqPkU7MC3iDhn7NW3YIPZYnAVh7L4UpCroLyWigG/jw1i4UJJD/jtbNhaXKFGiyHwkUJqdHTekSXo5vnmw+4X1paVLhZ1wCh8EKW4MZYyNHqjU4w4byP+0BFncUQtGi6U0xG5JIcLFdCoE+MPZWbs+Bof31kuxsabbDHGiJupHqJT1GK8fPCkU7Aj34I9+Bbswbdgt75wGSh6hoysYLfnBXv3pYvcjfmS8aXgi8YLcb6CPf8WSdtNd5HgQRNJrEdX4bWdgr36Fuy/t2D/vUUiaQ/qrC+9xtjq3bjK+17tqDjEZNQQOAFeK3oHLkTglT6dHQnDZYjnDFFAE4o/MOlQ9eE1psU3DFF7oSh6ksHcC/pljpv9Y4rgbyn69JtPY0UfRcaUcEUHAbKZie4oEXTihBfc4YJXXA+hABY3XOa4WRNDFQgr9Bb+GDyZMuYcg4g34cSqGiTFajZWUoiPUItDPqVMCXJNQ1kUyxKK+zakTai2oXFaaAsdF+7N1LlxglM9Lh2MCaZTm/jQhtImFDexw7iJh1K0YRvC/YnAG6e5fb2yoSYO9waacG3zUc6HTTqWTFsyXTYf0jwe2+f13JbNNI5HTTjr2bc54WmcO/gqtOF5GjcfVMTTBmSDw9KNHphg3hSbDjMTnBdNmTWFkDYfPq3zua0XENbRc71NEjcHSJj7HnbRX6jn1cMa5slT6meqF6ulIdpB4F0UzoGNU0iP43DGXVEl0E+iuTJ3M5yS8w1tMJWgnvfnSmjyBKVsoKJ+1puFwJrgMd9U3AfxiJb5jF7Xn0NC4VyCmrpkmNTDnJagkBEkPVS6UYhePUzyIX/CEPr4PCN+AN+RxP2IK0adRFOKjU0eT9Q0LwUKU8oocz79xATG6FRmzqF5nAL/mC+FeZLNOKoEYVOellU9l1g9r3sShIKVLIyjgt81jqpSPnSc5CGwC67gHiY+EzBMlXrlPBpK3XM1YCMY51NhL+O64gQTVdDbkqiHO+0oNESXARycqxPNsTEeFsgfn+TpXAKQQfqEpO7xa5J6zGWZqsSkCEHcwaq5/FM1z6d6HkkYeBgzWfRXYEKct7SEiRAXfKbgc5npTlWJCjsMzzRdc1MrUMd9LpE8Gddc9PDeecUlBpnoS51hfigHqBfPOTkKmlZCN6bR8c00GRfcfbkMC6ncIg9HilODYKJ0LOFkbCuwgGGjnDdB1OTxYJHDkGJRZY0jwJTDc2h0JZd+Ad13SuWE2u+UA1O1BOVH4VkPN6QTv4OOwAnggfdjHnNOuMlpdNjNgbqQUK4z+5m4MRyKd8k0EmgvfZMY8GDl5BTvaa0Dw/VUJWMarPQ0Dk3jgTC0KcMF4C7L6xPctc8DHXTOvjyC8EyaI25FbxpvhXsQZKSLKL/VrD+a8ZhVcUXBu3vY9Ed8U6axRJd6Ls1iqugbphEICByCJlbTu6dJ3k9sQE35LVPMNjIdvoH2zjmdlgQiBgylQTXJNzh9poC8YW54AAbmpmkTt019UVMNULoblDdJ3tDOkXDdh3vbG+bwG/ZWv7ayyid6FDzhKbIzR62IBLBcZDcPskMRvew5VnTaT8FHGcAFZl0Fn2RQ8LkGBZ9+VvDMCL2JXEGQBhQ5HmGa8pzJUSmjBsjRvzn4jVVo3CwJ8uy54Ll0wRPsgifYcMEqwCvO8AqejBcyGUdK3EFW0BlklTntLvvVyjnrDuaC/HbeGAi3l30JHopWySsHeMVZVSnf5RzyYERaeJjfHEbVElyRpqMU5jhHP/ty7VccmOR9xcGeKunaLxgT4UwMric1x1MLK3Oek5W4CtUfMSXlQ1+hRnRr8yYVg/ZOhJPzD2gg/xV4ABIUl7sIjRr4BhKB1J7jheqLz1co+LSFgo9ZKNrHLJC8UOGOGtklWFRLVVSm7JcG58M0O9becU9LfERYp+BTFeBCueDDFQo+cQEuuIkPLuiCBq+UqQol8QivlJ3pFcpn2iloS3OBm5TxF/sx7mfG3yHF90CMqDFAiZ5zIlABvQrmGIrPDfwVKK0+fMe79xfMf3ifMF1Q/uf9wgXvFy54v3DB+4QL3idc8D7hZ47CwUxNnyk6IukZT/af8fT+GZ/tAxdM65nsyX3Gk/5nrBB4Rjt08YJJl9F57KiMbua9CqSaUg6m1DehjNElJFTaTVRJPI+Wwkj3y7io4oGGNso7Z0ue4Zc8+y95hl+2jkcpc9xwCz2ngx0Dd6yWfERKmfPG1TLnXl7mnAxtlCr54BTuEHjhrawl7YaCX06ZX9je5lVm6Emx1E2jhA8SP2f24NOSDssq6ZSskjtDyZ2hxFOy8JcywM2+dJV8vIW4rOngJT7ABPg4vY7a9btlvVSy2qLdOh6h99WIL3E00WM8rK4ePjBJvtEpWU9Yto8oLOlkQMrS9KZG47ObYTRQdVJJNpod7KYJ2lNSy3fZJUG/eneFslXSfv+SekUpO/xL3toPF2xNJW/oL3lDf8kdQ5+jCkOUVjdBAKFK1dz6NLc+zS1Us8oJLtCV8YKlrbllam6ZuucnrXs28d6iLfJJFC2P6rQYlTDePRCHLaSZ0b03OprP2NN8xp7mM/Y0n7Gn+Yw9zWfs6XPOwNJ9+Cw+K0/zgXiaj8zTfFie5sPyNG8c12HrJEc8fE6HNzXIr5iauccDHGCMf7eAGkW1HI7RC1wA0AlvQgsfFHJ2Qs5HyPkIOR8hl3KI++vhlzIcct/SfByXvsLmdh0tyE6hqhJkT/Q6glJy+iCONVBAxoAlICk0K/jt0U9Iv7RGBQF6QKEIfwb0M+JfEE/pOsHLEH/oQYw/9AgqFX30SjCt0ZOh+OzlqEn9HEMJ/aisFxM2xZ+MftBxVK+sx3o4Sykmp5+S3lbQD+5upQDfklM0CJT4Q6GKfijRGn+m+EPvneHPHH+omIcX9JPhTTyRzBuZl4ywhlJFH+oRXktD57COwwj998HYzFvtNW+g17yBXvOOe8377+GCLgI0azj1yD8RDSbyzfGPmtWVmtWVmvWUmrWWmhWUmnWT+grnU+m4o3kTvT4B9F7unQRMRw1Xo1gHtE67cF2jTKIHNcxfeZsyNKkTTvYKp6LpMZ9TyQ4g1s5sA/5NmN1wYsk53TxZ2P9U+iCL8rjg8xw1azU1azU1axo1H1WmWcepWfTXV1gN0VAbLNvrK5xLprMO/KGTLJ1xLliM1xmfmKZZuIdLgT6RMYBemvBKr3AGZk1nluncOfJW86is+agyzUeVaRRM8Ze+lsdkzcOuvsIZYLrsaBaotTtIW4GaMqFvXr5gp5IHjIUa4PMtNQupmoduzUM3XDC7LLFqHsG15nGGB3JdndMAKl4sYhlZRFON8uayZqmTLqRWCymOXlnhiQEqfo6Hu8AN9RuWZzULsjhRKvHAkiLCsFZ5haIrBkmYkTmUvsICoq5h4s3o1vGYekILZPi6yU30yZWEOZ+dptmnkGZfQnpCKwCanQPBpcSxgp0D6edXyMHzRZ23P4mHwKkeoFNRW7vcYZ5zzbCfHj27wptmFx5le/5qkZ7RqYOaJWzNErZm0VqzoK1ZGNcsaGsWtPUV1mL1vDW50rEuWA7Tc2gltOyqaQlW8yKp5sXQ6gqH9lb9TsXCQ3WFUzUrGNh5GAcx53J0lLRyDjFyvt1NdGDHKV3BHU016FS80FZdYaGtGp4zG63wlTw0VVc4Q7MaLWhr4zKuHlQjmhZB2VejNzqAh/KvcFzD3yH9QkOpcDTDX2wJFY5w+Eu5ODnLBqoTd5bcqU4o0RPiNnDBtE+oFcEFGxpchjn/TvCCs1W4YHODC83F8BpRLL+AGghcKlQ8wBVX6Coe5qorDHPVuFPxOFZdwWFNBd/AA1MllZ2e89HpTfKoLt/Mi/J0wV/KLi/B44UfUYopfyev3lc8hlXZuT7GoBgyPLzWtAua0YxyEBzxGCd41ql4OIMLHh4FlwFWHuut4IKHp9MFBFm6VgleU/QjT1Qg3NElogtl2oyBEDCraRUPg1V+haLLFzRAPBgYmsnoAZ7PBFBofzx0VjxmVjxaVjxaVjyeVjkXGI+dlTt2UjX4xSNRuAyO08pho9GTSWULaN03/ebTo8PuxvHebxwcbm9s7Rwdd6qyoyBPZafX03SJErpgo2a9HFzCEn8pnyWZjFSsnatEAQdXyn3ZGa+u0SUq8MKNnPV6Fev14AKZxUvFb6pAuMcLpUYNnrR4TzWX6x76wo7KLNiYoUK8k/VbgEbLGeABUX1yQDM4Q0fWOkRLBXzOMF9V0NJoVK94VK94mK540IYLFUoln1yRJrJiLRRRwCQZric5FmTFvq3gKiu0GMM2KxAi136YLpUPa7cqFhsqHvSrKzgZrCbs6qYiyRbmioHKZsHazqZGCxL26wxlpKEfREGWZxEOmik2cPIyGMRZIKesRa9hStYhIYCraVyN0NKWzsOD2uFcXUGdVk07FTsDrFhjULHGoGKHgRUrDipWHMAlDgkypKIAQipkwCZ1D6//8KOP/vGvv0uhH371H378Jxj62Z/+7s+//gGGKFMtv3nVnIcxCJw5VF4v6LfsyKh84NBD16Whu6JhvJoTQ5/LkfAQ4JYwR6GyYtMovFDFo2EU/lI6eIA6/GIb59G/dg/6RlufVJFBW61kjLn5m7VakNdwLCqhWr3RYSQkCVVPZVyzBqVm7UpNzvVqVrzUrHixJI2LJPKkp13m4edrLQlv4rF/5VhpkKggBbmx4SyW1/HNKHZuJpWO7V0Od0w1pl/KXb/kX5gHKgoRdZjxbwSdrcAzxiZ6zDFxOwYaeCXBgj56QL8TleVLdHJTLMjxiH9VOeYsUjCXIB8XQ8EUJSJIM5419zO4n9GnjOOISWLIA5ZiSHdU/OOSf2d8p+mX0TN+Z0KVkFCNJPVQZZy3pJZvSBg1YXRKcekMDUI4JosxzDnIQ/6NtDIBDhUJ/eK25wlFlDFMByiQT7KcApSC7tFv1IPqxtVQvpu4d/Qluk6pLKqIf6Eui5ie13P+HQ3zkvOD+2Fx9U+CUHAx31DpTvJkRoEZua2BwBwdc8fz0XwU+bdMBleYHsUlh3Gtf8Zhquw5FSQ16mFbM9rMN5ZrEDRrOrK9Ri0J/kL3rtHXIP4OKR5LiDUqNatSahZN6yuIYPV4Qa/djfFU4GR5UyWzBxmuI8rUrB5DLx5TVsaUObbKgktShXgBBlOzTRdeRhovTAh1TFTENgj1a8s4n61ZEKyvsEAEoyP8QY/C0ACmfXBBE3u40JlDOOrqDC+c4Bmeocb4EIRE/C3pl6jUHH/7lBJ+FfxW+Bv26Tei9xHVkDDDGn9HlI+YYmLODGFiysKYUh7P8DchTMq/hEwpzZRSSAmf0ntTokrp7Rm9PSN8RvhsRL8n9JvS74R+6S05Pc3pG3P6uoLeWFC4pHdpSlNTmhW9t3pO5UpvnBBmwmGinfIvxU/pXdOZFPDxKLIqshJHYpBnoCuic6QA90UFuojoOA2rvVLWfy2M6JHqjwIyNHuNlQdkPMoxy78G73gtCGFywaGo6i/zcijZoNaokhMXqRpSA7I8xdNQKhoWoMrVXJXh/4DvwSecqha4yY4xZ4W3qjBELSlasU5HMWeQnANqNtBNZpDBnv0O+Dj7La8FeRmgLxgsghEJNyDgJcly8HZkfCrji8lzLq5Mo2stzH0GGZqi19cgrn5VB/0kUuSLsArCGA8DQ0GR1kfofTYO08G1YjoKjcRYbtUUSmyotCFtQ3MT4pZOodyGKhPiVk+hyIQGNuWhpeVeANnBG+oMQbAOXO3u6u07d+8ES8FjOuWOXN4qroKlaQylsbm/FaCT64ySiW2C3IkoZF8nnQlCY/sh3KkwlFjatAnZVFKbfe5oFLLppfbTU5tyaguBO598HPVB/rjVlbXXb92+B193GKU5iq0KGyW6iCed7TFI0dAeD3Ot8+DzMOrDdfogI5u6NzhlmyvuzhQ6saHUhiY2ZL83txS5rfTcVnVhy4C7vOS+5Ko5P79P4jTYwBPicIds8HkUTdA2r6we4GGmAySGOM65dstEN2Vy/9bt1VurFxbJm8h7g/U8n0fB5+lmmW4e2GQ4+cpWDfMkDNW2Qia2CidNnP3gaROKnUwSy5JM3r53b+XOhZl8Cx0yBOsljIbQOT+PU7XowZR0kiCicgaZ9fX70uMwkJhAaQLaBOYSkGGlL30NA5UEuKdhIJLAwCQ4NFTcyyDAww0EYvOI+wsGDBX3FgiMTTbGJs+JoUptwJCn5u2peUVq0klNnlOTYGoyn5nMZyadzKTDDRsDJyaQmsDEBEzGcgPOTanmpjALk9XCxJQmh9q8XZu3VybP3HogUJusTgzVxMaYlKc2YDBTk0Ou7iTuSX1TKLGh0oa0Dc1NiCudQrkNVSYUmo5ENxG10W2t6yj4lbXbt6CN7lILhQnwAQjCwDdxRwaNeX6j3QoBiUPt57fCZQo9sCm+QW8a2PwObY64RWGImxSGYvuUGxWFLC03KwyN7beObekkljZtQjYVblwUsm9LbXqpLZ3UppzacuImRiGbXmbT41ZGoRMbSm1oYkM2p7mlyG2N5rYeC5v7wsaVNs/a5kXbvFT2O7jNYai2uZ9Y2kkTZ98xbUKx0xo8jrV6997KhRxrR5Ugq78ddIF3J2HweUVX4FkgZ9uUuBVYmc2OlHS2AwkzvPUJ5As8U6BfaRR9dmHmDOJZjUcM6WWS9G6TtqYkMca6UkatRRHBT4a7gaLn/aioaH9PIc32xsFbx6++FrA1Thnc2Hh8fPgqOvmlZAqFdqQxyIs3DrqH+xuPXzW+fpcDeGnO1nFBGCURnhccNhuPoGeISBXc2dkM0Eeswh2uQBd8Hj5yKVVLjVYgeBeL7t2gBCFzeTiN0CMzjmkF1EsjO/RHVenccuacCHjZLh4uiK7Q8byP14JtaJ8BioRcklUjEM+oFH7jfwxu3oAE/lm//8+gGl7lHnCTnBwjGN3s5VMQGcXn+pmlIhA4IcFlPLyQhqEzOFI/B0sjDiy38cEoSoqOyrIlcp5m+Dse4kwhFLA5FEHxZRKm0+t5ZlJrCVWRGR3wNJYqytRU7mmudY6hGc36ZjCL5RWrmhe14DKse3hBJVTNa1s1r2bVvJpVz89o35frOSREui36TfFClLxUVbOyaqIun0NOYEbP8+/JFRa2Jv1FCzh6pEYPgNOrQZzEMAqglu2NzoQtbSZsYjNhE5sJ293ABb97wgY3E14zm0Rnl5RzKsNJtGBJGQAPGEQNCoKT6AF0azwLEBcY4UEH/shSDa5oSTJBOzf+TfESoQzFG+DgQkZsE94IR5cJXkh1SNcKL5R93gk3YXO5CVufwAVPYcFLhJmStWEIVHyPKvMJ25VMrrD+Nhl2JqzOmJxzpsIkvplFU56Q4eqCsT46C9skzLuHtBrey8PZu0z1rjUkSZCP4XGDEc8jeeZHU1xhesErRkn9it3mGFCpxVT2VFQxT1DDPNJo0Kv6eD4SThzVDHs7LQZ7M0fiEa6RSXADJp+QOKIhHZxQWk14L3p1GebnVH98cARcWC7myuDjICZswjLJ2tatOUCymxPUdMkvF4P7/uUTXXQmvIY14WWoCS9DTdhAY8JLWZOMW0OGiiD4RU34RNa1kA415HDFZQe4yFbdCa9aTa5gJDCpW1U5qaky65sdeES5qzk/NWey5kyyYcF08MswVZ/ySvH0CmqpqWu3rFWak5nc1OzkDmlFcspmHlM26ZiySceUl3umbPsxZWY6q34Z+Z/x8sz25h4f5EF5fHsUZajIQG6CmoqCTm/UrwVFEikYbc1YhtN1mAIiu9k+2g9urd69y6m8hm0zo1WtJWjDUfIatX8eyNg+Cnujqn4dMXhk0dIOwoIbr3x+B9pYFqD/oohcDr0Bfenz6JGnBlmCFHRv/JPXgIeXscpwzWeQ/9NXoSQ+7+fgDUn4n+gizzQeKTfErdD/lPNEL6MPf54tLaXDnlLpPfX6CN6/hR7cKnhnt1S9uI9Jd7ecisNCVpHdL6YIJabgzCqwd3z2Vz/9nc/+6rPvwf9v2ffM7qtb6hm8YzSL6AUp7qzK6BW7VAtH+xsQDG6woMKMfFmlgdnp8Grnbz/82/f+9oc2yTu3e/cGlVrp9yHZdSikREHKHMB01zc7p+9/+/T9T07f/8rp+x9BwJLeX1EgeL3SGyJBjScHSF7WH3U+/d6n33eLp188uzss1KoCAjy1YFTC53pltP4Yv/mrn33jsw8++95P/+Cnv+u+B6obXhQl+KYoUWWt4VU3D+n8UlzQLNFElJN5B8n23c93bcwhXz/89P+wSQ/iZ/p1TPtLozwbDuv85sYozrAE8Brh2WRHcVok8SCOQkx+Yw9JN/agPzhV2s/oYKiMraI24/Lm2srKnZurKzdXV2/eWlt9nUaN//Lig5/9q3/nvnt+f/G7j0tltsr/Ml7+F39oX54Mez1Vrarw9RPIQTdBL13qZjcJ3lRzFZdezWx+iWvm2599BK3x/c++Z1OZDnujVWw4W8NZUd0Ead6n3HrU4q8wOoCkXC5Hw5sdaN8/dNKKVtVtTCqqsQScGt166i7BRTWeSBLVnU9/+JP/2RI/e67uKkP8qIyi8QLK//jD/+t3vK47WlW3RifjMoQUdlWN53pDt/K+ZPcQ8vvT38eW+dm3oDR+F65/ZRPJ8hB7whA7JVuqQ6vcHY+iEDp0jPSPtjr/+bf/1//823/iZjnFHCdujh8d4uPHeOwCVGqwG2csgsEsbBuP0oWbuo+7vaht8PGVzBllrqDh6/5j075P7k5X798awnseQxsLnsBP077whY+feEYmUEWjcVz24XeBPDoaL6OV4QMDfeOiNG7i5A93WwFH+804/I1bK7/y3928dbvzX9//s5+/+G7nZ7/z7Z/98Ytlufv5n/7ZP37lI3v3J3/zs4//wtz97Mt/+F8+/tjc/cOPPvqHH75n73gVXO7Ml6/pfnlyn5gauolGfvwEzwgOiattU0/aBmlMdU4/+uD0o09OP/rw9KOvWfJbozPk+2U8O0v87Q9Ov/3J6bc/PP12Q3z7Tq+8058lllql2JhgqptKsXtJvP8Bste/+vD0/SaJ0VoPyvtWNIn8RI5wWRQa6JlEvvbB6dc+Of3ah6df+9rp175z+vU/8pKCT3ndfExecTqV4qZ5fjJf/0NIye0lw/r1NeWWx1GMxx63U/i7L9Oo1YxZ5aS/Gq2o9FbrS3ZVombwPz2Thx98cPqDT05/8OHpD752+oOvtL/DzcImerxVOPAsKg/3K4BfKb9N6FEan6WHYcj/DKG9txq55A2D8In/7pMW+aCg9nQrdKmPo6Qe1mey/uEHpx9+cvrhh6cffu30e009DqMzTfJRfYKhs1//rQ9Ov/XJ6bc+PP1W8/X6fu8sfZnW47OVePqND06/8cnpNz48/Ybfqlv06yDEnNeKuEW/77Xo5+P+WpjcUneiaAXFJIJiIYBUmZxJ4Tu/d/qdb5x+949Ov/O10+/8zel3vnv6nU88nn1L3R6sEs/fBkYI6Rzg2bosf2wfwoD1n/45Ck+OGGGoSofIqT+kAaZ+hqZ6vtZjgmc+wRdoMPaOkCdOvEsGQ/gg1uj9pfPZjynVr3g5mY3ugTxE0tDSfhnmfm7e3CdDJtmCFbBTGZ66HdtDl4MNOi88uLG3fbzxKp2/lkOPCo7w6O+KFkSP+GBtSDpZerPuRfFI8ViOrfO7zofeilZ691buYeM+jPgEe1q0gZk1VtJjlJ6plp4cdv7fP/2L/+eHH1jS11dUvka96omaU5eaq/GIsF/q/N//6tMPP/3rZtC714+o0nZUDlD4RdxOt3P68ddPP/7o9ONmTB3M+2v9+9Fan9DZWDHLwa3kOlriELHjnSdt+75k3Dn90b87/dFXTn/056d//cdeE1TJLRCeiZNt45xAxW4bPD8tbIwfnX7nz6g9/unpd3/fk2xX1H017+MYu5uXeb+f34Qq3VXDURn3fBmiS2UPcs/HUPrfsImEqyoZAHk6Dokv9iNoDtKUdylDu91DPA7z0//z048//UEjw60qktpTajy7MLbDzEzI9hD8yaeNtJnGz1+/vzogCadPhX+RlLlLbW93H6TMAGSRva1jGLqgRd8wcwiRrvOS9ArLaf5q5+effP+//osvuy9beX3t3Jf54jS8C0n/5TfcEn1+ux+uqB5RwxihiU1QkEjeQUnsq9BVv+/JYEB4X017+NL9tNW593ehE+J8qsUOYqi54bPiLsrAB2oco3kfED4tw/pmQ33wpPNT4Od/983Pvs+yXzuNOzAMraz0LkzETjad1H7622dSmw178ZpK0+eQ0EP4XDxk3P+OgyNjD3FAznPwnOzMZRNofxRVEIMz9aBbVyOYuFaz4MbB3vZe99WWNF5k5OxHd376W8ASvv/ZX7enXbcUti9d9loi+eFRW+K70g7Sn/zWT7786fc6n/7NT768bMKfwOX7zd2n33OfffoDE/7Jb3tUH376sQmb7BarKobMlgPIq0wMl7w8e5OB/193pP7kyz/5qjtHSojlfQEEH3969IVu+xDhPjuheaZgevSVz/7amR5BU4vK4ra6E95WJU3ZjlQNA83IS/Goe45u0mw4Jcb/fegIvw+s/w8+++a5ab9+b3hu6k4b5ve4qcFY66X2LJndA754996g178stXMS+0+/fSaxiRqU104JmvPvuSkVydoARQ/aYOqX2+YZrYEOG60BsBpIzu+sgzK/Hd+9l+OgNETLPBw7bdifZR096vz8X3z4sz/4Nz/71+/ZFPpJf9RfidRKb224pu4P+2ErATsqAfnpd/6c5CAzBn3nWxSA36+cfudDCNtkc/jOwesDnHwcQfP3p7FH7/C3fK9d/9W5JH7hOrQN281v9ae3sVCPRzyUwgXRx4/bm2lGfHg2big5/fFXT1/8y9MX/3vn9MU3Tl/86PTFb52++Obpi4+W3Sf/2+mLF6cv/pIe/uD0xe97DyH6e6cv/pfTF38OZKcvvu49hMu/pei/dKN//OXTF392+uPfO33xpx76g9MXEPfV0x//c3qVS2OZy7CnV0L6TJD2dKtUj/da6r0KhL5v/fT3f/q7nzVKsHFRzu7cwzo+VvGU2t9FI/Dx2+2ym0o/tlrDENhs9u7KPUXKnZ/98Yf/8Ft/6b5qunrr/Ff54+8v8qaf/7iRxLPs+a3XX1fnvslpOvCaf/y9j90snqyqFIuzHpetseVpF5n9xz/5sts818Iw0tT/34nS1qD4zhaLVX8AYoH0z+fPG8123E/d/XUdeMja75ZnhxnxyVl0E9df4uZc+3dplQG/fcbLWTNex5rxwtWMl6hmvGA14yWqGa8+zVVrcWSu0J8wIubK6EZ4gzO/ayluBP2buOYxV+i2L+ZAUuEFetCcrdnnbM2Ol5JOsMUgekeZs3+LORu442VIRBknBA2YLvAtczZ+n9tT6SFUUTQuos15/XLu7sudk1eFebpoCyp+xQMDfKMzT+lTUlq+mdNOqTlvm5rzbqg5b5GCC63szHmr1Jy3Uc15UxVc0Ih3zhun5m1nD0VelYo9us2nN3lmluE6YztvvRQKfThVDzyKN4K1ldV7SyurS2t3OnPy9TAnXw9z3rEx5x0bc96jARc5y376iFY88GZH9lJDZDCk9TzoaXhwVhjAUJF6uYX5YpbRws4ZHxS4s3gIgoS+OVnjwPKJJitOyOLaLczirZXjlfu/vnbr19duf4nlwVjzVm64olvHpWGU8Uj2WgBTiV+tApiSwtMK7SlorZD2lSuF7RM+/M7SytrS2t2gi+v5kDUUHHPctJFEqLWCOSS1SLZDQB8+HSYvC0t/Z2ltNeh2Dw/w+DR2Pqx6qqS9i4y4B/kOHkJKwCHKmU5g4tq8Rgd7y29B0kQi1D0mvb20urK0djvorq8HO1UImJ4B5LRpiTCQPGI4bkf1cpqgxLjkxJmmJ4ZwEkd+voDwLYgzUHgu0L6P24x1Fs2CLRS0izLWTvp9oUiapO9Ca4KkE5twYpLNhyrMbd5vY97t7OpI+A9Mj3c2OoIVujocAdtr570ONin6aAZThpS1EK7KAPUE6IFGyDmtvgqjdMZJ3VpaXYXUgvU4A2l2N8e6ppczSAj6kExdRjbbry+twufZ6CLpdyzIkOAp2rjKZqoSOtgKtMkK6cyznRiYSBR2GniLWttX3kLys7m0QCHMbQnBZ70edDf2oVQnuBoYdEcJdIIseJT2HgefAy6xHDx5BElICfdxWmvKZA3+gs18GKyjDbl5GSAYG9p8AXLlNrRr9Mig0azzMFdhU6Nc+6HkLqyTymnaq3eD7Y3dBtzd5PcgjAmiof0cyNT9oDuu0HVcAuNGFWwlUR/98tXPOwAUgipTrZ5JUTvxAJuirkuFG+A28rRQ2axDeKYcJE7OVtagh+KSPWoVgod5XuHJvcFOpIY1tKZBIjTIM9wauh18qcttDuak9rv2DjaCCuUcNFzXNn65S/QdToZTHKrxSGX2C6B73ILXwwhHrbk7VMETfH7Dhh5C/fPTVztCLSlFWd9t46u3z7YewjA8Hjo1uvp6wxB5Zm3m2Y/KvC5Mx4+l0OOyV+tWz+S4o+Xu8hEi8c6AoXf2I6fUVu6eaWkCMhRVlPgcEdWwVYxvwUe2F9EtE43DX7gUgZTTSAYKhq8odz9t9VJGbqgkjbgHrN3plMg4JJIKM3icJ9Qy7FfwU0NeqNk1qYFCiJNBnCnyIm9GipVbQG+iN6O6AskiQsPs9OWPoDXgYQ2qqlGBgj0tG4I4mySAUYOqY9MzqdN2A79wuib2YVyyzdSG0jDuVrPzep+ATXozZ/jAJogx6E0868ek4sE2B3EC18ppRfewyx6Sr1ooGHzvlsYcI8jgK/I57zbSnaPj/d0OP2IUuiCVhnOfegLwHI47Z5DiHNFjoeY+Ez0nf0CtkjEdakueHtNeYEg3oYm3SdaUjk3eS9N7zwBnyTPnNdDMu+lDlbZoGWdIn/+y8vVcUoTkF+UBHgvQMkvmzchkMGq3Riuu89pHavliijamoXmREXoewYwIFwIgIXnesUimA541q+K+9pnbhkoL4E7bB8xtDEhIwjKPQ2eoB+ngkvGNSYT8We0wcBqE16P4BPvoF+JRHTyJTmIce19DkQ5pES+k81bVmCFIlrmnwZciOtUNjVTGmCLzANv3TdfME5sQtOJ7wb4CaZSzmgt/RAdWZOHklszq2SHC4oTMlXtXLx34i8KQObIhEXYpyqCMdAhlUaIFQEu2NdE0i7UgJoHJsEkZR7k1GaRxPoIT5eCItqV1EGcI0n7uS2Vdjms39Q5jhaw/im221nDQ2g5pj9gMRONhXClnHEKsUBk586KRrjQyZimS0V0RJJ4+CTbKCMakSYRvArmhSb4yJJFfe86MhfsvEr/8N0GiguOX30xefnNChzIEW3WZFy+/icfUBI+Wt5a34T8mK0WqQ+U3xLdpWQdyeIT2ppbzAU4I+LWR9r/1TGOyOCarRklUKZ8no4b/kbIiBkMEDi/HKYgnEbfLU0BMUfOGjoUEjBF8GLeEx6eb2+cOhYC0JGfnPZeMGExj6XM/g19850vLG/u7kjt4LEAtPnf5RTADueXKqAd4wnQCLbcscrY07QiJkON6SFvEuySbSGKo3R4Db/YzWeUW1jQBYH33z+Ck5ieQ5b5qTVUkkl/ON4ye6lb5vn3U5JpSFrNO9Vy5MxgYu7/YFR7JCm5aqT7qHnUAyRRzZ1rHxbIb98tc54PKL8y5mdqB8DVzRMvWR+JTgcVh3RoHMEpKlx4LMGuPyivBRlzFQ8q4oDM74GI4Aw4oS9VeR/V7j480xM3QdvecSR4CDHJskfex2AfHO5tnW4uhGgtVaw55ANPTHCPWeEU+hEGim+VZnKrgAOTkHPqrCkIcCiZoxgANEm42VEEc9S1UpGJfgXRN+v0oyTPljxC7aIgQFzHSrhtIx4ItaaJmfVWGPvdf5weaRtXggD7HQl1SfUU6bYiiAcwcXaF2FWonSfLgrRh4FKSubU0x1hAOabPLwsmbQQkJumtI7LCPs6T7we7OetANJyhTGduMzceGmgkM9TiqXHpgL/CBD19+M3z5TbGb9+aBERe0JbvxcHu9+2qnSUjSrUeq1o6yB2SRt6NyTLGohugIROAz9NHT8A8QJdc5biOHoapfGRqMYxKjpTKdFzeXxnoEV5BG+oo94bj9uCfaql6v8oXW9fXPHbeQUh29ifJVA+vdvY39YH17Z727H7y1/aWN7jvdoHv4aGvvuHu43X2Npr0dpOME+kOXX6xRL13PSWjaIE+BlZXlTHvoD4U0u1JD70txgLygfTlrnaJEVQK0olsjpKGpK8vQUJ3jc3d+LtCo9Nn7BZo7BApFViXNkC11JJG72PcbsUawQlcmcWY7wArytjCv1rcOd7b3WgosxgqZGSHNALAOMci2DuATUYWKCAvt1TOffa6vvwNcil0f2K7JQCGqnNKFecGFIiAgmYIVFV6hifLC0aQGN2Qa/6rdjGoTIjCn1UgazLzvN5O3dXomNk+dnpUvemYmYwoEFZDrEGnTl3lLLx5HBom9/6xGFBECzYZXGTIRZwlyX2F4NnGACDj3pciLy1hEo16i+mOniFcX0SC2oRqUcahmPu1FbbrBC33eH/dq2vvYVpSPgs31I78sGrShHvod9UkGCPl9+/H+9hGyCbR25pfnQ0OXp3iipsMWgKmu22iY00pb0oaSnwh5Hfl67wUlVUt1p9phf/CBwGOBd8GMANgwSFPP6rjXs1MmQAvVtNXqkWvHpG6ijh9lNBRE54nXQMyJZAXMP2MYVmxad3BgW987CA74QafBMEmuqpZy2uNmueV7eTTiM7qd6sN8NvHb8JvgOaxtJSiNQhYnyQ1akyaSCnAWyn3Ubw6AFrLUV4jvvfy4n0Q5S0S0jvHyr17+ZR6gViTH89PlPsxB5EfRav0wWAr2tjeWeyUkm0qqnpS3cic4AlG+2oS+f9Tt4FOB5U4dodS5cDYPcEM2dgWFe5fNJJDAUlrmQeUEX7zOkTi3D9ZRgyoooYAm4asFD3NozhUOoPDk0e46VgUEDbyKx76SaZ3j6MMxZIF55ox6qysXdnyCClV1rSlUz0h0OQwpz7yud55EJyghee6rNagJ9lVxplJkVoBHset+3hpqQfaDJmKesVwid0IWh0Oq8eiMIGUfeC23iZcEoKFMlTvEr60FG1ES9XAZlgUxeG5KRG4N7TgqfRVGezpCEIPGPaPOlAo5gURuZ2HNS8iiVxO0UNYauIv21Tebe0fLvSiYzKcdA2B0HSehzyQPEhxio4izhM8bZFTqxSuJBiUkGm1ELiVhlJDMrtfojLBSz+eOBAVy5+b+8dHxYfd469E7wcY+IuesMOzNR07ZYId2FEm4ag+ADv5HcN8o3G6ZxZhW7vuiaeurQeSssp+jXUSEQBNfzl3MiQBuyJJrlU3fTE0gMIHMJFGc+aP3wVuPyYbBH0UduJCnVlt3GxVFGyo1kxRkcEfdwx0gSg04KtVi4YoxBl4sbh6IEGjmqLdwwQIyktFkjT42y012i6jKp9m1lyrPgXRMYiZlkhoW6v0E5BJYfkNDCNCYGf9+FjlrPi7naSglIY8VrHnDfF/UBXBVE5X5KokNifQGddtA6JkhDrVDCsPo2S8rZW27r8roslIobc7tRIq7JonlGxxtoFHpgLXfkc9L2nAYCOuLdXX4VGBaOa0MmvAFwx8CDUXkLCvANJFExpmFma/To8sKQhtOAmyuPWKdBcdyCDh6Dyit+HBxlTDKkIxyR0uGDGuVBCfcIxLX1Gl1nGAopIUnu6MlpvVr5IIJBW8cbGwcvQoXIabWWUMzTWgvACP9TTGvdsz7OTM95Qhla9Dm93d39/fe3uruHD9GpcKTYP9h0H2KPHpnu9vpyyJwv5c5q1FrrD/YGKEUjppfT9Xh+U2BFKQl90p3nRZa0Mb64ZYFmXbZ060+CZOYTfHHJ1BpPLz7ZjGTIozAcx+7sbXvTsr7sljed9U/JLbSQkUEH7lfDlUWz3lEwvLeq9mZ3yHMG3C9JbjxysbW4d4rUOZGOdQfKLfcXg82HnZxlRGmO3UVdfoigvcHoS+CuLIyPGTQSGVR4qt9NzjOnxuQBMtoS5g15guoNnj9stGNSQw57WIg8tdJhQbtlzW/9GLUeDSsmdGG0umvt3Fse/NgN8dyxPfriCYorzVbKJwxv0PEJplq8dQdEQKNVLFYxCWIgMkNgq8c3Xi8vXfc3fbZPgOFCBt9qrTPuTwGZyCGAJ2NXEe0IAohrsv+yBcaz34QYhgeF2j1G7siz30QI9BCZcM8OyrxSFq+Eaqyn0TXE2KIRKh9kR9Fb4pqtspZIiPy92EUDZ22TNrPDYnk7s6fxlGGJl64QIEAi+z7M/+N7ePtDVmG8WoWoYZo1prht8vZNmsIRKq9bI3mXbqaQUHKBxjpzX49U3ESiYrTSwzrGCNwYECXDzuCEpLY0UgRJ2tvUBJ9idDGopSCUOYU3wVZQ0wDt/NKGt5WeBFoS1cvvwnzFVXD1ENehs7wuTSETNIQl6qL5VNBGZI6dFgLTOK7ZQ2j28HWBswxC5xlEkbQdc9l/69fKGkA0FKg9bK36LeByey+/GYYQ0GWKsPPO+I3EZopc9X0WJasznwJQgQcRtrXMZ8Fh7JY3c8Hgyhy0KvnFBJhBJ4k0TDyNYsen2KAAefDZgK+Qg0MJMIneZSwuWZHIAJPcbhvdWCJdPV/2yZb9MjQpt7K3pUEESGyKYCEU80u6Q8GZoiotS+WGESFaAlKr2Ff3G4Ya+nqypOuz5uhCsqQ6Kjvr9S+BSLkUTw0dt8MEXQW5pfNCghj4Oy4wtoh3KKJ2t3ziCzSksrSkPe2loakgRmqSvUr/3POkiDG4kuy7NVu/lbOy58BCqGjy7tsDuFo9CTsyUVGKr2UjXu0JrnkEpkjzw201K69Li1qb2A/hEKfxMAweQ5Q4gGEjBW6OsNv8tZRFvJzJjDERaNoNJsNFo/vROJQa8cIeu28lkMgQ1DqqKUAv7BWCMt0hTJWjyiKrbj2vyIwoy57Iyor2kFlZNCuY4AOaXBaJe25WDyIEcaBu8vyi0jsmjzfAovJM18PAJL7Rp4bcw+HlLBCCwNuVPkDBa6MbcgDswgiQCFyNCjMVzYoautZHRd05own3ZRWR1K6S+Awb34YhbLbciNfkpxOIu28VSqlrONGhmcl9Vsx9bpDIIBxnQAwN12PyrRG/y6k+2Q6J4nL+BWDmACCMXSuRPl5Ptp4vLu9eRw8Otx/erBlDLsbNBPP0rL2pzp7fLJB8PRJh54KLq/9SvPmXPCUYKHq1WVLaUtR5CnDFhfBhCD0zQcWy/oAF7KsH/kjRotjEkKwxmLtLkl2a+ev9yHM4KOzW0A23SXe0JhAowX6ZXImYwxc155O716wt3101N0LdveP9w9RxwtEx5v4pQgVqtl1Fn3McmfYT8b+d1xCB3gmDLW/Zn4BNwpFkRZGKrmWOR0SWEpvOWHtwlUThloqb3nxrHqNIAIeltHivQsMEXSC3XTmamhXz0mdUYaktdVhE2Mk04n50CTHvYf+euOmiT3Oa1xlPc5HKVZ7x8ANbaX8KeomRXXjElpk5hRrYppwlOb9UlWLe4iAhCLzlcLnsHPGWHisq4V2moIRvI79MX8TY4wfMMHLSbMYGGZO013QBAkqVBN/XfaS1h7xmVjhqDWvtlss0D4z6D7qAIKRsUpBTHSFrvNanqCEpBmyWG53JxT4VGDDy1XyAhIC9PjsWmmdU1uEceB5IxBh1i+iyE27RvVDnVWX5YpRDcnEW57HAjWxzSKBqcSOoTDkoysYZiBM8DNnwRU472XCKBAwYVa4oiFkUUFbjAsU+w7KOBMTNGPwD3Amy/v6Wk0slw0KELA79cwYdKYgc7tXL8yHi/V3oZiWmJNXFk5xjT8TJqh8a+nzC1jWwEMQh5JchWcHwyM+DKMZDwXJZGU8iRz5FyrmknJCAiatJs5AAl9+fv7kOLuw7jnqyFVUpGxS1FGKlu+PmpMFNyOzKaJDREIOeS786QxZ4+Z5OENF9TFuiPpccFj3elYjM+swmaQALabyh77NGpcbgiM85Yc2UR2UeVijB/mnR12pESKTFMqe+uUs8nFSnOpEDV22c+ua+8aCzbeQ70EqklzTdu8gE9vcPnrc6CtRSbWzvLO8ATU54RYcNZupTRumYT2Btmk3/m5nWT4xlqpmxvJk+clyJ7Ibq6NGlX0V8TCS0SwyKta7VK+4RgUCeJ6nAhO9Kgj1Y+Urcrc2t550sZTI7Hqs6kH68mtZRlZQZfAoynKtofComKIsiHDtgJKRBGteR1qsNrEwJoL+mSxWahFEwCAcjX1Bf5einjxS3Q4/ZmQWlcNLBBnGCHwIooRjLXqepGRAHoVjW3j+GOEAhbAxo1w4IDlAJix0s3jOzeooisd5sEUPXB5BUCayc72F6lILYyKYRWqdZ76h2XGURIM8U+6W5p1gN9gSdMeQSRpZ7FfU1uHedmsTKPQzxDGBfnadRSeAC1mzqfPCr2t2bUYglKlEVf7C8FYal7QdCL+x7/sJdMo1uOFwoS1J6dWOSZNfUJe57FryfAbYlUFv+fMpqQNubK0/hWQspSSkz+5MqDH2Ie79nce5AhJpGpNmZ9xFEzHGMPw5aqSGl6z/G5SQFFFZ+cqzswSIMfBcR+ElOWKQIfC2oJ5rfuPuKY2eQ13owu6pNWapWxgPAxA+wN1f6EecaQ2eyAdq2FrEeogx/tooqesRKSTxJULqwPApCJTTOAtbhg0PIfptij4AHg/cR1s6hhtiZ/iwUsdDijYyB4EE7m2qJYP9FuNy9tMOvPH2LI8byCAKV+3PQb60uXfUKp8mM5nJepkuZv6IsFBrJ2JYzEOJbLbWbplGKHih1SO3g9E28QvmSII1dNezhxyYZYgBjHLPfWMDVooldhuyy38JLoRR2LPm3kYZewyS106Eu5ZRUqrK2uw+NnChLUtVxtfazS80lj4qW/YqDyXyWDjZTv1c2jk/YMpYVdd7bSzsbxCjeqCatX3JSOw6mUdiT/O2Yxkqk0TuF/VhPsRW0XLguqHQRbSUWxyanCepv5awmxPrPojJGbeZG9kaNzYdSCgpZCr5Jdo4U3o25czzJHHOqC8ghyC+bG5sYUJUXm97JxJYykssa3eALtNkMegm0rGkJqE0VEnkq/oemtjGUtHwD3kixGYebAa+s98rU2AMXHVJR7BCV11JvzKITaOuGhvYi2uhsiawA1wgL32e/JDiTJnTjcEOR5cN3gIyBC0dw8Od7UMzsWheYJLPy1hXl3BlBhmCaVRqf63BVdwIQMCz6whtAzMKeYb8l05sBmLID9fQN2O9TOeBFJY0Y33b9ZbwHEKTkLPz8CIrQIMSEm8HJwoCGEO7SuzEGkEGbYaaC2zMCWGw2u1nd3g9qY3Wtm/lMNS0mqWUtAXXwgathxm/ZV5oy9UQCP1z16cDjCD7X7Rg8zL5CquiNotGl3Eso7Ae4BFMWdx25vPQRm+nNEzBDH8JZrVTleCcXy+RiYIllqRcm7fVO8HDwx3rio6M5AAhyHyoMu2P6PsHtx4ed+SRgWVVmOflNdubIWsSiR2dItXBQxO7ceFUpWMoOZmq9PewXCmFSojrk7jSdauIJdKycL4Xgsz2UtJ2H4Ko8Tx4+CWoclnUgeslq6eIECjMED1nZhfgBSZEVa9ZZ+f5dbtfEITRs9jvFGcSn7FENVSJz0i7ZILYj19+PwsK3Dn+qLvTGcpIDNck8tTO0PXbSQvIEuRX2X5NQEtSF/76yCOKc6B1Idg08rcauWwdn1qY9nGtoiOEYItFG/qHyrwZpSR/LeCCMZehQjWza/x3cJRoxOZNWvkwFFJ6vXju7zJcPKognglDdyoBH/FmHmfkOwTkdmMn8cqemsRD6hmu689A87D7SmcoXriGkfKVNI+2jE+Hc3YOAlqospaub0vpGR5XGay/hacvVhbmbkhD9oMmxXkJEsTbeZmgN8E+CLXRMmvvhUCIyRv2FTylMJKJhlHkm0c+2t231iQGPxSGPIwHla+Q38yrRxApVQUhi9P+/O1Mx0CIgCdtTy0XjkKENVTuvl8adxeR2VJK1GXbnQgi4GsZ6w5lHB4mec+R6m9LQQWPOL49RzFMlslsCrk/BD2iKLMlwMxQUNOAO/Ax4ocRebijdIR9pK5SA6dMl32B1cUO097IYfPn2MAiQqD55U0oNTl67ivOVj+3GuxiLj8nLiFo8AYYw/NQhWHLp9ijPNikWOhDpuUxTmiS0LfmOJPzXHbMYaAgbuDpY97Z39xf7x493g42urtbh12yXCDDBUtgyAeLBUVECLQ1Ud87PqaenaHfTS4iEYOHZonmuos3htCkMryOgQTiLeE1mz1RCHHR3r3O3l5Uxp6v0B0W7j4cxQUfzFJVUWTaiKw8Da+5rXUoS3pDPMnO3UBN9h2PTOxFI5I8N0kUI+uf7cJBXVCGpIovMd9mjMAjZ6ikSdWFe94JK1RxcYk+lyACzvtWMKElWaiIC0YChhqyuvBdyp59R26EjRoG8lhl53jLMk/O93HqbEHvmEQkxX4/9vn5I4zK+UKG3ApyjDdCMIxaG2oWN9PajHl1HEaX1DBCDLhSZcv5jSdYMUDAxt7sorUKRBB0pJpp/lkvJ/hUYGmvdtwrrGHzeiyRIDAtkcC0JEMK8U4hEfJsmNeVX6yLi0lIhLzWC619rbOcUS/3m8Lj9f0LO+xIhqdROGh5dX68//Roe+9RsLn11tbO/sHu1t5x8HB7r7u3sQVM+PBg/7B7vL2/F+xs724fb212MAWbVGNIzqLyWvB48+EGOz4wXcrgmIiOqfUXJWCcfkzR8oEUdtDexsq75+mvGpyQJYUvGjtrw+LhvskeHt4tVBrSjf2Z4QZyCehAj+Vpx8CEpox888RLajqyWSzTyJXXYI7+eOtwd+so2N473jrco0KHqQ8DmWbomDNcaeqLFEwaFyNnnJB1iip4HOPSYSGlSCDBa5W25qjA0R7b6AP4zFT1o7qSfZDLr/EbDUKSqZTjNJEXox9zpBjDCETgEyeL0G0WmVgDmInGlb9edbCx8fbS4yfHaGKpgMJRjJsqH0tfg+lINPP9S59pWoQRuGhZF7IbgzIkjXuZi4RfAQlBGoVR4djV0PT5Me5h26R4sf9ozjcyiQihTQZlE+3XHw4Wx29+sdkNZNqJgVviBU786HEDjDIdXf0tBBfiLGwZSzymKKPFM+ZSBBSSUkdX0k0TUmi0sz/9LpkurpxTBbrZoD7KdeXPJBp1y8i4U8SAa6lAe9b8YtJ2njjKq2u5SxnZaqyiJNK+8ZP1zouczDg0PNzpCLgh1I5mF5td28sKgwzenbcs8ukkUCGrdXRZ465tTUyvo6IGOJNpx8Mb2Z0+PlrfMBM727dvPH3yatPBtfh4G9XDUdTSZT7muCMQjZME4NaAyPtKAnEaM1VVrSQo6hEd1S2mSoQSPKptY38d9rFE+ipqgRJZ3Et9nUlrV7FxG7KL7DKL/OwCMSfSdw5tkF0q7BYFkiBf3DABiEpaATPeiejgZVt0mIIkFflOn9iQGrWcGRnumoVkabOxLLHF/ZbPS9ewHx4yKIoif4a5vbW1ZSoV2w0iGDpIfWt1jIisNzuaNXcgjsFjleV+yW9TFC3I0lPGpSpVVWv5+aru4ZlY0gl711oaRAKhdKbw984zuEWEhbLK3e9qLcGwgTFV1rd7xlF1dJEhPOAEb/znLF44a3BCNoiz2HFwt2gTggEL5fA6/MDw0jgb+xk8X4cZi8AZW1PAhWzKwgyRTKG8vrTA/amlaOgvUa8zRuBuT78knw7UENdxiz9tc1w3TPkMbXYD3W6KBJI0JlDunjPwizJtgUxYxCWeI+pPCrdNLMkq6BEYJn8HEZ7UEZmuKBBOpYwdjyGvn/vdpVmijjUOP/Ev2neZWlKqWvnG0yt7dRLsYl4LEMsqPMqcHW4qMqcwa7qxgf4CCRCEU6lU7TsW3caYp1ncI7ddxqqPiwweCdWkxeKO3zor5MYi+p8onPP709E3OW4HR4RDsqVHgw1GCtFE+X4m90uFXgrcQQdBjO73fL3XmxvrjgAHjxkWRUVrzWujGzw9wgoGFDwVWDWvWp4DW62BIAKeRkk58yWks3ACMUGc+6L1YYTes/uR40DMluGJ+Jc8SRKf6M0cR2AovyH8HOGCE/YpKRsklIXqk7Twd27uKnjDc3PuwIl4cTrJTnwPd2/mowzNRT9nQ63OCxRMmXu6iv8Wg29OSlK9njrwROTVk3x2TTqplCJltyS/oLMSQy+JObst71NpKpC2zmHcThMtRJ10UsNEqMUEF81CGS+kWVy0XYG++XRv+2DrMNjbOn57//DJEXz4HjZ2xhIdGoVfMrQzhNFhGPv+vp5sbm67mpoOQhgbleXMnQqYkn2CD6zBGnrRNS3eIWmSQKN83ZyhYbzfXJKKpWoSKtBit6zi6HopNWSc1KC1F+BJPVUw3D0Ua7DHNOUAFKNj5XPLJ9tdFkyO/FKLleBl1ry2Kj72QXZ9gpHNONJkL5Y58zhO3bXxWxdrmAEpFFkYlb7V1IWmhAy2dNc8boBJhLrqj6JssZ28gIRgGrdW/fePoQzf3rYaQYQwlrxVeJzb92FBAIHSzkR/pvBEIqlLCkLQ2vX7SK7Jn3DcrirHEc3EgSFEFUtjjGfSIh36x0A9Odh91Jpc2d3iICXdOOe5v0/j1Q4mKqln7U/I0EtgfDKO4EV7bMsJKEaXLfvtJ4ePnL1EOBk7/7D7zlhMmcZl1PLm/ARiXE9aiGBonY+d9YUrdDVDwOSz3DvxAU0z6EA8Wu1lYRky+evBEwK+OctHefBIjWtoN0RLqSSqr+Lnym8TZG3PFh7onRQd/AcbBAv//st/toWnWExiHcQgMmaa1Buuq/PXgr//8l8kQvH3X/7XHXmHvC/twUgAM9rY3/hl7WtjGLAbjLiqcchMMk1zu6JLHaIR6gx3I3g2RngqTXdvo3t0vHXYsQALj9W1jIOZxFCHi912JOY8h4ROKZpEVxIGLdiQPm+dZ7WCH/TFrSN/L50AhUirJImuJzkJkSTQOivQ1a+Z7SF4xk2WX2GfJwMNTZn3vEWFu5Ad6BYYC+I3HUQB0roAhWjqr3FfoHsEnMHPvP1PZy2mGMLosC11HG4brf6uytQQj/6VpfdEBp0ksg7eLlqiJIiAQX4v+/40q7u8sbwJLGBrw3P4il6R+NxMjWUJImsPpsY1yFVbYY4riDDhptQ6kqq8YVCqsa8L3okeYpzrvE8GJUYL4bBtIH72O4wNGITy1ju2Hu0Hb9YwQwy6N48QKrUcPa+1z/uP99/ZP+5avUQz+hOWqYa96oqLxQhlmjhMfJHzqD+aqnJunSeidZ6VO/3TC5BYUhlEi33NIcJCzyop+HBA47bF+KaEUaVZF0YzY4/YJsfrSNfyqWbJJJHhqLrUjZhBCcn4eo7vEnMCQRInSevohi3k7RQtik5SsRJQSKjWLukvUrVCkC/eC40IgQIPS7JLbZIFZ2jCyBdkdyjqHKM2whqqsb8yvpfDXL2xbELebZRfSVzomS8q7lAUoyAksEkrI20+ZTZUJ44JmB0GLm0l1hIsSYT/3KPqvr2gayXCU8xK7n1qT3cv0mImsoib5Co7u9NrJ1eNzI4Qi71E30UQAffHzoh+8W5yBhqaunVw1Q5Fde2hhPySvuE9zrF9pOv2BjyxrU3Qt1jW8n5BH0nxhuM0n4vRQll5rmhgANihKLt/pJkRE9ZS5b5viIurDbFC5bUpGC5xW/GIjjyGgVSOLdw56CCQKYqk1bAOds60pSIx2NZWnsVE/oaepAp9F2Bnqr0KDVD5K4HU/Pai6ouvUaUjUuQw4PC9qD/29ZKPYWw1D2hskhsheR5d6TAdBBqK2nFDRjxph+P8/Y8MJJpU9Wetg0h3MUoKhx4LMCydUy/ZdJFsH9GpEvClXQJ0GCck8cDXX+JJnnR8I4gTMjrZzfh1GTzkqBu73e2Hr3aQ3KSj29si2nXCGIGPI2deQ8Oe21P4uUBbG7x3u3vB0RZATFJGtFqsd29whmyY+95wDp7uHe8HD7swp9jBYjWn2aTGoJs9ddy/ZJkjVSbjpeOf7NydpQxx0P7OrXO/QmAOkV64M0YwBl/GzrHzvJFp18SSsfQ0Dn0lsSEyKegRnvl7NYMBCxdiTXP0a82UDJGkUFVR4vfQXY47AvbVvLYyh0inzsHN5+5jSMVDd9ofA9ONWiLJbv8Jx7Y4kkEzqePnlLMUhc3BhsavaUqWugtlF4IIOKp8o9BLGl1k2lGU9PK6zCLfroHcjZOnOxjeS5TgUKqsRpFYmMLNWzH6m4lhel5GBe0DQlmrNwtiaGG+joNpNnHJLi94VmNXt1F0a4y1OjY/krs0uo7nMsQbwryM23OMdlMXkFC0PDZuPe8nkEkY1M4YkaeiKoNr7U7FybINahNi/W1aCBSKsj9Odeg4F4HBfPfIWWVstxyhYPJYpbGvDrpgBCGk0BijjivYeliwkGbxL36iU2qUK2mc/SLLlakxAU+91U4cc5TWUIu1jqpK+94qHRVaGltyXfdw76pvgLDbxPtFYKKZOun5Ho8WnLAIWKFpz0KxO+Fe65iWJXDLO/vccNc0UsP2UuWLbLu73WC7e7jZgSeMIIZ3BZeEDBSaUC1cckCAIL1Di25dy/MOEEsi8bXmeYAXutT3Mu0N83LWVZpnqtnhDD0PmtQuxzmqHEYZAudEwnP1DQQxYE+XRx3bz0ZmdXnAQdK2ILNAkcNwQ1kN1TBaqC8yIKHQfWNSZbq/s0SBRyRAe6L1ZhC58NYuZHm+lpjlDkrFzodpS3/Dm4MbD7vbx9ubIK3R++TVVe5Phaj753jKNJ4thVKDw7k46zIxoPNe+7N+ssjG0AEJ0eRajD+fGLI48u15z1Y0QBiswxZHWMSHDQuu2vLl8Z54DbPDQyU17GwZpV1mu8eHni+chkAaE4w3fbsUdYfn5dUuxVos3gm6qlVrmXoP2stoGmHzzEg0B8Du08A1Y5FSoGeUTtacps4LLc2Kqz2M3rPvzuSwJ2ja+Uz5QjFuS2reRCXHMKGA1qb8QtnrHj+l7T9Huy//p+NtuPIKGGOFbLL4SHUEMLLX0qvvrXeDw61H20fHh+8IVgS4rO0AfG9rw2Oembj/zvB422u6TxcaQz9I4uf+ev8eR5oi4jsDb7bYX+Tg2t1On0VQTd4ZzNDq96IapK7Spk8QgU+v07MAbsi0z9HbdRDJidxZ9LxqfyzEFEm/g48sxvVBeS7ScUCZNZrdq+2Xyqx6Nxu0/HPuPdxBcLBfsDYGAAwc5lfcIWlmetlo7KhvaWWlaLun8nxf7T1+8moHqJg67rdOSN18u7v3aN/RySBEsOOW+eTe9hNzmk9mdKQQcDyOkbQCsP09T/FNIIFnJ4tlAkIIFkSuqzkeZmhDNfNPqBY3mefvXGJi6cu5u5LPXZSivE6am2X8zGF9LdbITesc1ujzxLMp2ITbXuP2KA6/YQd3OHM1UJyQTK+1Xp/JMAvXsp47qipcyNS4YZN2tG0fiwQG/CCBlNQMuv2RypYDkJ6P4z5vWIbpVkxJQlImVceAjLZMIG/o1f0xHkPsmFETkmlK5bvE2AMe7WgNqbbEtiUzbLqc+rb6u+So679vLJjJJgBgDPcUC8irtw8OoLEeb+1sPTrsHjwOunubdHfweH9vy2/Eol/IZn3fpJQkbdnBswec7h1kpT1eIahGZV4PRzSNtc8IfJUl+eBz57i260AGKCMgaPsaxv31tqe+oy27BKS6HSAQQrTqj0rfXqfVDw2IKQaDuB+1JItzZ3OMZKJxnKmpamncDkt/qBYUUyTQvDK/2WwAH8RDvuw3UYUy0iHyrRAupWxsEOBZM9af7w9BIAJP8iso6HPj6QE3vbQ4CiZ+NFUVnivLGuoq7BCOKbKW+nw/i2g/Bep2ja+mvO2/8sKBIxdFXJ4l/skgseUMXC5ZYnBx1lrua7am8FMG5q3t4dt7D7fXt7q7Qfetrb2nW0fWdieXHdB5EWV+Odt1ROOOze47SejgAseKzvR+qpBCtCE5G4xeZkTKMEORDVsteZ/j1kvlLqAaBsUUQowrzH1/SLpwnULQQgkPmxM2rmjuIVScglZj5bPpfYqy1diM4YRlqkrXY1dehnnlPsedsx7DaCGsrtLMhSfmk5GvCqQTlNQ6NJgJT4cL33PjymXCVGFmoFAeSudZ3NpRemCj3fxYsJCWsW4JnsKn8Vhv3SGAgepfyhhYmD3DhayVLF5wMChLcgV8A257ysVj148Af0Z7SGAhA2EHXSlfbb+XhQvx7FpbzAoRq4p+f3p2/2Sw5fjUtTkFKNNElb/h/8J+VoheuRjE87YO5YDjODcUZijvLJ35nOM8s19sLOs5HdGKzUboOiYBSc1Vrl66YR3gQhbj8rjP7F3bvgMB0CKDoIXSDhILdXGEMxRV7s/vLtDiErKhIZ8Il/jgd4AN4SUe3hkj8JmOc39N8ADi1uO5tThkDMMdPw5nt+0XxhYYAlX7IIMDjmOnvjzlZ5ilqMvoEoeBBiUkYWtsv8hFaCGrmUWcXUtJWchR0IVrEkE+pg7ogB7rEBO9EMMQ0pANDd3YPdV3dUE/MhYdBbSdKGr5HjuQSK99cZwQzeetg2fPFh1AGJwox6Pm7fMOTyOIAc+u45of8ZYQdd6x7xN7LTjKsxmLSqpPywDEiSoVs+d9m4oQS2J12nMtj861gzQoQ6IXKwYRwdCs72fxYG/DKHR5fEa07KIr8lHy3+4qH1OR5MaOCSF5dbq4jeTG7oQ2HsWRr8Y7kMg99LJLNqECE5Iy8zXi2xu7ThPe46/M5WDdolQsHrZG/rKm3EGu7OEcptUbCkP/PG65QaQoMcil54J0/YSS2/NG5G2cV8NQlV7PKJ4ohDa/1qFRiLeEfFzfZaJBAzSEg+uorxBvCIf4ze6JGKT/OXAebChd01ERZ/UpDr1JL215Sbq4fSHWUHnbOBZ8trtvQ25nviZh0e4aQ2HIq6jvcQxaTfOGGIsRkvoaDbQ2NAZyDVJ5xCk4p52S5Xdr+l7IIafFtO/rwg6gf0RTPL2cds3zrgRNVlKAJZpnRdv0C8+N39/fkaw8M+cGPqujnlFpm9WhA3Sj9YX65TfxCToZZpDBOweK3mqfLUSPCQh82fUCR/OFgzJKY9ffk2kzDBa6MHZP0VhbvYIHfyJi8kiF1xqdkcBQJu6ZBkb+hILDtVdZesWPbJCW0J6zY0wh3OVaa2ontObAHQq3/Iq02gBDBN2Pi+gSmUxAQhD6atoLu6zZCwJX3w01KdgOTexjhQu2G3lNi3/NnNOQmTRg/CybcwFNM+H5CTZU9odAubVYoR2p3sIlG0IINvaM2s9xFUWQBpwtlgoZY+CVP5W8aF7hVvO23fQM31rrqmlhYlJQmi2kV95YaiiEPPP5xYKDAUr7JVnrUHa3t5bGTq0kTw2XsGkBCUFhvWRdZEXGGAPPnUMsLkgeMQZuXNMtWuZuYEKlqxYjQ8ewGIHyYj+O0ECxm8H0LKU957R6rNi5PC3/05YGGBfZE8xbuOOol0Sd0vI07PU4VFaLPWg2OCGbxM3yWWNufMjxTZ3hrUPR8nV35usJI/jnMKKN/KWLw7yHJ4es5xrVlLvrjzsCY5K4P/L17i1tIgIsEmbPzl56nv8Dzx6g8gaaBVqW0r46x3TBXyOwiZgk89ZJG4cUZZWEzFgwTgiSa/QacTtTOlPSNdR1bKUoCapmp31zsMDL99BDVXDUDZaC7YOd7t7h9j4kJINKXPg+ONpVEbMiusz7I+9cyUXHPjDY0I1121LbfwMiBBtG+ZWMuQgpNEP3xI8rHu3AVJJAnl5vVM3F6KbUk+I6juoRz4T1sGcdJeI774ib3UN6cITmX0DZd41QnCaAIEln5J7xDU0Hut6RUjS3kZYOEMFmi718leJFu5y2mfjbW3imEsTz81k9ntUtI7j2sgmDCK9Nfjz+fiajBiY0g+ttikECS+nIHex9h+POb0pMK3KIVuO6bHnkOuo+QWsMK6PL+8bGFMP1lX+uUaV1lA8Bb9X0nMOICCLgVPeTuncVz8oGawlrZ/2FDy7u7h493XsUHG0e2XXpjiCFKgsn8djfvXMkkd31jjx3sf0chhmVtU69OEtjcIY2H8S++fQRxXX4kaAKfxXjqHuAbVCLZbhWjmP9c4cpRAhUt7mrjlQJA4Q4oACAACfXmz0jgVA+z32x8ghjyEIIV8XwOQN7sb+0c3TcPd6yxjLbe5vb3Y6WZVDda+leXSdS8JBBfeXLiUdvbe0dPekGG1s7O0939oHnd58cb2+t7+90H20dB0cb3eAGShavdrToIXTjuYT9fNICYKzSM46yZBHPzk5lM9mNV4421l/BBKUBwmgYDQaJt1sduPJRE+8Z33UfNRsBG1qTVBqH1VUO4RaoIcsTRe5+ta/GO3Ke4KqlNBYn1iaQX3IaB2MMvE4u2YXKGAOnjZG/6G5JoZe04sg9/sdM6yA9fmBGDQEKUd7yC0/Nqp9XZ6eviGUi7jXX2MfBFIa48nUeR1vd49eo8oIbVoB9Si5B0D01NlBz9KSO+nUZXWtph0kaavfwqDttpbxBCPz/I+7dluRIjgPR9/qKMpkdiTOHVUBfcJO12qrRaAA9ABoQugFw5kUWmRlVFZ2XSGRkVnX1EzlDihQp7WrPitLuUhdKOxLJ1UpLanUZ3s3O7L4PnzTzNrYYzHBs9RHH3eOSkVlZDbRIO2sGdEV4uEdExtXDw8Odx21/iLzW9MNkg5d4ulq4kF1dxaxoVEOVeUwU6QceEmg/m/BM8OLdv2F9oBd4n8eUbqAeURl6MyIavN/SWLO+gDXJzLvX1jORQE/wTKHVtfEOg/AMxbzp8uBw78lg79HD+zce7j/eqwcjjUXD1SvPvYxWkfZlp4efM/U6sciL5iuBsyRgiK3Jxv6Kgosdjhs4ndKTKoZn5qgi0SiZgdTXUIP+4c2HPSDVWaC5PuChWdNV46EG/6/P//5d1jp13sa8fNbfjQSblc24yJuXRIcE8i8kCMmiz5sivEOE7OKZzDh/6N8aPhgiiWngKU9a4rjDqfaK3qmb0/wKlHRRDiYvwX4p18mYkc0xhqo2+fyVMhmDbShlQ/DT8cCIUCxy7lv0vNqlXausBV8M1BdTl3Fh7nAEa7EsSXUsmuzjdS6OMZffFNOqf4cfC088pPEt6fxsG1zK2vPEQOkL62lW7l4/dKu//RBC0zQiic/HngCBocxaCsaHCGkMTABY3EnCX2De1yAZgnKlNg6maaRYNC2wrB4Zxqg0/GarHzdiqkFr3ZGjekFz5CPXGC8sds5f5s0PIWqSZOmt90pPzYSrqchZdsPikvaf3TyR6o6C7e/ezsMj2O8QxdCL5Jznn9S+alFZOG6abMRV8t3vlO9+x0n6gL872L3ZQ1RNI8OQF02R5lKnE45FF20jje0zj3QvjbF1GzbSzR3nuCSmUuuYYevDVxhURzdnxdmvQSySoZhWTenBIUBIOc2NLwAY3IS9QMZHKBa5al5xrSKo6gsuJb2RQ+/nXtSHVhdO4f1v8wSLkMYIBYDBXZzn3k4Za3MqZ86mwDVsKdoADgGKB/InsFHQU0QtSBSytqThGjJnNqPGVXnjbrL2jKtqGekV2h0uW0O3LaFzy1duTj6ItFdU3QOfubWzf3gTZouTqCrfVPhLNbQ1Ea6KpKXE03raruwhsmQRa52Nj3ZuwOGqOM24IH8ieqlBREuSJ227y4caaOuhYxa9aPU5QvYzZM8eFGJGFzS28e3LBRLONybX6mOlRa0Ja8e6Xv2QnUK40QevXxN3XKW6XEyeYftcX0XCqgh7RKFFb/j+eAFNrRKr0IUWHNhaUrtDC1ZoWQ2W2Z5DtGRFU8sPTiGNXUUjOGTe3LO8YY2JBq3gLPUcFkG/0eFLw+v+wqihqCLP/GbXe3ON4rAXTYnRqjMGYhqaphGbi8vKK7XBGlU56axtksfypP9A5lUiGhZVYLhWVk6rqjxPmlffHQJFi1WTLF6CYOHQ/VuVi106OAbJEBTjlzIkgYiWYuL81awyfmKQDMFpFYumrOTw0RuP7ux3GFPSyJpOK1O/WMlaIxoaoVRTqnhIoF2Zjd1q2CM0TbCIvAedRqRgH6ajav+hrMopyg+xIZra/fbqmmwGsUDAOayn8zNZ6/PI2XufRiKCsr7o1OPviAVo3mzZR29pLjxLBud+0byHONIwUom9hVbYSPupp1ENlccS0xx9wcKPBIYS9h7ZMhqViIAFrPVEoa4pUhhiGBMtT9ZHGrZk/QebRuMb0pLRzZxqLthHANfvRxWNhBrPkRVNQZYVvt0VDC36lYvapyC5jxZ6D6UbLOhaus6d6E3VVBKfhmO++DjWOcz6FSq6sKWWUnpHAXS11GloXGMaopOzZxQgWERx9oVEafWfyrBl7vVfeVotjQunMoqbuopHN+40uCtA0IhucV91pCvtul7yxhSHpqr3C0xzSOZ4fqYfqBrPkKEJj7hptu7IAN2c+ozhEup7SUNn8sgyoc7eFjSOQZ+xlic4hLRc3nTcTyKhzmEandd/S2k0j8spZ2XjPNI1OjRSTVDwJi/nb+0GQSMLxpqKY0e49+KFYc3h4FK4k2UVTqwWh2rdiGE+JsOwtiFjVSkbpWsEg8yziJ39lFXjGPTxmDVOAzgJDdC38WYQDVGuzlaJLq2guxSeRnH3yk4oFlkmzWUor7K4RMeAkEDXeISjsY9TdnLyclZuNK4he2kaQxCfo5y4LidlSXKuHSC1rrdL6fkzWtHA0nozKmW8kE3rER0yK8Iy+DJRTeWF5cylUVUpGw6toB7Do/sP6sdVpRGEIWPbskp+RKB6Art6FK7eaioC1jR6dnT/8Pb+9Z3mYqkRDVHJWsaFjhC0l/GCRCWHez3CMdiVtxF2+swkFIM8f4GSE2IY1AWU8ZJWLzWypVMvKsIYCkM78rzlWpcc6SzdnxCmo2mqDS7z/gbH4ovshfasLJYlmdXWnfSJarmEmbHspINokC7jyfm8qjVIvcx4g7Pp1oxzmE26ZVueL8ygacGzRMW0lgL3GdcKhG4IZy9Rpn4FXFYBbzIPd9Gmbv1U0B5diagydnPLSjSvq48e7aO4AOAmPePqXDIMojC0alqdS1hIFJp2ppqywqP+YzjTHNx4uHOvv//63sGtnYf9/q/2D+8f1O8lgYiIq4bg4aXsZFROAFEFLaNrj64fYotU5mIbpaS14RvszUvGS9OjjBjfA2MUYrWv+bpUystma4zhnD3RazxDJpv3VTVzVxl7ypVsuTx+dP3A+DHcO0J1i51hrzJbY5W3TGI+enBorLj3bxRYsumpymzTM2a/70w1Q4dmiDJ2PmO2SGFJyUtq85YMd9XHNoU2yZqOgJqWT5hqPrfE2+XHCLb4GDbImfeoadWFg8UyJIXAo0fzKvAxQA/pQGJK0EiWQgnk8KrGs2QYr2USBfJEcy4+liE72/bgzBzqZoIdv0h3WeMYdKfktkJhiDAM7qR5bf14f+/gYAfG1eGjh+SelJQgUIXiiaB75Mf+t94qqjznkJ/5IBH7txnElT/WsIc46Pq7RUWvHT9znRdpFbFXtOBH05kskoS9aBQSjkHPmnqby7imi0T+UobFZ0YbcSaAn8ia9gUea1jXs02NbihV68XOY4TUlhu1m3tHqJglEy8yz6lxDPqMtfWiugSbiGcJZEukAKhjfCzM8Om3EonWBUBETZEwhh4xPN1vMph94+BwCHvU7HTeq1E0hYxi9lLqlYRpaJJYzfGNbWsg1nDNLtcnIzMDHYLNp/5AZINR0IggZwsCp+zEmM0LZMImPaIx1GXL6eU9meHTranHcFG9zY0jBJqcEy4RLKm4e0Wnb5w0niWRL1mGrdTCyZNXrlyEo9ErnsiXfNescYlsznwrYZe0zZcUZXT9R3d6lGrxWreOK9UFDaolK9veEDwVPp1sML0GJXnsGzISLIRCvFMSYjn0iTwfY2JoDH3ZUOLuOCfX4lIK8ZYge+Uls8HWlJyVvl8Vks6ex+2kycDPrM1Rk4jvX5Gnz17PeRCytHniwJuGJxruPo2ilqJtrPsQBn85uCUD3PufUPrhTo8QLUl9n798nWeSDWrkH2a0NGDFamJwDZ3wfHbTJFjSRiAci+13zfo1qDVA9IqD+pvus81jjPlUKvjfFNI/mcpfU/i3PhkbPE3je5W8iA/kSaoxJDixBnPrUHIuYtE8jnULRBHPECTABKdTkSRNPc0nOqF/G1N81xD1N9WkJquWVfebolAdCntzYeuaRbJtoaxb38GgWjJ+9p49tyZWIJD5B74zRS8GWROmvGklzLbGPVmgTYw92LTYTBbNh8+mdKMPM5e4Mqk4qeZtRdMnOql/R6eRfYIGuslARkpEvKmn8cRCja/GyptbJslQF/FLbaXOKB0G1AsWNESxyEl0tsN3QjHI86Ye4ouWWaOGNC9bjyP1IwQyX2kuDpQv/bTk5gJ3Xo7PPkQBAiGeII/9EsMQ8TQBLzwK2ms+R6AbB7d3KWvC0LjkTnXR7EUYfPiUEpcIJx/f15Uy+JpWTCuWvby2l8Y3pC1N/j10z4LqgZyhqRw7aE7MbDzJBoAVbIYbUVPoaA8usDgXSe/Dt7/+4V/+zodv/21NFMbrfC04l3Dg2Zs/fPbWj5699dd1Lk+fztc3Wk6obsHHTE6nsEm//ggX1lWqhHUDfPTjbzz/3vdcrhsXi+LKojlM905CNH6flWZ9sg3x8Ze+8vwPv1iTBqq8eDFt3oPssUmCnhgKceothJ9+47eff+MvaspIbW5u+IYO8D4VzXkao5ZWUNG//3rv+R9/+5Mf/LSmzU+qq/FZHfDJm9//5I+/5gg218P16Bo7s8ve+eKH7/zNh+/8eU106enaWtjkJQ2vdIuTBWm843v+pb9+/nt/VRNNgqJ2oIfzeLN/mycCFiD4OH0t+MGXPvjKB2++/31HdOnS0/nmekNCeNWISfw9BRiOAwZrrm9d2t0NQkX+6yff+Kqf58mlqLlQ6SxraZqRvfjjxVw0fmb34GB/9xXM9Pm/fafOVFUbm8fXNi4HEzX5xXVvP/2r//jxN3/06Zd/9+Ov/U5dRnmaXpo0eUCjZPTEMDY4TOjy0Gb0yY//3Sd//R9dFpfHxenV9clLujH85Fs/fP7Vb9fET59eu3o52DhJmqP6aBFKnFAFZ+QS2c2Ir/y7T77yvY9+/E2Xw9WLLDqZxur/P4PG7/3ovZ/87Avv/eC9v3vvu341nvIwKtaY/zZ/rf8AHZ+PRYhjcheOKoJGJzk7qEVwIqgUqRyyhIKfebC7e/gK/BhicjWodcmw+hqzKcWDav3gve/+7M33fvLeD997570f+BVTfBo50wLaVvzu/Yd7DbPhQPdjoPzue99/78cN2vnkBZTQFED1szdrqsVFdvHyRuu5979WEPnzL/zBp5//Ty7za1HwdP3MteWfv/XPX//nP6kJeHlpvWqutw/3D/f6j/d3j+7XK1/v4z/6x+ff9QqKC1hsX6QE/Pwnf/v892qiYHN++eKlMS+ic188f/xPf/7pd/7rp39QL4lBGK8F19ilqOCb4fn2sre+/uzNP3/21lvP3vynZ29969lb/91lGq6x2aS5UK20MwjD/PPveaTrQXFlcvZO/O0P//LLH779ZzUN7MTBxnl34u8/e+srz978Cz+XeXjCrfuda2aSvzCfv3j21p8+e/Mnje+fbAZxwzPple6Hfv/y5o/+5Yv1th2eFpevbQZNGa1bR27IsjZgrncR1RzGz7/+2x9/88t+dupiebZqF5A8/8Ef+STVetS5Q+48rVghKuWX9vzP/o0jjdZYeLwR+LT6yVprmaz3KFEK8qag0GsmKV6RoMR+MPrPfO/v3/sHWG/q/YqH8Swqw2vns7fx7K0/efbm29BF0Od1VuPZ4upV37c77kvnZr0+/sPvfvpHP3S5jsNyc/3a+dSfnv/tP376DS+LacDFWVPgg7eA3fhSjS+erq9fDS9N1cvxXh+98zcf/+GXYYf1c7i8GfiScliDYSPa9bTA7WoE1B/9tF5AxsdPr6xfbFngXRpl3/7eR9///ZommW9cWuPezdvG2gv0o3/+3X/6+G9+t87hdKGuRpevVbNJ+pIWAqGFf/7tr37yja988q36wyfrJyebV8OmGscBI5EaMnmf+Rycfnn2ihu1pudM/6MN6Z///d988jvfqXMM42JjfHF8ztXovz176zvP3vyrZ2/VbNMk3mDlGj/XCRJ2sZ//XV2Z6Ul5dW3Td5gDY8nM5yNWFd5shuHgLwRiLbgcrLHLbJ2/5EL+4dvvfPj2mx++/Ucfvv1NWKPrnNLi0tpG61B2jkXt07d///m/qQe7kBfZFfGLcsHIXv7wj12mx2uMj8+accD3/OS9H9X4ydPNqxezdevd/iU3i49+8J9w+/18zdMfz4q1q9fScw2WT//znz3/919wWcRhcXLlSrR2ssle0iTkp//5T59/5Tsf/0N9xonzqtwQTdGPPW8/3D86Ghz4fOyiwbLalbD38e987eM/rnnCdBKwDbbBj8umdGynYGkol0z09t7/1vvfe/9bcJKCxa2RyZXwYhBkHpd0hpVmyOCL73//gy9+8FUI/XYjGxaHV6LZuHnG2UsFmnRQHfaym+bwrY4IU/29EoZowspXsMr/5f1/oDIh1CgtWA8ibz3F9a3N077/baD6O/xsnzJkV6LTqOUzeCeo+jemLBDOrY05RzTGd8GxRt/+4CvvvwN/v+rnKjZ5yE/y/1PHhg/egor9NWxa0DEfvOVXrNyIptEv5cnjB1/+4Es0gmoGKAXettrcDM+a2f/7a//0Lz/8+5rkpHy6ljbFZzD4B7t0GeAboPr4D376/Af1EpJB111ip5F/kNloHZvN5X5tubBc6C/ovf/30GNvvf8dPzt+jV9kTcXQO9WcidLYeEOBTKWgz6Gv3/8vXrMCbXHSvNK7y9mkIpVzmH6BVkBXvfe/Dw1Wr9TZ09mV8Uuu9jDTP/7TLzZJ1cWLPGUvmcEnP/ziJz/8cjubxdPF+uX2BW2Jj2/7R/ho2PGeX/jL5z/4J0cny+rKtcuRfcekpc2vCdzFX69QFRtVjJhoMnANYwm9j7/2jZ9/4T+4HHPgZsdNyygPK5UBM1BvmT/7/M/e+lm9EOdKLdars8ba87e/9fHXaqHX02vHi2Az9IUSV17ABj37wk+fvfnvn32hXmyehjFby9PwPMzUsze/i2JI/FtLIovp09m1y5OmOk1X23/0zh9+8vWaFSvkrLp69Xynr4+/8c4n3/s9lwWwaZcubU6a5547qJ5TTzfkT/7k846kvHwxuHSZvWBm/+8v1mOkhPMdPwv/2ZtwPKhlPqV4unnt5Oni+P/Uovn87e989M4PP/56LQWrsirfXDRFJW1W++N33vn4K//Wkcx4kTqzrrwYhGXwi5uAnTVRzb3NUnGQxSCf//LLs3pAVOD0aTU7+6r/ox994aN3avHJrHp69fJa0HKxZpgdZAMGAvZVx0R2i9t/+ucff+G/uSznm8XVSzy5Op5Wl6KsYE0HGXd4ATPAmuJCUyFuFfsPv/Ppl38XVoVPv/T/+Mw35Kc2L7aMCrwoG0d9Mn16aX0taK7D5z/XPv/+Tz96p97fTsfFmn9G/FdJtHHP/Ko5Oy5Om0o7vmI+JBLSgoXT8gxXbDrdoE6lbKpQ3WflVK83lGjQUnZSnc+WtqEx9FlUW9TQyteva5gxD34dWWyNpilkJAPmXDpaNvj1+zfuX985vL3f3925t0dexYafpQOlIzDkE9a28tl5n4mIhiKWU5ay5u7VodtuEQ1VdS6ZxcKogyzQMmfAW66Zz9x+DIkmr85xzbcwnXDK8lyqcxld0CSGumhxGFbbnswy3hCKv/s9CZPipBSJtYSDb3WP9j6no6/0MA+dGZrZO89gQgJNKc5lo+7UKNidOlOpqy6rT61V1NOK1wYX3amQZVjCG+/+iNI+s6vjwBm+USEEvk2T9SiP3/iN39g7uNHf3905OOjfuH9vZ//gEGAm6frerf2D/oOH+4/xXXMz+TMHsuS/jk+AgG1GcwHA+bIkh1MM14+UZIH3scGiHxpWDLmrV6jUtVvX8VOg5tOyzNWvX7gwn8+Ha5NgWLELiHBYBfS2m7z4WuytTIYjRIIMAXG7F4bw04PlHH8SGEGV7rTLa2uhpHtimz1A8kIe87AcymJyoQfxodEm22GFRO+YBRPq1/q3RJkAA41uRlRNDqAhQ7yE8xSKH56ypVr6+fS3RKl+K+WjFtF2j9whyJOhse/OQhg5SvortNHvzRM4/dQ1MIj46csNVOfC0v6WidFxlxchVKKm3e69Ooz4LIwyD6ibIUQr7HcZqYfXpQJwKORSibvwlZP+a7CPizDub0XaesrI4mMxMhs4cl0AyoJhfxNJs9u1rX18TpEMA3G6/Hn3xzCO7sCRHA2rbsE0L1OGntFGPiV9XCsrXS6strw10hjCuttyH01CS5qlWwH9jhz2Nh3L76JPmKNC8ESOx/2t0oQ8tB4FGU/DRFYRNTE1+3AZPk1OhglaRG8m4UtgTCJ3NxDYGDrHN/p7jIQGGuZBwkrc6r1PjGxi/aFL3/ka9D5HLbibLFrwbOsY4+nSRwg5IG8HIVXJgIoqQ/M4BKIqTeAI3Ff5sH/al0Ppj1sYGTxjwzxZqsCD4pSnC5WwOXwCnzOcMwOGbMXIEW33As5zpKZiRBGTDeVGVxKwuy9vkVXdXSlj9IO+Zc2NjWoa+EoKw+5BZum86IBVLciQV6YeM555kwSjMMqXy99DdjcjR+VJyjLVqIImwgpAqB4QVEAMu7ZofygBuz/UEOj5rx8Uq2o8FicJ7DCjmhJKi1mUKd2ZGlwHsacnsDd7IB5NeCvagTWNGpEODO2xagnQgXnKo2asgYMViPnCDy+lqwq45masgUPrbhLIEhY/ZhoZu9GClluXdtCdWcHQFfAW04GRI4BRCkvCYmjsOu4kKPY8tFZ07tKNoysE0gbWeE7nxMTnKP3Xhv0jQU7NY9nfQuH5sLTx0XImuN7iLlvBhxsj8q8OCW3ou2dlSTJAkfFQv/BxA9gDL1XnCcrcafkt5ZjDojiPRz7Bdi9mSrsErMcvqvzNUFmiNYQdHHfh5U0F+COJ1oIzBlMF32L/FqDiylrTiRLGcDMb/WVztlARK1l71riEzq/bXaAR6C297DRxsRwXdysdMH+87luvDTEByJZKMDJBOi/ZjdIUZ0i2e+lCh/1SZNb+EgKumP9PDoEPNeYWt3KVDLAY1N/khRrVlNuGUnHjOYQeD2DqBQVQEQlGj8pRBbYPSx5nRR/X+qT/K6GERaqKuPqVPswlv5q7uHbdBD6n7Pj4jM1gQ70H+cEggs+neNqu0kM+xscSIfCTlzbXNjcvr10ejKPNa4PNzY1Lg6uX4M/atfAyu3xxLYiCjR4tl2Msst1me7vrS7W4W8WcTCupF7aNV5HNcOPqmF1ZG1y6ysLB5pXLVwfXrvFLg8tXr4VrVy5f4Zc2rnjTTmfE5sps9wY+WFuV0oQPw6xXqQEHxmaJxPu8w41f0tdFFy9fDaJrVwbjiwya+erG1QG7HMEnXtoMLq+v8/Em4z21AdUakC/adqWwvpAcVSyBeRjGQ5ZrxM4vWI25voz5gvSB0YZ/YT6NEhVaYemq1xlpfkkr6ZdLeakWqDHPrHnIBngJVrCkK8czU13tz86jLotXZ+GdmerKOjuPRlno3fjlkNY7a7Miya/KKurlIjZWF7Hx4iI6qJdoXjA/Bi8a9WeN0xeMvMELxtvg7HF0ds+v7snBGZ10RuPyE7pS6yxsLIAvh2VyImcrS1VssKod7AK73pl0VqaQ/KKk1UPxhX3v4Z3Zj514Z5R7Rt9YlDNay6Ks2paaKC8qaHUzedNxdW08pNX16UDqKO6MUWAn9ioU2omB1yKW51p7K/718/Fh3m68HlyOxuwaG2ywK+PB5sblK4NgnaPBgvU1fuVKeDG6dK03G0MlqOAhG69YDOAD0IHLAFny8sXofpZ5d6uuyHEFdjPD1YN+Za6rSVZlvX7+rNdfMuuN82e9cWbW5+q0/KU6bfWCcXa2L9HGq9eYl8j6jDY+Y7vpzvgMAi/bM/ap7mzPIGhm28kBr860G72Z5XlGwkr0ZpadK/DKHDuxOzJcP1eG6y/OcONcGZ4xn1J+rlZcie5luWr/6c5xFbaX4aq9qjvDVdgdGa6fK8P1MzM8x8BZhd2R4fq5Mly10+4l6JkxRCsdmSIzkr+c8y9j6xfXN65twmbLLsL59xLuuJuXBlej4OLmpc1raxtXeM87/PJg+fxrk02LdKFwXf3A1l5LlJvb3Itx1l8CZ2Mljh31Z6a/oC7+ut6J01iiOzEaq+0qDNuUZyWvn528oiHqydqZXE+9M5PXVyZ7p4dVKC+TvKIAunCF+fCKmxB38Qr5OkvQGWw2+SXNirV1thFdWr86WAtQ5rW5AWfHjUvX4M+1S1cvXxmPebTeexWq2DHUO8BuFluT42GI1r0Y+ipdEhXim3V8c6gUqjIW5xJmXVu7fOXStWjALl4NBpvrm2uD4NLG5cFmFFy9emXj6ga7zHpQpwlVg9W1cJXkeGvfrtLrFcfr9El/C5MH0SJjqQgHUXYmK395k435lYuD8eV1aMLw4vrgKguuQYXWr65B86Fws4f5DWWW4IN+yhvvJ7iFYI5O3Gokf0YIWGVNoTPE9YV2u+50FaTxt0JelCOHu90rN5KN/GKhZafosGXoEnUxuZjwRjkEWCF61sju1KPvoOp7Lke63TNhex+c59MqapSCgE4x/U7CT8igDpSTiBnL6B4GgKOaaLunhIDDmzfy8txayW8I0g24uyRLs/qDamr8JB2bSgUZmNVSgyrFC+jFEtY8v0ZVJPuPbvU/g9pvVTZRAVfhtHj37SwuX1muKGC/oEFuy3AasCp1lxiOiGqHYSciz0sRJMvNQdDu3j2aypSp/v1Cnoayv1VSdOSRbPfwnr+O64IO9/YP+NJNreJoPWfFMILE/uHenf1bj3Zv7+OVl0hGHsV2D9vT5QGj9TjvTWbDWdiLhvqXSib1+1C8+9/RMqCYSIUqPwlrOuT8lb1KxbAQAAL7lfpyDiawrFCPDbhPtVzF2zxEj8xo1gevclJWhCwbccqqJqUZRnVFS98LzMrUrDZmm0uc7oTrtZEHvdCxnmcM1sRjhhamBS6HHvp2LxepQE+6NIdzKWYFrE467LB6as55mfM8N3HdVY8O0TInOURxLaG7q1J2iWzX5rHI6G3GkeK4PM50tMTYyKOjmy0vGyqwhA1MCeZfbzEL6yzsEObfYboop/2tiM8SKfNRg2C7F0bZEN3cDx18gNoWbtwj75KhMeCXmXnMYVMGHRsrTIiHSAs77FaC0VGTZhsNZWYqYbgk1HobVSlDGfFmUQTqVtBgoQz6d2FthV6H4MjHhrltY/ClOv/H9/ysZymkdFxlR/gaHjVEFWTMhnMMjDQ2dtcYFrnTuuUeH6I0zZhmw6cYfgla0tahHfQaU6iZcruaSKw8RkY1Oi4ZNuYKetLfiWZCycJYDKBVvL5oheHpF61R5x5qRx1Qlf8O6l9EC09DaZkUL8/ZHAdMyDCoMYbGLM/OG8M80botQ9izvUqcdmm13ClOF+q0lGM0hoPul7ZiCxnOCTKCNRSvDrZ7TkNrgK6wrJZLMKBrbHL21lxAdUpXg9+HTREdCVzn2Wl/S+rYMIDYyBCF0+1eTU/lQPvnwD629wMH7ypoJ4MtIIMFAM4DeEnPdHzkE6E6hInBBmSLu46LJFsqC4FdBZEVIGB+sgnww6VELpYgbDIZOSosCYM421EF0y0yj2pro9AT11l2zGjiesVXGWxZVg3Bev9CZX1rkR8pD4scVvc+85SdZ7yvrVIMOzU3ZH+f2BOg34pRARtWxKkscTsbFmq7V6ihMdkMtTplQouSO0ZvJtFGWbnAVkaFdfhqxHeKQjhqNUgfpXGzH/o40KivUmcuYM9Q7SSqAezTn/VXfmyXANZy3FuXmeEF6x/s3No53HmyY5WMBlrLaEBqRoYSO0XBAA97cozvKFiC4aBa0CCHnMeo+ywzcuaC8SkwMmEBizKslhCN2ZwJYZFVhX4poZcxgrqmFg6/2r0jrSFUpPM/d52jPZvrEj6c9ljvhbr3oYQUWKSuRQzw7xQiQv2XreNYh0YtOlRRqwG2ZTk6pcBHk0vbB5/1D3hcQObAwiaZCUKuE8voQK9RBDrUZFay/WznujxZno5RgTspsH74C5ngWYkF8kTn1IzrzCBeLO7W097ORILTDO5aTQ9YCd3Uv/9rh1VCDHh/KyPQKGVpKsspnQIZLGpBVgyNa8brogwqtMBfFxVYUGeTw+4Ea1lRJoIH8FFSh9q7fZ2HbW040sXAA0dLg5m+zKZ2ftgDViX93ULOIzpyQmwY6tioQQjF2ihyG3bfuo6bPKlg+22pgavYB45mRdHGIjIPfBRQBPWDqxi2xvHwmJus4fgJTcg/21KVDiAhoIv9rm1PqmnFaH9X5kgwBK4MmGZeCFiLfGL8JhOzLSllmvirZECAzpKORACr5W1txhJOBhQY1QSQuw67toI9g6wKNnqHgJ0F7BWwiB5M4AiO/7ayScxiNqoJsABHrAs4GQPn3ag+QbqPUCc8gZVBixegqSA68vAxdx2x+yXsaCGP2vXXUPzIZab9noAdK2EzqNkpbgjuEOlRwbEVDW/wK7BF9ywc62vDwMVZDAh6lA7sGrjgWUS+ntz3awjsPMvylkqh+xkWSbdfRQgaFjVoVNNv04o79DLUJco5HHW0n3fc/jrEOviSlxyybgUaW7t9J51GIUdPK9gjYNbqJu/A0AUtSm1C4DYctLX5y0YvmGQ9i1ZMclioYFuxk1zHRk1KOLnFF2EthAMlFBxN2xkDm9yCYFm7DCat0rrzy68aQjY+7mZRSa9USCDE8ySwUgkTI4cOxxpHSRIhBisvsLGVWirAJnQfraFfQiB6IPPcCgmaJMj3h5HVbt5lGTqcKRee5ioWFSK8u4TXJDrhg55M8LnYsgKyI8VvguBAq1tnjZj5zF1WFM1iId6lWLnzGnzN8cimb/eiohiysFfNqyETPUcX6p/japjq/XRXS6EPRNhcto10OhM0GC/QW+AL5tywVHphH+9sTRgcf4c4cqFdG1nAIU0L1QK9RoX6fIs7Jcxk/FG8Z05/XCubTwKt+l/Rz3FufjRhyvU+XlRGoq1/tN5oZeTI+kylO7Z3yobBKf61rXsg+4kEnrro4/MAZrVgXYt81rznQQAqgqKb3QRwOPDAMkd0YNYuwLpf8FTONDWsaQU5NMu1UHW5sW5hE9Fikb2gvXRDad+hvVjHMi0CexrqD1zUooQxWr2mgyKcGuGwaJ2FNgeu7aohc/i/QA3rPDATceP+UW3qwvoGawwrPYKGk+IXK3hS73POMHBdkBmmQ6Nj/68uxdDD8Xho7Gc+OvRsDN31ioQh1sXDvXRRhl4Lu6kT6+eLzVXUdWAof7E2DOvnTg+BXXfHvlZxkATHJQUcJhwvkwu/wGCplJdRLzv1Y7StPTw0juD9PVulCFrxpOVly8Z3xHLIUhMYm01kmLDWqgeQC73Q8ux4V5BO2qJJD7pUo+tDYFWB3VXhNBVR2d+a1g+0PDpofS8TWoeBE0Jcan6/MAPH13zLe1msFil6TSAZrHFEOXIkKBuml4En9i3grpjBtnQK5DeECtGrEad3/WSeEU+Ed0TZeW6ILHq3QPYhcA8smOJr6EDAWPpVtK07ZbARms5BD3WjRiawRbmo9qpax50/u92Ek0cIPKi3N3pKGjgRwHKlfrNC2QS+NsA7C3wu2aaBpiEI7bmGuYIiyaZyqyzgcVC2uPRkQsIu3z8E9qEU0APaXs3I4VMBRUynVwrpqy2MvjpMwoTypJBO0FFVTupQnaBrJ8IYWrvJ9YUILQja3RSP0qrs36pSnggSoHgWOQfLDEozN/yGGmDZa+qSy5eXGoqg3ZW4M2XAcR6yY5iD/a0YYyOPAMqBQMp7FjZXLuhe+VKxO/RaE6vSVTxzqRc6eN85jMkHcLCP7YhoUGz3jvFBqHt/Ns8bUR8bZ1hvXHBOOpIUa2WmlxCEwRQrtdO6pdqGOmnFhZOE2aTQLnmMj43K+ahNs93zIQn36mlgKAlz7CTCYbh3TvHQJK7oPB4xtO2DzltFjLcv7vzmE9LbKxt3Zvqp3HEC57PVJVNyd9k1+apHfc0coFHGA5GPVd0YlFbDYP9skvTojTNNs2KdfvCdGBy+vJknZ4uiMekQ0CmuZcAvhnH/QPBEYcflGjByJNu9ecZPaqlW2Hx919jjcUOS9jndroS1dVf61ZADSM66dmZ0V1uSL56kwmfYEwKMLAW9Sy4ZDg/Nvg0cH6+GRf1y1fRSnTwMTzsPVrjYHvIU9lgcHfg7ahBpnkM/RN69cXDlivf0zg6FKNPgLiFw/06RA2O5dQwH8xiDI4cOWRta3FApNFAqoWlYmBQKKx8PMcxjTEOCY7q++9f7zg30Ola2GFkaPubtaMd9dKzMhTQsmyUKN91ik5mFxkaYEsxF8Jm2DYdJFdSR0AXhZGqDwJXWwbF0EWoDE86LGp7PXdBcxO4ePHjdH0xZvhhOoqzjdD7hiezfSph2uAEHdASMEjZlIcwT802WXA/VCF16TPp82H88bIxYndDJQN1DT6ynOJpQnoMc1ESUoxR404Iu5Ryxu0rcldhBZIiEhjX9NXu5TAOetF94UvfVKauu+Z/IilwPmHv+4VzHR4aUeBY99OCAMNWSmGHAeyYIXEaFbB/WSNdl2R8y3rCQ3dJH9Jb+cweDwYO1nX2/rcIyiYZFdYFn9lijX5Gr3wJgQR57eNQx9zk0Ltc/gs9UjGfzajKy+aF1APjp8ajCH1gZ8EdkJf6keD1T9UquKEq1v3+4f+++fw/nGlKJVHbdrj7kGderQoh3w0VqgiNHAizgIqMccERCFlqpCA0mqYEFZcDWRmh2CWPCWL20qclADiYDMchqalJJ0tMB3czhok+TNM5OBuZlLokL6R3/oLVb7xZskeHBv2sBDHXiCpUN9I90p2DHmYhJG0fBEkWxkUcHJxHOktQtgsUiL2UmU+Ff6IY1tJuLoXvreX+X4V1ux91TKwPak5sgXXqVhyz2JcShhnQ+339N6juO/kPY3DOa2FvHBjaqCWGG2rBZYapihpoGfinFbJCwAHuwcx/BQ90TwRVdNen4yCfCMopZvTOjTkUKuPd39/uD/v2ChehbcJH5W3WF91OYQN2Ht861VYaFfkgM46bLagKfSNgRbxTYVO6MZWi2tRgJTb8cTWGLxX+o5BQBcSn7LFESAKS71g9NNQcyJPMD/ajiiDQXSRTirWRfpDl0pSiThSHClbaccidUgu/nCfZmKyvcyDHUBc67wWoZTIzIQmZdt+0IH4bTC10vukWGGpgVRxdSE/2wORkZAugqDMAhKdYhxxPeYIR8GApUFdSGXjxLGZSqdGL3jDuMF8C9odEOfFidIM9BkNES7XZvnM1jXfQYDmzSmAHQkNyYDLnouLEbDPpBs6q+8Q6CdsuuX+vfP4CJTTLYGg/WNx2GQ00iQ5bQqbyG6bLKUnYfuTGl+9O1gdsclbBiOM9s5dORw8ZCIUjlUWUpWvCELeooirRIiLpwdD4ZrhA2ya4W0F8q9s5crpYG3LVMsUygcgTMxq1Ie60YmV/kbKMYry9P4bdHsd5YFCn+wpTDH30nHulrBWi1PEMNHyGbfWKhHeUHKH+QSVT1ofo7eX6ACkf6BGGs38LpxcuC9iPaWWqYKxwYL9jApq3CDfRCpwavQA9rRcRiZABF+DQWSTKapM5Az6tDLwu86T49bUB0sxfx0vCAo1icMH08777fuS4mEzykyVyNfGxo8Uok5VyU00jf26R2GLIbvOSh9m/VLKxkESWtuLehzeg6y2D+4z0uC0xwpEKOdl30oOS45TezEpn2INKG2wrNRbE0C83MoLSus9dDlDsVUf+uoBMqbMS4WtcE29oOCulb2SkI/OMl/4NhCFzqNpDBYL1EuwoJLCIl8KahBozML9dw88UuGyqEB001P/oQAnaL1B5w5BAfsGRckCsnl8XhQtG9lRnJkWLLIpw6Y2x5Crt5zDO8ZCOLyo3ljWcrVpu7FZxNd1EBhMF25Us0LQ0WkknakDHgDMzYHTrih3u7jbKgazrnrP5qzYHrozPXBWkCLAi3XjMvYcSydouWbKg65uMOm5H0aYqGeacMhikBRoYAM4YA7gAUsDW/IdAtY32Z4n0DpgyVTVleAEQkYIEGtnfOMqPC26LBFaAA7jNdDEWEq0ErS10BFcLBod1ZGrpCQ3SKpstQTTuxQtciMmIgI3UFboMttCjExvlpPVbhPKWvk+jc6wrFA6rxGNkxNRIni0cte4qOfJLt3rE8Fc4SGRRCXFir/zJFGoFdnOGBLAIUaOxUZD5Ft6jD3+7VYV2ALHeKJcNRkSzRKN0KJr6EqQX9RVb1cR1h0E502rUJo5p+WE5ReRMfKUBQawTYRRs4/iMea++u7QpAWsnjFRs7rt03qUg4B408XLzRZYuIZAG2m+Aske4arUmvFKwJalx3iITuyAylM3fwIwKGSnkYH8Y6PkJ12NTqYZpnBJAZK4zVMlcqKh60+AAEE3/T3bJ6l7jJCmTUtxhFh2OKjhq0+KEmiuK3+mNlINtFAqi7ONgFYBOQ0J6RSHC2F7kOjRwR3vO7iCmiijxf5K1tUJe46q7hDtejX+DmAu3KafQX9pqBQqhEgb9G9HKjCmOcac1vAqCR4FxYubfdZgVqFG8VOj7yiKCoOmL0zvKGQmMOq123zoJTDz6WqP1DmMihEQUp/YgyoC80bGCHEcQnPEH/4XhOEQIW0Zrd6TWo9bawGI6Fd22L0a6XBiKOYSllGRymUT0JVtMprCfVCP4ORSyACjk3+OmVVRa7L4cTIHqhXpIxGiYP+1GZy2o6+2n4BcMEDlg5oOWEFkkHonODBwoSOfHjeCPgx9tZiNyPucYxcVISbeDnIlR+HFUNygaGZ27MQCx3b6PYRn68+Qm0IpsgiU1MGJvRBMtZDz4d+LpoAJyOlqcwVHM8QWNvcLaAig/iTM4TMpFHueiEzhReonFuFBxgztiCdlmzYdJiNWHSGYEw3bNQBKZosphImKaQMWyZVq9ExnwgjAosadhWStt864V4ODWSfsiTJYKpZgSxXIQ+PVORPJYWi8IGicKEI7myJWIYjv4nQi9jjbjGbabamKZtYsrUNogNE3xWeP0yADaLVa7fvU+yEcrZRgwVK2lAmiTMbq56PIvk2IrWtK3ABgRpCVJOeROoygEbJAzHsdIvAH0Y+qF3sIQP8BrEg6Qyo+lTcfMZcAof6MFBYxKjKR4BbUQCnhbsQUThd9gUqJgNT2Q5RDYKNZZcXCgTL2Q6YHr063DihQsvrJ99UNhouOiw9OBlDY9CL8zr8NjLf+LlMxV1WHhwEXlhj1ZkdThWXnhRh412KYVTL8/UyzPVc0WHvTqkXv6p9MJeWan3vZn3vZmXf+Z9ezb1wsdeOPXCMy+8qOsvPVrp9Zf0+ij3vjH34IX3Xcqrp/LqWXrfW57U4cr7xpmX/8yDzz343Ctr7n3L3PRLmRsJcxj2xhWN7TJiCr7zFPnUCQzKAXE+2CsUs8t52JvwMl2Y/QLCbqWZyKG3RMP4touFCRIUFkNF04ImjI0Ks8RPGTQTqsIMpF7NcRUduAmncWQQLAbK6lZ7USxAc9p1NTDuh+HLdcYmbOGiBgPnXJ2YvG2smWaJqkzUiBSxeBRBNMFSlsB5hxnJAn477ViEKmDvHrCMFbANmBWYQLj8TMzmqQFSxjHneQNWVCJTY32eNqAqSWCvN2/0CBRCWQIvKmqsEK8t/JxCnpSwnzeyCqd87GFgzNWYYjUmnAlhcNCzG4/CTAMdqQIRwkqLetYeDgpfwoKVPgTdxPt1i2RoXyZSfExudwRL7BMwlzLh+kjixVyNKeZqPEG/Rh5qZZQ1KYas60DfsntA/W7UxeNMTKZlnWMCraxC1uifhM0XjbhAxxlJCwAnHOH3F42wAb1NrXOvBxBGUzi5hg2ijEHhRaPfM9JYctGcieYXwKbX7HB8woMCazTM3eiBfCpLSSbRG9CENb6uMC+y/DoUMkTNIg9LaXcs/lhX8qRRD1XiRY6HUHIWNorGdxvC+zaYUlA7P1O0ak5vfVnWBPpDieIFLYI1ZGkuAqwomwD7VKMBwhW00XDBAk9UEECVOjEWRgcGAGN86WQ/eCIz9ynQRRJyE2kTAl2r2oBSyqwJxFXaQRLOXY0Tu45DOJPlcn0UTEpXH4VKVTalggHqZwU7wAJWL4dMcThTJg3ARMomRoaHBx9Aj5wtBIW+2Ie0KuqCGpO5MZcbUxnmJYwwvsgqW0OFt7nwfcDDL6Sd1CXHCyAe002tKmGxp3vZGD5UoaUbfQ/rYpg3Hg0UXggketPB+T3YHNAbN+jVhAelSO1umXBBb5U5ZZuImOP5SPchxXANXhjt8IIexw8zvM62vwqWUHzFolstXeRoewcYYAjh3KNdOuNw1KkKvXzo89hA0nZC1ddBOmLB2SWXEaqmUYoNY9YFZ1G6oLMh6ZlAxytNN6WrYVJVD1HvEA5Cylro1hBSuwt7iidj2CyRqzVBrd9OQWorEyZKHaSyOG1NsJUndsrVoMqLG26CItCHBe3oOgfgQILA0pqIxjURh2a3eRdxKThNdAw1ROhgUfUUXnFy17xkGmIAc6qwCxEsSuOxHQBE7QNgROhFSg3QAYxW5ZoWFZ0ByX5HwXiVlrClM3oMrlUzdCuaIDWdCRvtbgxiWbAZKe7OpfMC30Qjz+TO+CS0AC4HL6HboiED7pDaSJJaHsBCcCp4wvpbUKQVoBsqfVtgdSCGfoTuo5rxNRMciHx9E8PYdWSuUfOOJpdlaLogmIdGGTuBCYdNX6QtnejIQFe9pzmh+9vrCClQvTTVkJFPh+J1E6Ojp41YOb6+FLwfcpahWZAuNw5aC5nwJOJRddDOQRWWCs2lDOxF7nIlrxfsBK3W364mE1RxX7Km084YBbsNmLsa9mt6iMNXvXQdabSrzgEioG7D/mtymuEz25eo3quNcnTO9RBFhW60ypIXjctSD9yhZDkthOo/gGWdhHT9rRABo9wChsgNbPeCUAz9jKykrepQsooMuOPuvuL910mdgo8slp4DtfwMIm5zmghxqsET2CZNMJancq4Xf6k4LmFmkZRyYh7GwJKLHGYeGrNcioUstdIP/axIn88QTFpzLI1mhTkjcx7ZI026oPtDWiV4UPAIYtp9DoqREYt4sFpQZZ16QTkzX0ua4p33NlqTIu7f4xlqmkQypX7PuFOVAkIrOt3bfP3+o77KZTJs6iphGXyT9uQOJc3HwM2LVBT9G1VUmKf0Fnu7xzetYtIeU4ucZdxXWeYW1m0KISq4wOdyJTPifTHyKSB3F8PJVMdIdZoKTTj62JWoZFuHyRdWtxOK66yImX4sKLNgxC2NHk1elEwC+MlS32XsZf1CyvJ/ff7H/ndmAwR2aifupSnLKmiWh2wm0H37FjeQUaEhpmyTx7gw5cDuhQvGzmFzhnCdcJYa4q4sS6EfFZMaYqjjI48UrzLKCmJ6+OvgAGdu5l+h7GUhHO7h9IiWIHau+18ckqZFx/sG7Nd3v4Ovj2NFSxN17aimwG8NC92lBIQDQl3i7i1c3SQeeuki3ysznBQmpbOlt8ZFuHZpY2PUQsSVuUAGLuM9e81cQ8h6GVRjzhZdWkwmqUtbEd+FQr/eYBVsDzRgUHWiJtjGR4k2qgcUfeEjehzkvqpacZkPPVbAJskXCpagxh26JqHssR3QQIQJoUMqGy5dqLKhgLvQxIZC5kKRC01dKHOhhQud2lDk8otiG0L1UBt0yVzZ0Fi4UGFDExea1iGXi3C5CPehIqtD7juFK0Q42HFuQ7HLOnGpiSskmdlQGrqQKzh1X5c62tS1BzKqNuiaNXN1zVyXZK7hYKLVtc0dau4yejpw3VI4+sJVV7mqKdeeylWydJ9aOVjliqMxWMEEWZQ+R8oNCNV3l9aw2YRnAvfgAKcVzGk+VCY88ghxSALrIgqrA7w341lW22yyc8pAV7CHeEWZoGJsgnqxVn3SJ8Jy0BCkB0LI+hJkYwmy2YRUqp0PQNaXIBtLkFY+tIrcoB1+6QrfKnqbI8ywnHewUsPDYR++G6/xQ0WBUYj51ETbvXKuL/9CG6Lziw7qIYlBLeYAgh7Swg8WdpMFpJzh/OQJ63Cxtd6NNSKu0xe6LUpot9Fb6ANx5GHjKmujtkVuAseH4lHj2rFbc+dhJdC2ZVMreTQOagOBw3GggtoEy82d/aP9G169x0yUIupeRuGwIdL+TnIqUXmBzIrgKycWlqOajHRyG62Q8BOBOoWopYh6379FZ8DC8H/RYsIXDAc5+iGNRSF0RA/8EBZX/IFOgJ+Ike5YRjrzhTzNFhiKWZIuYk0WV5jOBOnRw8KQ0RxMZRHJmcZIYW7jz6KEVQMCsI+HU0GwTKvh5wtWigkcRgmo8gB/Zsi6xWwWs1MXTXUdWRBP2Snkrir3ORiMy0UFRwvIBmNFPKVHGzYKzJAhUdMJQ2EThk95ETCBL2wUNkgCiVAp5TcORooFM/kGVYyKMhiEz0hjPMkrr6UgTMZiod4snjrQCS60EJjQCwUiNw0KIW3UUmLwmKXBIsGQa2UdriYmBCcRmdjgBPgTC+eJQZ6ymEzmUKRgCiY6KyhSoTNfHTLdBuGEZ9UEA2ivaqGmCaMPdX2odB/iT4U2rXTYdiMFZ5Jy0vaSm1+e6eaUQWZ7JufZKeWay7iQMw1UEnKjAHQ//JTY/KZtYaKl/JRCcgIfCQwhRQopSk1dKrxBQWEy9SpwhEz/zujXH0vKG0sYxvVEN6Ge9Fm4wMO9+mzzwfuEFKQyYOBXmPOAhunfqnI6DkB41CLY7tkYnrfISsOwDdEVUKgPv2yRcUwJ3buOIaqdT7i3ijWVVgR17qdyMsUFaQNSVKDLOx2H1i5gIlepB0y0ZYoUOO4GAM+MJXygKaaJo5cbbZvRg2g7vEtg6AKV+ICgDTCELahtNNw9lrYDDV5tGQUlJZGasrm26DmyFPgg2woe6MpkbFfvw6PHDw77e3uHR/t9OJH667gqZzmucB0apQLmZDjls/5jhj7loYcSoSGjmm67Z8K+iotNplNjusCoeYprEkin3ySQXERXlEey8A0LjAnQcIMLaKrrGecjyLGYi+MYtzUbHi1lANUlED2vti9OJgaYc5kbiaZOWiInk3VSrU7AV+HLifrjWPFEFrEy5hNatinHkIxSEGdJwVgFUk0FNlFOYeCIIuGehcguWnyEl2WYSSihKhiAHsitAaCbqPN60igfAW7QNYuVPINBkPX38TAUocWArXgIy7+w8ZEhD8giZyQDoeoj7c3b/XsVzxC3Pdang9SkdD5OI5nbAasyaNBxfwv1RXR41KSEXvXiRl55ExizCboD7OK5TNqKR6x4iH5jWpElUJmN5NxzW+lIUVPFhNHxqh+NeCM6CRpR0UyFA5MfVROr8n4T+CG0EriCl9OywIdsARuYFQSODYmtqo75r8sx0zkPlr3Sjl3CCh+fxyLq/2ZVcPQbv5U+1aERS/HhXF0e3rlYOezNuzsP2qUkKP/oNCB5V+L7590px6sgBo2fIGAUTtMMWgRyR1KhT/Q3keW5UaBpS28IIzBCYJfwizjpXXwb5w44DQLipmsA7TVJVMxcy41h7RbSL27RVc6dqijxvVOMMxR4FyxmQfnjL4pvcO/CLqErmencjddkkWWNz4F4p63asx75WSoqLsvsSchtOvjiFKWg/V//f7/Z6Bib0Nn7D+HURcvsA1agTquJosHoYtQg3SZ1Lh4kKGnVV46Rl7n91gLfYrTHBgE7y6cV6KGs5lNguEtUieZZMR3VFFCsDlO/UZAsdVnNKEqj5dFG9GWijRnrui4qYmEqWuGz3Ht7N/Z3fvXBw6UaY2rX0vVYkOXtB1W+yPD0DufrRI4cPm7RNbG2kDR7ANx4q4CCzfAJNS7pIunolUz0b8tCzvE1NvRKJkYwK3OJr9o1DVrV8bPQ38Sx+2LkI6r6/eXYgAcBgju7oUW5NQ5Gy1TbvYvcGjcHAjiOnrSYQg1EuevyF5Hr2ZuJgFxhlynGOjSqabZ7JjyQtnMpamMBJUDeEDKBGr+O6QjNa7xC4Cou9QXjUhc3Ulc5gTgsJb6oiCgGM0IKNQ2YMSPUzsOWahXH/EnvgTueb0R0jbflztMeti7G0VLrV1l8k6NnigEamODQsqdV0b/57j8W0GdVNsFaoNj6gJen7aE3BuIxEg9Zueqrd4aw0kEGU4n+7rdmODnhbDfyabd7c8GzZna6cmjYBuZd//6t1viwKV0lW3Pgh+E0w5GX8METNqFHyMqBRn4WNNcoHqYKs3x1yE/aEJE1ITpiV08HQDbbACgX2EUUyik0IAbmhGctoH7Drjxx9K0bh622xpf9VWwV3Qd4WcGSC6WVHC0uAISk6APN8g3I0t/yhV3J8ykslDfxLVLjCZh9z8kC9EVgNdh1sWhCLeI5vofOygE+LAHmDZWCgVX0U0T2tEJjAx1gUSzaYH1t1AIWHP0pIdSInjziQi5Y0ioe9diZmGSmpj3TQDaqTZTcuN/dmpNKRPhwEU/BpLmUC9t8MkPnNx1mN19jqeD9I/TnjcwfRIYlRl7Ugnif4lXqFp+KIutiPCeUgovxcd718mfKRf/1+4e392/saNnZyKfY7pmYdibh++s4zk3JWZnA/892F64Tu2+yYB26k+AasVXKdOTjYrGw4FiblzYJtcz0zS4DBlehQRc6l9eWGm6RsbROW9YTp1C7zGmWJT4koSOOkwk4fKgMBQ0ncQuGhzwkk16vMRh0texDHaMlySVTAS184CVYXJWyGvBwpGmgBE2r8y9vV8Eqcao5fB5JmTZrC+e0yshTdbjtW0VDczMjkC00NO67yrtsVbnoSwWbSPEkZsatDJBjP9giMVxnhSZ44dAN+4BrfwfsyP2Y/c+fwGqCsoatlI8YAE7oOoOyRt4VyDAHZCyU5pRuJaLEV0XNN5ATgq44UUQKX0nBIpXR28tIjWp0KEmHzWHI+GM6gM1iJ0kETmyvmExOmIF2C6UPjS+eko/HVJw2/TNqEW73Mq1FpN+du5K5s+J7+PBue1Zhut0pfjELiDDJC6kfKRqljVv37vcf8JwFy+YHcgJ3l2hre0PMrAHdGn27l8hE5JIcHbBsAusvrB4BCxZoXNuGU5HEOhzGUaFN2AdoOTBjFCRVIgollZpSQEr9szA/4wJ2frKQH1S0QpHqX67zClmu8UNUCJKEFk7xepwCAtJzZsLAO5sMpgIWIQqQxXMdrOhHMJ2dxMt3roMyIbUwDBcsi6liYVXyiSgSDEeMioh4aH8F/cJqH9LX8wlwcqn2ARDzcUUljrmGJELXYJzIPKe84UMo3zG61prGKAVBpz9QWt0WGCNkGDgy1SGZ6VadAG9jmnXKQl0paJPZQgd0gphK/WsKnUqd37SyfSlgGaqoaIF3FBg45kmyQA9t5LAAjjcF3gdQOEnMLyHCkVUSEkw/WOZJYxljesikoY3AMoDv+CiWSv0746ZzMahME5ItWfyVkdK/uh3gdyJx6ukIZQF8DXIsFIQ6llTDTMLqORU6KKk2UuphAOdPmL48sWHS6sEwD2UhdAjYQAPLMhMgf0IUKlIqIheTQLJSBzMZU2Xg+xaVDuTmV6lFaNAWRIiSRDs7UJ90kaJOI3p7YBHqSWMIxiqMQ90fsEMUXINLFjAdKIStkIINgH6ybBGxhfYgYdoRThixLBXVpMrM4K8s5YwnM50xuhHhNqBLnYmZaec5K5XuXmCOUR2XglNUoszkHCOnEua49nLBYZNM6s38/uPhozu11h8w4cwaEu6yFThscmsXOjmOJ1D0HIYm2b0bVDHwjUwNnAGfs7kubcoR2RKDCOMui/Dx2lKC/wFs0apuzhaodKReWGP7tvc6rAX1095hgNEXVTZfpFlpGcRHd24Dx3CfMFZwr2gGMMNqXZB4/yUUcVCKrCKZvLskQ/3DKSx0iiRCQ0VhVzMkbdVqCVRveWYPWcGCvCET4Iz7e7BiAV+xxel31N4Lt1GXQujT6C25/4CsFjjj041THyy0eZckhcScJdrUeCAr7bkBtqZwitqNSvGRoQN+wWSgy5KTZJXgdC+q6N35Y9Lz5bMM8kB0U+FKK5LiNbAOWZHmq8OLPE1rj3myNIaaiqEfR+N3LqJzdpyyjjoHWmTPElaoKJUBmfzV0i86HKlpXsgTrSGJjwLqOlJ0ATtiFXBTgwnsGloFamauLhC9duqlo/payHKhBNIGNquMDAvXT3qp/ox74cQLp3U4YF7Yww8mXvi4Docefjj2wlMv7JUV0inaj4qoGW0ix7wRzU4bURjefvTUr4xMG5EhK1rxqhkPWunBohkPZSveSue8FZ+04qoZT8tmPGvhl636VH55My/sNUnk1SHy2mYsvLCX78QLTz38qQ/32kl4+YvMC3vfJrzvIm7ShGMvz8SrT+LhJ15ZqTcuUq9uqZdPeuKFvfbJvEGUef2We/XPvXKfegOn8HAKj7bwvrHw6qk8fOX1ofK+UXn1V167ld43lvM6XHn5zDKzAla5aHjOnGhIl9iVbOBqM5CTDC1Cbk05yqtrElxeHbnO3+5P6HUM7d8dcHx6Rm//lnY0g4oNvaRDipYyBfC/LB3BOQXfdCfbZOvS2OQko3P9Sxuf7fBUQvY9L210CzQWaIHmSEaRNuyayRkDVLK1yOYqzfPESUpvvfujDDaZyXU0RgkMZUNSYdICm9a1S92sSMt5J45laFS2pyj0StDnLu1OXblYIBwkxtBEdve6zVBLJutPanN7qNSmoTiBl07swPGRtiBwrgnaMXOCiJoIr+fgV5CYGGdsCGcfmFgVw6GKb65waOriM1QvaBidnFpYt8gGGMn+DZamsDdHozQazkJgLZS2CD5FNYHM5IxS8YZV9KkBofkSP2zQq4Y+wJQAXXdiwPVMRTTt3+nfq6ZQEY6WBjVs5Ki2eyaIu7kODjx/f7e5SOBcnmUtZ3w7OdoCjKwVRNUfoJod8CIL46OVBLz9z2iRTR+vcdQrzXE6VYOpzb3zptswlm/IOT6QRhZnoI/tLcptYwNblEt56k/I6a6tNU2mBrziBmMmIjShmSRo1BXCRmLgU0HT+XnosgoZV0slIXCVofQU1pgpqcmn+DuqsTF/DNurah1DrRk7RW/j+2C0zAvD5+EUDmnL8u/7cOru74kM+FEJQdT2hzkY6ClvI1oO72JO1GMhKTq4gdMUnHU5WdrNXJIdxvQIL0/6h8Odoa90StBfyL8mBlSccOda8zYcktEg1KT/QL9Q0g8DdiWwbCJptT0+oemWfu3tMnZo/UJXaYpKXCOLv42nUjh24Zauy4TxftLuWAJ2v4mB00ki+zc4XutmoTTLnyZAYRZ+l4v0dBn3D4+uw3nAOWppl6bKQN9kdRkAY1mVVf39SQA/hZxMactasq/nZbLdk8UJPTBEW5YTND+dIAdFxizhdwLrcYZ66hH+ZBOJP6hXr0KJTz6nQ2XWCMhzYaVrn8ESFt5x55VeA2LXlXffjmM0iYR81dJhBAZ6jdHfwvj/natk5FNBA/p5YCb7oUAn0XertltEAeOCJVW2yiCrSOGQeE8W5BERbTxnowYJXpel+J4azTLiJ9QRKwnQ5pyWhY4sg17ZgfVIv0KZMjaylp8MCVEngkV9PJCKll1ASkGGsEPrTZBzUVIRjdFMfpq6SONyWNCtL+RBmemLXqGrvZ/mHN/JnTRMBW7ZGgibjN4Srdng5pl3Kk5IFfuNKZOoNY6KZiNHZ/wRtbLpNdJ1RTIgDYifqRtAEHDFiGdk0DKakwVi9GBCK7Qa1UQwidNKTW2h81z7d3e2q+Z5WtnEkqc5aqqTSh4moCdh98JrP4tgkYEzcoIsHeqV9a/jo9Gsz4eP21dKIhsElNj5qrY+ux9yUlHQWko+Eb2Zq6M90Y4UgQ1VpTMwPohU4oXoNbwO6hcqg1luM8CQSceg3b3MBW1r7mjgBVolGNLoW1xHEHqqDAawwgv2IR67YaebTyV+Mcp6h6Xc7lkq3dL4GhddL7SrYeFD+IsnzeUbhaDAC/ib+GJJFGg7T3twkEExWiInO/ImJ5a4UOpCuQ0FzIZCbkPR2Ia4qw8skCaUOop04kIOLy1tKHd4eeBCroxcuFBhQ8WxC2Uu5MotajxXmnJfqVzOyn1b6Wh1d5KCNt7fqfYrtFycoMegFXcrsIuPJ7gKHIZTmJCnll3yc9ScmpdN3d8oGoS9HGf/A+8Ci5da779TFdcq4B7iG/qIx7D45AJmeOYbKs7YZmVfAQmZwyLRV9YZuTNX2t+57n2oRgPO40KnJg6cZ07RrCSWV2B8yEx85ChhROugOTzsPxA30d22vmLtfvEicjEGnFUaziU+CMabwYinZJV1aoIjjxDKNRG7K+2jXLHdlQJFuiueEgrYeKVWnyOOxeJC1hiCXb9KyD4Bnk8HLieC4zN2EoM7SP1eHw0S1Og2oE3V7g/u92/sHO30b+w93t9FX9YHu20XLlK7P+9qnNerYwGnrYxN2Jw5BWBzVDCEqCYgZBC4hnmNJwxW4XCpL45NQndZ+1NZAOuZyGhRxdBE8cjH34ZWSTQznaCBDiMiM966caDjhuLGHQoHUfgI44RYiZkoSthhnNcmWiy0ux+UpLEkXxsyPhwn+Hh8ivJWbWfGQTg+I2MnjMzcaZ4+gg2fpyYyA8avri+BIMpNUJRrQzRox4oKL2F4NhvoYJ28BIx5IshoI8VgjjcB8sQilrDLuEgVe5G8ELBA2HbDtBYkhMM4DxMm0hqlBVAtAPCmJbq4dWyobgveBTUtUAWw+Cv7qkK7X0KnH2XtRCuSeBGjj2IC1dmNvJpppX4eDYLFgLQXIiO0LtjE+GwjhKoFHUA9/YRjM1Ji/92ABqJVnMExnE2aiWSc3B+CeGUJ50FrrZAqhxap4ew+KYyNvmMeDeds7HmhxzuiNqhYLGEd64EKU1yb+kT5rSs8khMW4SNRfFxLNNasBTK5zop6SIaQEER1a4KoL/QDCVHUDadRA2BoF9rJteJxtGYBRlpBtMK4DBqMi7UhnGHpHQWlovcQOCkI4+ysbjQJs2hYwtCBJNMB8WmvoiJjdgrjkOO9GwIp9ViMx9beH1sjm4lN8ByWurVBpdrw40QNVClhkmHJJz5svQO24cPwUQiZflemZJjoS32LKdCRZyWtdydli1VEkLKCRk1W0bimRetB6A8E25Qyk7BnkWebaDmxMbga2ZSqihk3rx5VpDRNnvSgg13YDRjY7R0lMIBFZac7Og3Sh7YIhcw0Xki/uS4IvTfCvAamQ+dVZlAtMmOmpmJsr0vy6cnQ5VSpumhjH/k14G9au/sxsjwdT5phe57wRf9RsmBQAuzrlQmNDAU+HbHErw7tp/gwFC63YSrnYVlUaQOIb4zqOFVUwuiukqp/OLzb2AMNfKVkA7ls4BXe/R5DNgFfZfNCsJFPh0bTbcxpeb4mFygcb7UNAbv32+to+g6OWhl0fYmux/HKPtDRUU2I53PgMUtYxUi8+uowhO21TteFHw7vP/TUatH2yXG3DQdUiUKvbVyklpM9NkYcjmurQ3dg8DcdmZsvihk+PjWPDJIubhkdcuGNOV2Ze0qpTcrtHsaHk0kvnqI1PSv9vwPH/PYIixFGb7W7fXsz/rpEkdldjrrSPBw1CKAkNGEni9ot8x2WK98GfYzxLhHHkUxF/7UqKysS1nCYP2JksfETDB1lyheo3bxzq117gq/gSfGp/w18YDnF99leY/FFytB3j7ai34jq0qAZPYPv9BXYsrSnLN1WAOeSc6hhglck1wsGh5gxwQIIj5DQWnqn46MD0BPQTFxyvOUdMv34QALHV7P8jTeo9MmElSPWClPwEh+3kRiviPF31CKBOngA48JV10DiKzE0e1B+tn94t9XWlNg5t/dn7/551r+fiBlO7BkaXcTwqCaBMnUY7Q6UprRHD+/fedS/e+S/hkeVqbgaJmWHj7cbsC9Lxa5LXz1ULhgJA/SvGYLlFCrAY17fPnBRwhiuoPTWV8G5BKHIVC/70wE2u49VJBT09AKAkUcCn2UjxubOqdY08LPFfId3Ht6o+xGVFOMiIk8zFzBgfF+TWsr/tX7xAdQ+XAzzaIxqjIBA8lP8pc/D6wVc31rPHmMD7+yi66jLjiJlFPjBSjjyseEzbMxYiCvRtZVzEXx3twp4f4By8zEnjXKW9M160+fDO+1DaRIC/sDzEtgxQe+yQsEfPs3MkZGI9P2eQPV26Gqyekh51SdCNcvqRKocZxFd5LQrYeHdPtFhj4fVIUnYDLXe6gegDbLtXh0NZS/xQm0lZF2VpDqpTfxjTRID6ngTjCn9HfJsowXuxniEJsExi8XbsC5AjDnwnLUKbV2QTRqapAvdN4s3BXwyPqdyL/cSS2g6i1RnndsTCMPYC2lkUkTPXwg5e7k9bSMcpZsYRFVojoH6zYWpO6w5KWl7wThCOS6u0nD85sXSaRp58yTN0xU6wNh3t2WCLEx/a4KWLqY6NrJEZHrDZUHlo0u2BV4MJlHZGikmpdPfmxZuHAqOqvpozJI8sQAMeqWGjbw8oNdchBYFG7V+uKemPTzbMjRUhDPIsnSx75a7GgmlBmmAYgCP8NUhBtGMRRbCfGmk6IgM8Cm1eU6YG2DnYBZPKxEd8HLZF2xCSdDniYA68hUuQsy7vcfoIYM73ekuWvQoOgz0rnAXHbKhJVm/ZSys02TXXVRCN/u911A+jXvG38yJhgVCyI4pWrBbdnOSZaTTMcUHhUaVY8GmnFyFNGl17Se/BtWAvm3fG6PVGu07p3vXfvc7wA7dY+hQrOCpGCJPMmoQYXk2irfudQy/pY7Bol1HrMMPH+RWExkz1JSLGk1tYF36JQeSTftH8PXiGPVAGFawRt/WdlCLWkfh7rv/ME0rBUx/KpLFZ/tHU97p7UQ4TI+p7bMSOoegkSz7+GxZR+0zhrtVcG/noP/o3u5h/zAf9k/7cuiztwkUAztal1ug/QL4okqh211Ydk/5nO6ShYFC2zvoyOWyrU2piBiPjQkPT7WLIEhHo8lzE8bbFgjlMhOwzM4JRc1hwGs6XW8ykgtHwkZlLbBbEzUvxGSi3CRy2Pi2oyZtBpUN61JjljUmsy6WoCsc3aHNwZ1ZwVLUg9G3IrjU1TTb6PVELUhFkQJGMRDD0g5cBzECG1IPbGCklhrFaTqI6nQU4JUJoNKbCWhvK4rejOtAyl0gswSoCGqCpItJIV2mjRS23mjY04RcFRyIrtpNEOeOCer908RMK99juCXIvvVS1XEMTgWwYkeyKjL0tb0VlSY4SjWt3jTo6lgDtEcCdxi+xxZoeQCOJ8CQkZUNXG78sZSyxRgRuhUY9Lu8e+Eu8BZ6ByX2yyeCrcXGqLH9NFMHkQwfVkafzNPbQAmuvijtkFrsJwvWf4MVvISJBZvaqQ1qY2GGbLvXzMWW1z9CDVx8HLvCWC7M3ZyvePR+V/RvV4KsrlRi5GGiD+/hzJZyyjHzRTPfFMC59Sa6NEkilkLWKdqwX9D8RN27udK7ToMUadMwR4sVfmdpSNdaC2vjAo6uU6iwNFbKLDZVN2wdUtOQDpbnNYHnyDB7NH8H+esAWu0K84IsepEYKPSs45HpFeTx/BpYGF48rKrEHXk6raZo9wkHQQXAeOQTImPjZVNH7JaNJeNT79ucJWW7eEiYErz7oRwqh/EEzqqkVpFK/B0t0cEYLNhQx02RqYLFc7rEdtMAocSVL0Hrdxn2jFETbKOxduFJ5k2SlQncAx6crEw77bh4WQqSGqRO/vUhHJ8yvF9NRZRyWsGX7S17OWDrm4i1PXKPlwwduBdV+tn+7t7hwd5R/3SohnnTCq+uipbLoxAxPEUn7suaHxHP/sc34v7//GYFR15YBSDO0YgXxEYhOsEuScXyVfP0pZllT3txbkN/+dV8iCbCYLHM/sfv0fvlLGR+7cZJaetXZQKrUKmiBTGV4sAE36jV4JYWTVMhru34WoOLq0yq7rKiIPO4OcVHNSX2HIat3h+vhk0A1QcnQNXfw50YeAoFx+a7mhFvu7knxol+LGEh8RKcLETKwn+EjFPPJne76CV9MoDhARcKhGXLHHxTNYZWSDRb08gERwA7RTMC0oxTemN5qo0R4KaoT/YEGdCrD30BYF8s1anabgA9YkLY2rALut4JNc9KgevsSkVDfwwN7a1KrFR3tnjZg9ZGYTVflbyKlLYRWDTwTZKW3fm94MNXWea//u4/lqVxXV6MmjSwH6oTNNKp+xyHSWO30pAVUndYaHbQAKN3DPMIaK2jiDuB3KNe27MChrZa+rFE9qMouw/Ftwq2wFXcHv0mOj5MKT7SI8IJL+D8R/Z08CbQMFLyFIUhHeOZvlSnvoQwbaxDI48CPlVHBkJ6XJMpsPMRv6Xu5NnwLRh8IPxtlhKkkpWwlE31mztX0OGdwf7n2kuMigfipFMn5g7aIUQLlEZVZasYxhY0cnTbZCsYdn88VaDVztyxAgt8WQnNgteN7WIpbRBQ2mr/3bsMjVVM0ZNoElUV8FBtuu2evi035rb1PoCWiJYKCNFEJks6UsbQTGLSSZQsspMOsAxh3S67ElBpGPqhK+W0C6zyqegsYqY6gF2ICziWdn1uhdwbL2HpzEiB0R5GDlhQoR9r1maUAY6uAVb2RkU2YWRVkkdqsu838om2e5WgXIYI0WUBdwQHdrTKh3pD0yXeHBYx8sPZIQqGTRYvmlQ4RT8AaIr12MRGPp15pDrMpMpZWr/oM8UnYrxYLhWh3ZbpUJAdMrTCpVCZa+tYA0YeEY14ilhW6ICj3dSi+zHkEdkBwmNKf4vCQwzjaYNefgcC+IbNytU5Qyd1vuEFjK9QWk1Q73NaABeBJiowU4sMdaSgEdUfiDSA7rPy4dYVATUKYdSa1p2vcw+ATZAJU8b+TSbCeNRBCIVPSTbvj7qbhwedqmoHnBXJAs1YHcLpC/h5YNA6jqd8PAaWmfOE3JnWcuqMyFE/WWlyks9nY2Wv13TpUuYNYxYyp85b+kAy8UbmpsLpnAtj+w3xRwWUpJ2vvzo07xeMNTSXnS6qKKdj6Iy43cyZS1lteVQCqDTC5FCOmiTbJMh1EP1iNqhEEpFsDVhjFOvqIF4ikZ1CHU3FRO9lTgp3gL4EOAuSppUPC+x6AnSXVeihqP8AbzUnBSom1Ft6gxStfLhoxufKFDkf1K6edXnzgXXb2HFtDuP6YaUUnZIURkceAXk6pxvYcIquq3AD6GWb2Om9ixfXNLuqHagk1lUgGu9yAwO2/So91bKMtcuDAHV2Ntb17+VN/atwUw7RiCocf8sw36TXKxmMPu17UVhHKrZi5BxrnhmHVLM8s8BoQW4Ljfl8jYzhk83NjWE+d7BSwoAqoyShkPO+Uik0sDGmSYZi5VOWl9IJmLFC5HQRP6Y9s/20C6v8azxhKFvJ7LVbgwh604/C8ldE6HJFzqamXwf7D1pm1jK56nEJHnqy/kN+yjpelFiybf3ccmGYvKAg/VyGrR/ioALOHjURyZAs+jODdjL+bEOJg5NbH3+hrMJpLmG/kGggxHgAZmnm671hA2O/ojkcz4EO+uGRhelxgWPN+JmNZgX2u3UTbQYwp6dTA21MIDSaVnBIUYuEs0nFtTNTHk9gqTab1EQmaC0He1eLFdDXHvDCpOhmPX3aKZaycAmWWxCOBqioQLsgpgmMKzmU8OvENAlss6QpwyC+10kXDB89GV8+VmkjXUQCZl6mhQ7pAtZftLWrIyR8yUMTy9WJCdlahWjikZl8WsBOTONyYkDdqFszGye2stmUgnqaketwZgO0v+iwToYANCacDad8LsaaKcwnzNKTdsM6eYClFIxC4wAJHHvRp+gp1x0hMny8mBntsacVuutblMa7FNohIR+p1gWc/hi0GGj9lNPw4ifk9YDXkGmVSqOfSfF8Pa8jCtZ8ptLaA1yJjmS0tmQ1Dm1zVFmlKpZoB5r66QmwQkIFsjCvpHobVQnTpRSmvgE+ZuPW4bVbkdz6WTvNhaVUjyXtewFd2ekxYp3apYuZcXqsWx1TdUgvDRTUa5wgs7vWSZQpXfvOswJZHUMDQi6N/GjCp5UF2k5xSFi+i5BTvbpNWTLG62oPUvrYogjrCJq+pYW0LjHNN7wOaXTg04rZSiysZMF4yoNP02uwPV4dwIq007igxDtU9IXUZcMWmEv0ForWl6XCixT6GdUk2+TXhw+9TKiUKky4VG1OKtPgbt4dTptF/42oygRD490YHXkE273c046lQl7fHabZ8ssdEjXCmMsWIaSvfFhg7HABcqmtcGl82EY0HRLeJ398uJQvcYXSJU2frhDXwongOlQWrexuwbAdtUmo8Ugf1yV0XWbfh/UulxFL6vdqJGzwa0MWUUSFWi8Xum1lzvuHcPCo3ULNRx4NHE8WDJXuqLx7t4Z379/138RJfNQjk+3uK4QDPg9kEpkLBIdrAjrPNBOBzKKGclibyZYGqdvoqQQecA+Ic7R7mvCRj22OV4sQjn94TWdlTVjsE26lhF7nAXzeLZl5DMxSgU/p9aIIPN2MIKOaBj+Nwk4WdF9fBbY+Z9V9uX4Zdr0C5pssLuBB9TjIRtLela+tb0yPeQobqEDPmHELkEkCwAZLhveAAYRoDKysErMK1Q4his8CBbqG4DqGqoZcYThHC6IM4zkRKiZIMoJB+B5MGBcYI2+UeH/HykY04jaKsaARg5XZiyWVH8sSZ7iTHIKRe1HnsrwZpSdbNWTSiBmpLjQ6HPFg3GlD+o11AAoYQ5I1hN/RCXtRJDjeT3M4P3OMjNpEsBSIyC5p93O8iiBPzUvmNEtUqNdenFedgfmJtjz4mBnvBLWNyDY5DK86Xg8xgO3iluA/bcKhnKNPNzZeoVH5/1V2Pc1u40b+rk/BY2YykhM7yaa2XlSU/Tz2G/97857HnskNEiGJFkXSBCk9ufaQ1B52q/awn2NuSW1VNpWayh78BTzfaPvXDZAgCU12LxK6gQb4BwS6G/3nFmwsyWw5W2cYguIehQwmoNPeu+FuiIFD5mce0ft4qKqyVeFBr7e0r5elPbmJLpCdbUgFV2xRhLfrHPEKih6x+Kp9ES1mcKwf+z7lG5K4rjW8QPnTOQGB/ivV3hGCULuZxX2zhftwvalatufMC6v4fLrWEluTEbA6qmm4lnY+4QM+QchgTYVg4f2zCTYNa+qtSzdw74sWTY0ZG1y7LYl12hcjHr+f+RfRrdC7gxq2r+Z2XcfziaOZukR8HL/KItuYrpbCP3V51dS3Z3Tylw0SSyOoZk2bvT0itF5o0MAJWetMQ29mjJQx3jxlhDfJDtuuyXiNznG2Af2g+fg9VmiGodEz9Go8UigjaP1hnw3C88bQ+RQ4DF/CMb+uCvZj8ua7mynHvLSVZ1JFwJde5yRRbUULuLdQPCCdT4Z9ETwr7WM49mIYb1WNRNSz1ITkU9aqkTTHgTSI+8pj/BiLcjdfHAtWuIhu49W3g2XyDkFfgpv6k4rtK7r0x5LsDAR0D0wHwuI0y1YBh2/ou2jPNjUnp6VPg/a82BVmTAW7ZxCDGsFS1oV/OF0K5px0Xuld9AJOKrh3AmKvPbGKm7WXBZo7r4qRF0bp8MKO3TuTAeRZRTKmywEyoJE4LIzwvxmM+O3zwd3cZcG58x30GU8aG/nl4kRg3DWXAe4yr+vTcZtW2TDCbmnxZx6Y6As5wHvF8eljnwAzxYd5pHIlx7X0WbWrcj8vqNfi3j8+813FrjGbXv3CWTb90hXuc6FKDs4YHsFVrN1B9LMNJuSaJ7dOPptA2iBGYJOlR3AUVNxVaie2bGXxIWe7t8mxKlb4/0BVCEXueIdrBdkbxmln/JNL2yC4KD8Bh3h5op1AIZAvMYdec7wxAZhZIfaBpNa2vn2NuoRpbfQvKB3VwYveUUrVmf2c9Y3PNbT1om3MUI49IvhPEcimwXasir2jVC9qsMWFh3nG/kZbzvazyEx9gkvG7qByJUDco59PPLA19rp+zE5eOJp7llZplonrlz6kPbsbhcDvQfcUS+U6udjNGinGLc18ku0euKO86wy2fVM+xxl86VLDhzHhJGTVx++Tj98jN8ybxcuXVx///eZxdLFGciM4etH99ntAlpipZZXt0OL3afxbKzts4JRiV2SKROy6qXBIASj2CJC9e+Z3gA2uBzc9kOQ4H/y8hcxWmMjPZzWybDl+EldMy3r/YiWwxuFsgPMvM1jflwLFHgXNAAGmbhn0YXRp4dJ5DF4TMz9+TYQ7w0GLQeduW5/EpJP2UFoR9tO9mOGbuCWma4GSCOvmRJBdMe/Yzeuiqn/9wH9bjAh+8JzLMmXHFeJpkYqEDz/u0viDbrDRcPgSJm777uW1KRmBY42QlVNCKxdNnBfPoouqSdK4a4151kG2b3qHyHj0CNt6l1cU2+2e1plTt5LAGSK/t1bvZ9t6PzaJ/n2TrRXMAGhp5qbiPtEOg4jso21G0OHsQGrbZNHlVh2Vb0bhkdCrEWBKVy0BMUqCc4kvhERCDsJ48LmyequygpYLx5l7TbtD0ovdO64kigG/QKLy7nVKW1gaJQzE6QEab5bvAh3wBlht9tXgxKDFhWLLV2oLZQibIRBbR0xT23w+udO0IznQ9p8eRrFnoJS3FeMhbHQXpODTeZLFaIzMJpag7VWtTnVRZFDtDD+xfu29YFapbbSo1AaqIuST2sYDIox3gI+XsgIPMc51sSqy6Llamt7DEvxMpffOmjM+bCocYSM0o9eezy+xwHa7GCphBd6Xf0uLDukvrXXNC05u4+Lfd+1xHx0xj8EnPWLecl3BlpCtoeF0LcjbRS/uf1lCVxGIpPmdIjb3QOtVulSIAbRXbnxaPqqm2qQk4U+RDAVWjWaq8+laFPkIXvWP20yWJ5pO7WJeNktksqSPZfC2W3xAt1t12qddQVO1bTufeHT8WE71tsgXOTGIMKnqRazhYbhe2fqwwVOaYbGSY7yLDaB4TIbw2KOusNGNsRjh61evX31Bsv1mFjyKr7SoIrK0NJyCij/Md1LDGZ0QTOZ9Ierx+YRLdiZ8zetB7/N5b1Hhr/9bdcAsu8SMgcL3LtErKviReVwHvqTwNc5sHiJNXJ70Hyqf5iyl5qxXevQwrQrJN0WPc+8Mc99znpF2kBuVRG/10llWdONUKvE8HYPn28iiED3KlIaFOAI8oBSPCK0GVlSCOOzXVkC80QmiUrcShDe4TqaoOuPAwTrrL1l6ME5nPVsLHPu0CKaFY4qVNkgiSuK0NQGkodng5RGx4g3ClI8MvCppseoanBEEYWVzm0EDW/GiyFcQIHXX4kKoyF4K8wDwDosVItNwMFEXRHR80g2r9FuxG+kMSOC9o8XOnYqO4f16cf+cpHcJ/cjP/HuVnGSJizvw2eS9ut9Nw0ffBtKMGWRHqVT0UGvEwr1YOUy8ZAzUrDTZVnf4aj6HGebMAtzny8W1la8GTARP71yFs5u9BKP5SBKyw3ZHUrK75nNE5ZzuT4A7BZHKyq0aYYew/d7K3jd9Jsea43jhDcfJIiFeoRQ7EuxQKNODEAvGbgiIerRJ9/zf31vsuUyOxsYRQ3g87Lom9ijmk4Mqi8rFNELZxs0p7Ae+2sFfIxAaubJV4XyKImGRgFgbuDnu0p2KfQpMN4++BZzW7AZJk2hqPEEisiGviHCCB4RrZTeS4H2bbaMQRhEvu5+GvsLxqZFkV7PDkvpAJvr2fvMV9F7gN4ajVlL3k4rDL9OkWa3SLnRW7FGx2iyf7h4YmdZUbmv5+QsqbfeKG2Ij4R8YXRar0eXIMqMSepcJVf9E6N0vU7OSqLha7eMBzXziI9qBk+ipckm+oLK/RcyWqK+rlzgutF5t1ZlDgtfpPnomqTgv6h0X4spXonqAHZgPM/y1PD+nG13kDTGT0ZMCZ2k0JeKurXg6e7RF7gEyUJnNejlqK8EEvKRy4hwROKXaaD7mzAQTgyKtvdS0cJpPWiwnJg2geSCpdkX+d3LYjTZp3mewK4sKPmD6yMC41ynerbTDSzVIjMeHYkQnJYT2MryPbLzwsTca9h67y4ejycX45EzMUEkP/Ewh0sl6bQ2nejTzyfbDqhvlwCFZDVEHnSuqrkGXnXp4vLkj7hkpTS7eUTkekcBnOsuWmu6OPZB1M0B0Fpk36arwPVBM6FglSffgykJnxl3CLXt0YtvS1LOlbiSjm9LFOvU5hYprgqEYSIiBtIQ5K05ZgOOOgPiBelU0WbLU1KsLjnhT0IfO3kxXiPte0xIKnsAT5fuPnCakTpqQvWmep/AqfKorNpm9eEeiQ02rmVBIOAoJ/GBRbvzdyfOj6dS7QfN+mo3r4Lr1UqcZp+jDrecExK4pEr7QGJ2ISCwQJ6vsn8Wjc1sRNO63saJ+T4x564TvEcwnn/786a+f/nv24x9+/Ncf/zj59LdPf//0QwdR4T88+IdPf/3xDx304x8//a2F/v7jHz79l4Oo5k/U+k+E+5OH+/uP//bprx78P5/+3EL/6Up8D4rEmzZknF2e29O+XuVPyMUpx23ZL/Ef96nmzqSWl8o2SgJYowDa1JsxWq4zT7Au7Mc+XiOFOBg/EoLPWJiYrdZmi8AoBXZVJXBs3ABiKtR1IcM/fBzZ4BteZNJB5E58AIaDqwQsvWHrw/I94hbSFNzHxsZhoa/I5gGypfaeEcPsOMr9ZCz6fIQD2mI+fs/ZfatM03/skyDrLn1oiFG3pO8IPnoZ9WlTYLnK+8HKPJuqvTlHSW9BqH1km6gnWL37rRkjYaFlZsEq8yCEmzq7jVHdcbsfI1kF5QliXQWSWQbwuJQ+JA8ieM2jKrrmAG7a2ZoM6nDNIyR6LrPpUR2Dg46qaNAArh10XIcZAgtMHwcXr0wtNScQ7vCJTlKbDRvTD9nidsVOi/Fm9GTp5xzvV94LRmAv4CqHjwLOUEXcJ5lPPNjx8izqw4+py+kyTknGgfDP6NkeZxmHZGdohqBMJnY0kspFwuh72dUYIYNXWO5FZdzf/gxVGb0Kqyfo7mjz5BNrJPqAaYbZncDp8orjkTItBtBsTxtcZ7rqkK/PY6Q8l70eMd/S3Yxtekzcp+u4zKnLx8WG/EMkIgqfBlDFYfI4VhQfYrQFjjE66ECMlKcO76xhfVCInR7vVqeJqkc3DWSYda8guz8rIPLXHAZnxYavuGNHhMgpXG6nEb34njeTYUT47Jtet8SYTuAF6fKZdxToncvYRbTtPzdaw4g4/ApdbTA9j0ZGixcf/5KvdrTUniDwrNlWw8FxrwMM34LdDebJ3mxG4wJpU5GN90ej1ji7rfJO5KTbY98qJrHO9FhGbEA7l1LxBdKa90IlnOwXtOeaM7rBg7bJbmEhQVAcIEOQBy63bJob9BWHGulrMJLKzBoVZNK2aRo9bDK123YqK2k+5xj6jcLJEv2SuNeI051Iqo+qU1kX/bAALK+uuAL2hMPhYIllcx33W9LL8mCcOfqw4zmAepz0REpuRssvz+F7YXOYr1JaD/UHa+NqO9atBrLroh2nKJcZ8cCDmP6mxZ+3FIweFkd+b/YmfQoc7vcx7XBjH0Ij2LMWbpxviB25MNKsEij2yNxwrpd2rDo0VL2yoWPOiKKVSjfRi9ULtYXAsAIYjwnnE8EZ34APKJZQBlNFsGe8JImbfPbxBzaI40029tvLMAx5i0sKZZ4R527sRFhixgmG4KpVwURKL3P03rmMF1MjXfD+0wPtALlalOVjjmLeTQ2jYE2cKwkxnYfytfh0F9TefWcg81Q1v9y54wX6J2nwQ+oA+qItABLX6pCWPijXiAjQtGANXrFFnzn93J0y6Nq3ykbm6a6vI6Nn7qB2NeXdevCRuB38/7vfw4Wkph2xybJOLzwbYpfEY4+QPNSeLt4Xw4PisEGrqTX63AQ2TUXCm8qQB+4CeYnjPgEytADG4Wa7Me8L4rnGp7XG4YMWXYhGA18JWHO1LeeTg/j/MUVeHNcZUriNlZLG1Z2xiFFpvaWZrkqV0IRbA5wVAsY9WrqhFoS3p/goaTZH6teYmpbMPfRrPag394igzIoj8VkqO3FCNe/J20rl6s5kLICZBnWueWlL8XRChPNJXZ2mrqq7gqKhhehp09shGLdt6rD6FidVl/qNajIc2aRVvNqXR2vwYKqtZ3Vzi4DmSGo2fBkOH5r0iwRJw3GEyooWxeDPe+ryKfsl+r2wc4lAUytkd7dINfhYvRsUTHAd/Tp6kYp36/vYa0erW2PSFQKvBo/e63qbKlpJdbWD5ndvETQ1gej3lLM/oB9dQzxjeCXpXSXcZYANcCEwV7P6fVqQ6621CvZPYP0OcDrhw2KM7mPkUdFlwUV59MIsPqju5cwnOMUXlTqBsU+AC3GQt/cgiK7eINaMHJrZc0tOMaZp8W9d4wdZBbUz3z7DTryC6A2tTMGFWE5qIZs++M2v5cEcSjNtIzurOutyBfRq8nfmTA3xDmdq5JvKT5L2Rrw4RfeIyDisceqfWtGOec6IFcckcAQSnpZzO8CQwLTJYHDSxK+wyKf9Iwv5rJ+rtIquh2dk8nlnVHc+mCAr0REau4aMq3auHPeJ+UjDzBiUQ9GatpJu+0dyhmdj7gnoHXOPAeUTnJAXu62SVG1d097M7tB2IK2Ta5LE8549ezcgVZdSHVSt2XDwL3Wz1mkbaG9ANu8imQxq7DXgzApcVvQzU1ac84D4t89GN++anY04ULMaX2tTe7xWn4451Q6j6j5s89L0cEs9aKMG8HbURx+RDDpIdn1Ymz68Tgdw1YfTQX/p4CasV7KHyAZw0YfLQb0Z3nF7+kFbAnE6FQ59lhXMm/1VN8nS5Rm/B3iycXz9dyjGXVt8COnMo3Wr6TuxEhuql1ETnP7iTBTRhoxA970wq7GjkgX1HcxD3EANLB10zsFdXh9RHvCYeT1raq4IJiy4TbMdYlWvEducc+3RTcR9qvnEld1XJ8NOb5qcrTEbXry/VCsRJgbzvlqtwxLn4+SICY9gKamf5s9R0O0Wq5aeEyp1kO0t+s3oqIUHbZa/OWdNRPs8JwK4SOhGbbs5Zwlsw18IWakGO6KxqNBmeJXD9IWWIhzawMKhZxzqUdJdOWBVdOU078rsDd3MALmztNvTHum/vohuF37MDiPoc7ztEgzVdUETZMW2gWvGxB4VjljMr092EScJLh0GM709SSqX3qg2u0uITXxY4CT25rQk2YDjjNnGcUuV4s12gBuFZZFgWBpjK89lU6Bd963GuWBFRZqpm9inmE9cYhn4x4sDvJidrYo8B7DKqcn+lNsUUGuOhZwIkKRmZ7daDj5hXMjDSXr/AUsgezSl/3ZMvxkC3Lq+JQiF7Zud/m0FHmNbAeBQ5iUC2tVF8Hqt38hrept1J8fBgGwQ5aZGC3mX98L5gxeb9GDzB3uN6XMAgKQ+VnFHd2nrB5B8LK8XV19+87I7YF142ViI/1k3+dRl5wspLB++K6AzfKpzhJfC+Z5EBInHtLg0xlm9sTyINGNGLzR5ald5ToNIzFZ3sp0oW4x7dHORpjscXl/dHSq8Xtw+izYatvol4tB4Dj1yDWY32yQINX2vMVmzSZGj5rOJoPCXm52UTrSO8IamOfmRKZDl3rrzwGJmtswa3b1ghxlroXQCN4/LZlmAk1sxOEsYjFsy0VTM6uXUt47kO9KrXZqYUBbUWqrCQmIBBe8ueqI5MTe0wIBnG4Zjj3SOjBkbYeXMg16nMn6mlz0OshaMXZpG3OtX0auXmh78hXoX95pibRcQ4rgr84LnABe65zVyaiQNYl/6+rfaoc9IHwnnMrraL4uEc5AkadwjwSK75tDlNbgtWmgPKx8Bh+HqIEx8S+jSIkrwOIhxXZ9IhsbY1NDqEKgoqyIJoJGrES+5V+NGHw3RVgRGcXXBHnmucoq9KL33anh+ITWhPbRLoIvQjVgK0jpum9N75GIrZbzOioFlUi2YEG+9A++nKjij4ehuF9u2xMBVhy47hvqQ7pt628LcygE8aMHJMZChYbRX0YJZSlVYZUYCFtSaD7VqygoevSpHlBm6xz6hB3KcHLZsGSDlWo75S300nvOG+0SpJrc1AX9mvLPoLTticpbl2G/P4Z7uVnshdjU9h2oevFLrdbq6hugdOKGqpbqExj0keVmL9TK6+fgXKF1KC8+qQmdZ3CdHrIgtSzv30zv8/SpL9woF/E9XxGJJ+IYtizNoRH/SiApdIwL8ntvskauiyIScthSQ05+Qw7SiJUdMiAcP/mlWpqsaYV8nUN3MqrT02sAiyCAwjWQoFJC74gRm9tHl5up6/MSI/SpDWR1uYGdLkiEyk1ZL/Mdda0mRphAjKYOw50G68SGbY8yO/n8e/P+3k9h+rGb2dXMnmSU8dqC5E14n+I10BCwMSMqlopK0CtCmrdezXg+0KvQ65FGPRT+0Hd/hsRCWIWAYyu2nI3N0IrFsBvGQieM4fI6QHrAPcjyzSp3aplR2xVObppuKtsSpdg/ppqjswbhfPipuA7ZymhhXBIc59cfs4TuW6FTCylP5Tl21w50JAF1XafRMVQhNBDNlAuMeCT3qFnQRFjFOINB/bdFhawZdbVJO5p1+gIxE0GzNUOzTsd9Svyd09Q0S6uDT8uMCOVwwzxwJJPUHkhAqPsTaH7kQs/V5qWCMbAOmLlnBg9RIn896PfKwlzdhS9EmqWbbXfBGOz82T5jv2s8nlo7+MHMyYt63u0lKm8pWNptvnkGdmBrmhrDI9zOxpeFT8avXJJQ7/vOiLmJnFuwx7N+8vHrz+OZ28Ty6fPVicfUyunn85Or29c13gxeJq0Uw4ZPI70brSGWm+OeIM71H060UvHbRVmfjYJ6LWuVN3kRXmyX9VcVmy+nvRjEHu36Q4nuvETPCIOnvmk/Yj2uG6E8ayb3k/FbYOJ/FhuF+xEFS4EnjGoSmiVUFtmnORR8Rh2jnE5vQmVUS1JcFpw7mqyozP8tYQ2AwjienL0LCSMWOt3B5JNF86RC+9tP1gU4amPvO+iYTLW5sKtXl7OnNRUdAfXPRboj9EJMco+Cb24D+hr4tEh2ji8fJjEsxtGC0nSKQd1tk+je6OrUuvbdXi+4bokd6mmWHkKZmV0CzvzP6nT6wLhy52G37+eT+Lx781j2NNzhVHwWLODD2zCk9ydKI+k/yn/EUNR0JvWQpY6mzRVhb5AXMAGTUtCSGkl7BY44TP3DZxOdzsC3C62C6R9wNhHbMeq/Fp4KJMRtLiQc4DhLs2DLlrsZBRt1sxMs7E2h0keQ0126uni5e9kfuiOj+O0CGpFcsgXZ7QcAOPvpMFN8ISn2a5LhRVrH3iGioDmwVT2+KO3rkZhv19/KDRUOCDpkVq+hGbzMbOx/x6BwBQkXvipnaT/KTUvhf6pm6mxADjj9isfHb4Hezwe9+hd+G8Xcn/OY0M9LJ3d1sk7FPx6bk0KizTQ0mkn7rAr/U7+Y0WdEynkv9bjNJC/y+K/FbH/Db7PjX4JeoMzOhC9kzO0q/1JJ+ESs0oR0hSWklLKp8crjDrXAa9NUEbtwrJGajX7qnHIoh6uOwkVEPDZ/+0R81tAl03sy+WUBIp71B8js4Dst3aOLq2eGnzKBuoC7ICtm+9SmuBJ7tUn1ge6iDs4B602R1Fb2SnHbDmYo6/j4hsDbU4J4kv0Oa7Iqmeyjstc4MCXQNDJVgIiIN47YrsMMM+Gn00M1b4h0zbRXWWgKEfzHUmLHf0ZFb8uQPhjxURp2aXYqwzRrSpFHxMlP03oSQVgq7gvRhuQq9fAoN2fLQfxTwM1Xn0jYsqhwR4J7qnHjvnbHfkEdiA0BtRUd2aEdComZofodKwvHYtuUZ2zDEM8ABX/TaqF2JWUMMuUPOaof0NyvREOipTSHGp6d9lERDLXZFlzCPL+OYIqo2clF6T+1Sl1lxEhcoYhZVWFN81Am3C743enxV+r7RCH7IJyXdsu/TwW3HQrS7tGWSaNqyXf/fsndLHl2mG3j+9J5yyEHumITNe79CmJuvoCx/RyWjix3Sise2OR7lnp7LsTvlfXu1uLnsZYjxBkpJoIfVTdgTMqFpRPI/8qnuCNhyOfaJ5lbh0OuIR013KUcNHAZAOFIF2/0cXYszJkMkNBc4IKhgGvIEWRgyNjZcCiru0dN72K8zGsnqRIpsDR0iQ8d9dwAhD+S2J06npkSczwCPV0K7pBNxtTEMx7Y5hzSirWKDoNT0yPNZC8vtf4DG5PfIutobC+gPwJ55ucTGRY9pA0R0LQDxgETyOWHW++dPb4uspucc0q0cqYoeTDidVbqPLj/+gIm5bXzOpiUSnagFp4neF/bIl2Owo4xIEDVtYK40hSBmJd+GkwsbEo90ygm/Bs348JbXTnTmNFVviyIx0RuVZVBrjm6HKg+27ly6A3HwQ6IunrsrgLOjgHGgh/kEah5cVlYgIs/JZVqseE1GzqAWddR6lw3b8WVfRz0TRr7iUjPqp92abvewK4OjHMCZARj7pOMgsw8R+YsYwsvmBlEfo4ulIGZJUwExIHdQCStaSdKKFPYhtMzeO89La/ACpC5khrhFTEaV7VO2QyQoto3pAtK71nYVNsFF1Touf6vzIn/Ep4ZPljfdYHfA2xQhASmFP5WcJKdtAR/OkXDYJyfxWWXrLlDyt/lDdTrrXXiXL8+5PQmhF1ayyyhxZ88uvX2t7WjS3J/5wJRzzXoovqjbXy2ePxejIEgKy8Pg2d+ZX8GLIXQ2b2McvEB4warmqB70nd0t9Vbr6uf+04ldL3gqK7B00Fpnk4RXMSrYetFuYKDvcM51N5N39Pz5o+6i5PmepP4ngobCL71I/ExSPy9NFgvhlPWCcF0UuHvnll+bDfHOu2iI54vV5lHRVEZH1/VpkB71pM2K68LXWjdZ9HBLDZTZKViAEyLu0cwnBfTBiEKrJHCFjImwZf1XdSoydTZc3VrlRXQDg2nF+wrg2FHQY0CqFt/S+7tiv/QFtxPgM863NWJlIAcKH14AjNvm1DUV2G51IkhiVBA0mQ1ToXFGPUyWTlyCl7I+ctFIcA4ukxBg9RbfNXmx7WetPFlUcFenRV1z7PQnVbpXyJfabjk+HV1nvoUpkEXRVaI0sx/v79XL9FH/i/2gwsxoUKuGEFFCMJ/IP/4ce4A9O3r86pWvPMP2O1tuzhpiLRAWG0yCTVZm288nSwMtq0k3ue0bZv3R4t5Q4fyBK2AtNVKc7NMMx1Fbkoa1KeO2JSJGGvqoRdFEi6kV5TuEbnk++4mIWPe73/3u8cvL6Prm6s3i9WOr07sl7OR/AWVff9U="
+}
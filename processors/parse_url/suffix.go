@@ -0,0 +1,147 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package parse_url
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/njcx/libbeat_v6/asset"
+)
+
+// suffixList holds the rules of a public suffix list
+// (https://publicsuffix.org/list/). Rules are keyed by the domain they apply
+// to, without the leading `*.` or `!`. The values tell if the rule is private.
+type suffixList struct {
+	normal    map[string]bool
+	wildcard  map[string]bool
+	exception map[string]bool
+}
+
+// parseSuffixList reads a list in the format of public_suffix_list.dat.
+// Rules following the `===BEGIN PRIVATE DOMAINS===` marker are private.
+func parseSuffixList(r io.Reader) (*suffixList, error) {
+	l := &suffixList{
+		normal:    map[string]bool{},
+		wildcard:  map[string]bool{},
+		exception: map[string]bool{},
+	}
+
+	private := false
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "//") {
+			if strings.Contains(line, "===BEGIN PRIVATE DOMAINS===") {
+				private = true
+			}
+			continue
+		}
+		// Rules end at the first white space.
+		if i := strings.IndexAny(line, " \t"); i >= 0 {
+			line = line[:i]
+		}
+		if line == "" {
+			continue
+		}
+
+		line = strings.ToLower(line)
+		switch {
+		case strings.HasPrefix(line, "!"):
+			l.exception[line[1:]] = private
+		case strings.HasPrefix(line, "*."):
+			l.wildcard[line[2:]] = private
+		default:
+			l.normal[line] = private
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(l.normal) == 0 {
+		return nil, fmt.Errorf("no public suffix rules found")
+	}
+	return l, nil
+}
+
+var (
+	defaultSuffixListOnce sync.Once
+	defaultSuffixList     *suffixList
+	defaultSuffixListErr  error
+)
+
+// getDefaultSuffixList returns the embedded public suffix list.
+func getDefaultSuffixList() (*suffixList, error) {
+	defaultSuffixListOnce.Do(func() {
+		var data []byte
+		data, defaultSuffixListErr = asset.DecodeData(publicSuffixAsset())
+		if defaultSuffixListErr == nil {
+			defaultSuffixList, defaultSuffixListErr = parseSuffixList(bytes.NewReader(data))
+		}
+	})
+	if defaultSuffixListErr != nil {
+		return nil, fmt.Errorf("failed to load the public suffix list: %v", defaultSuffixListErr)
+	}
+	return defaultSuffixList, nil
+}
+
+// publicSuffix returns the public suffix of the lower case domain, using the
+// longest matching rule. Exception rules take precedence over wildcard rules.
+// If no rule matches, the last label is the suffix. Private rules are ignored
+// unless private is set.
+func (l *suffixList) publicSuffix(domain string, private bool) string {
+	match := func(rules map[string]bool, key string) bool {
+		isPrivate, found := rules[key]
+		return found && (private || !isPrivate)
+	}
+
+	labels := strings.Split(domain, ".")
+	for i := range labels {
+		candidate := strings.Join(labels[i:], ".")
+		if match(l.exception, candidate) {
+			return strings.Join(labels[i+1:], ".")
+		}
+		if match(l.normal, candidate) {
+			return candidate
+		}
+		if i+1 < len(labels) && match(l.wildcard, strings.Join(labels[i+1:], ".")) {
+			return candidate
+		}
+	}
+	return labels[len(labels)-1]
+}
+
+// domainParts splits the lower case domain in its subdomain, registered domain
+// and top level domain (the public suffix). The registered domain is the
+// public suffix plus one label, it is empty if the domain is a public suffix.
+func (l *suffixList) domainParts(domain string, private bool) (subdomain, registered, tld string) {
+	tld = l.publicSuffix(domain, private)
+	if len(domain) <= len(tld)+1 {
+		return "", "", tld
+	}
+
+	rest := domain[:len(domain)-len(tld)-1]
+	if i := strings.LastIndexByte(rest, '.'); i >= 0 {
+		return rest[:i], rest[i+1:] + "." + tld, tld
+	}
+	return "", rest + "." + tld, tld
+}
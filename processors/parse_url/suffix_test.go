@@ -0,0 +1,90 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package parse_url
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/asset"
+)
+
+func TestPublicSuffixAsset(t *testing.T) {
+	expected, err := ioutil.ReadFile("publicsuffix/public_suffix_list.dat")
+	require.NoError(t, err)
+
+	data, err := asset.DecodeData(publicSuffixAsset())
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(data), "publicsuffix.go is outdated")
+}
+
+func TestDomainParts(t *testing.T) {
+	list := `
+// ===BEGIN ICANN DOMAINS===
+com
+uk
+co.uk
+jp
+*.kawasaki.jp
+!city.kawasaki.jp
+// ===END ICANN DOMAINS===
+// ===BEGIN PRIVATE DOMAINS===
+github.io
+// ===END PRIVATE DOMAINS===
+`
+	l, err := parseSuffixList(strings.NewReader(list))
+	require.NoError(t, err)
+
+	tests := []struct {
+		domain                     string
+		private                    bool
+		subdomain, registered, tld string
+	}{
+		{"example.com", true, "", "example.com", "com"},
+		{"a.b.example.com", true, "a.b", "example.com", "com"},
+		{"com", true, "", "", "com"},
+		{"www.example.co.uk", true, "www", "example.co.uk", "co.uk"},
+		{"www.example.test", true, "www", "example.test", "test"},
+		{"www.example.kawasaki.jp", true, "", "www.example.kawasaki.jp", "example.kawasaki.jp"},
+		{"www.city.kawasaki.jp", true, "www", "city.kawasaki.jp", "kawasaki.jp"},
+		{"docs.user.github.io", true, "docs", "user.github.io", "github.io"},
+		{"docs.user.github.io", false, "docs.user", "github.io", "io"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.domain, func(t *testing.T) {
+			subdomain, registered, tld := l.domainParts(test.domain, test.private)
+			assert.Equal(t, test.subdomain, subdomain)
+			assert.Equal(t, test.registered, registered)
+			assert.Equal(t, test.tld, tld)
+		})
+	}
+}
+
+func TestDefaultSuffixList(t *testing.T) {
+	l, err := getDefaultSuffixList()
+	require.NoError(t, err)
+
+	_, registered, tld := l.domainParts("www.bbc.co.uk", true)
+	assert.Equal(t, "bbc.co.uk", registered)
+	assert.Equal(t, "co.uk", tld)
+}
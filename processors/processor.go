@@ -35,6 +35,36 @@ type Processor interface {
 	String() string
 }
 
+// Closer is implemented by processors holding resources, like open files or
+// background goroutines, that must be released once the processor is not
+// used anymore.
+type Closer interface {
+	Close() error
+}
+
+// Close releases the resources of p, if p implements Closer. The processor
+// must not be run after it has been closed.
+func Close(p beat.Processor) error {
+	if c, ok := p.(Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// InfoSetter is implemented by processors depending on the information about
+// the beat publishing the events.
+type InfoSetter interface {
+	SetBeatInfo(info beat.Info)
+}
+
+// SetBeatInfo passes the beat information to p, if p implements InfoSetter.
+// It must be called before the processor is run.
+func SetBeatInfo(p beat.Processor, info beat.Info) {
+	if s, ok := p.(InfoSetter); ok {
+		s.SetBeatInfo(info)
+	}
+}
+
 func New(config PluginConfig) (*Processors, error) {
 	procs := Processors{}
 
@@ -56,6 +86,7 @@ func New(config PluginConfig) (*Processors, error) {
 			constructor := gen.Plugin()
 			plugin, err := constructor(cfg)
 			if err != nil {
+				procs.Close()
 				return nil, err
 			}
 
@@ -71,6 +102,33 @@ func (procs *Processors) add(p Processor) {
 	procs.List = append(procs.List, p)
 }
 
+// Close closes all processors implementing Closer. It returns the last error.
+func (procs *Processors) Close() error {
+	if procs == nil {
+		return nil
+	}
+
+	var err error
+	for _, p := range procs.List {
+		if e := Close(p); e != nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// SetBeatInfo passes the beat information to all processors implementing
+// InfoSetter.
+func (procs *Processors) SetBeatInfo(info beat.Info) {
+	if procs == nil {
+		return
+	}
+
+	for _, p := range procs.List {
+		SetBeatInfo(p, info)
+	}
+}
+
 // RunBC (run backwards-compatible) applies the processors, by providing the
 // old interface based on common.MapStr.
 // The event us temporarily converted to beat.Event. By this 'conversion' the
@@ -125,6 +183,83 @@ func (procs *Processors) Run(event *beat.Event) *beat.Event {
 	return event
 }
 
+// RunMulti applies the processors like Run, but processors implementing
+// beat.MultiProcessor can return multiple events. The remaining processors are
+// applied to each of them. Dropped events are not returned.
+func (procs *Processors) RunMulti(event *beat.Event) []*beat.Event {
+	events, _ := RunMultiList(procs.All(), event)
+	return events
+}
+
+// IsMulti returns true if any of the processors implements
+// beat.MultiProcessor.
+func (procs *Processors) IsMulti() bool {
+	if procs == nil {
+		return false
+	}
+	for _, p := range procs.List {
+		if _, ok := p.(beat.MultiProcessor); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// RunMulti applies p to event. Processors implementing beat.MultiProcessor
+// can return any number of events, other processors return the event or
+// nothing if the event was dropped.
+func RunMulti(p beat.Processor, event *beat.Event) ([]*beat.Event, error) {
+	if multi, ok := p.(beat.MultiProcessor); ok {
+		events, err := multi.RunMulti(event)
+
+		// remove dropped events
+		n := 0
+		for _, e := range events {
+			if e != nil {
+				events[n] = e
+				n++
+			}
+		}
+		return events[:n], err
+	}
+
+	event, err := p.Run(event)
+	if event == nil {
+		return nil, err
+	}
+	return []*beat.Event{event}, err
+}
+
+// RunMultiList applies the processors in list to event. Processors
+// implementing beat.MultiProcessor can return multiple events, the remaining
+// processors are applied to each of them. Dropped events are not returned.
+// Errors are logged and processing continues with the events returned. If all
+// events are dropped, the last error is returned.
+func RunMultiList(list []beat.Processor, event *beat.Event) ([]*beat.Event, error) {
+	events := []*beat.Event{event}
+	for _, p := range list {
+		var (
+			next    []*beat.Event
+			lastErr error
+		)
+		for _, event := range events {
+			out, err := RunMulti(p, event)
+			if err != nil {
+				logp.Debug("filter", "fail to apply processor %s: %s", p, err)
+				lastErr = err
+			}
+			next = append(next, out...)
+		}
+
+		events = next
+		if len(events) == 0 {
+			// all events dropped
+			return nil, lastErr
+		}
+	}
+	return events, nil
+}
+
 func (procs Processors) String() string {
 	var s []string
 	for _, p := range procs.List {
@@ -617,3 +617,57 @@ func TestDropMissingFields(t *testing.T) {
 
 	assert.Equal(t, expectedEvent, processedEvent.Fields)
 }
+
+func TestRunMulti(t *testing.T) {
+	yml := []map[string]interface{}{
+		{
+			"split": map[string]interface{}{
+				"field":  "records",
+				"target": "record",
+			},
+		},
+		{
+			"drop_event": map[string]interface{}{
+				"when": map[string]interface{}{
+					"equals": map[string]string{
+						"record.type": "noise",
+					},
+				},
+			},
+		},
+		{
+			"drop_fields": map[string]interface{}{
+				"fields": []string{"source"},
+			},
+		},
+	}
+
+	processors := GetProcessors(t, yml)
+	assert.True(t, processors.IsMulti())
+
+	event := &beat.Event{
+		Timestamp: time.Now(),
+		Fields: common.MapStr{
+			"source": "cloudtrail",
+			"bucket": "logs",
+			"records": []interface{}{
+				common.MapStr{"type": "login"},
+				common.MapStr{"type": "noise"},
+				common.MapStr{"type": "logout"},
+			},
+		},
+	}
+
+	events := processors.RunMulti(event)
+	if !assert.Len(t, events, 2) {
+		return
+	}
+
+	assert.Equal(t, common.MapStr{"bucket": "logs", "record": common.MapStr{"type": "login"}}, events[0].Fields)
+	assert.Equal(t, common.MapStr{"bucket": "logs", "record": common.MapStr{"type": "logout"}}, events[1].Fields)
+	for _, e := range events {
+		assert.Equal(t, event.Timestamp, e.Timestamp)
+	}
+
+	assert.False(t, GetProcessors(t, yml[1:]).IsMulti())
+}
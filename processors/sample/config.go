@@ -0,0 +1,48 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sample
+
+import (
+	"github.com/pkg/errors"
+)
+
+type config struct {
+	// Rate is the fraction of events kept, greater than 0 and at most 1.
+	Rate float64 `config:"rate" validate:"required"`
+
+	// KeyFields enables deterministic sampling. Events with the same values
+	// in these fields are kept or dropped together.
+	KeyFields []string `config:"key_fields"`
+
+	// TargetField is where the sample rate is recorded. Use an empty string
+	// to not record the rate.
+	TargetField string `config:"target_field"`
+}
+
+func defaultConfig() config {
+	return config{
+		TargetField: "sample.rate",
+	}
+}
+
+func (c *config) Validate() error {
+	if c.Rate <= 0 || c.Rate > 1 {
+		return errors.Errorf("invalid sample rate %v, the rate must be greater than 0 and at most 1", c.Rate)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sample
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+
+	"github.com/cespare/xxhash"
+	"github.com/pkg/errors"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/fieldhash"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+const processorName = "sample"
+
+func init() {
+	processors.RegisterPlugin(processorName, newSampleProcessor)
+}
+
+type sample struct {
+	config    config
+	keyFields []string
+	random    func() float64
+}
+
+func newSampleProcessor(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrapf(err, "fail to unpack the %v configuration", processorName)
+	}
+
+	fields := fieldhash.NormalizeFields(c.KeyFields)
+
+	return &sample{
+		config:    c,
+		keyFields: fields,
+		random:    rand.Float64,
+	}, nil
+}
+
+// Run drops the event unless it is selected by the sampler. The rate is
+// recorded in kept events.
+func (p *sample) Run(event *beat.Event) (*beat.Event, error) {
+	if p.config.Rate < 1 && p.position(event) >= p.config.Rate {
+		return nil, nil
+	}
+
+	if p.config.TargetField == "" {
+		return event, nil
+	}
+	if err := p.recordRate(event); err != nil {
+		return event, errors.Wrapf(err, "failed to record the sample rate in %v", p.config.TargetField)
+	}
+	return event, nil
+}
+
+// position returns a number in [0,1) that decides if the event is kept. The
+// number is computed from the key fields if they are configured and present in
+// the event, otherwise it is random.
+func (p *sample) position(event *beat.Event) float64 {
+	if len(p.keyFields) == 0 {
+		return p.random()
+	}
+
+	h := xxhash.New()
+	n, _ := fieldhash.WriteFields(h, event, p.keyFields, true)
+	if n == 0 {
+		return p.random()
+	}
+
+	// Use the 53 most significant bits, so the result is exactly
+	// representable and less than 1.
+	return float64(h.Sum64()>>11) / (1 << 53)
+}
+
+// recordRate stores the rate in the event. If the event was already sampled
+// before, the rates are multiplied.
+func (p *sample) recordRate(event *beat.Event) error {
+	rate := p.config.Rate
+	if v, err := event.GetValue(p.config.TargetField); err == nil {
+		if previous, ok := toFloat(v); ok {
+			rate *= previous
+		}
+	}
+	_, err := event.PutValue(p.config.TargetField, rate)
+	return err
+}
+
+func (p *sample) String() string {
+	return fmt.Sprintf("%v=[rate=%v, key_fields=%v, target_field=%v]",
+		processorName, p.config.Rate, strings.Join(p.keyFields, ","), p.config.TargetField)
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, !math.IsNaN(n)
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,211 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sample
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+func TestConfigValidate(t *testing.T) {
+	for _, rate := range []interface{}{0, -0.5, 1.5, "half"} {
+		_, err := newSampleProcessor(common.MustNewConfigFrom(map[string]interface{}{"rate": rate}))
+		assert.Error(t, err, "rate %v", rate)
+	}
+
+	_, err := newSampleProcessor(common.MustNewConfigFrom(map[string]interface{}{}))
+	assert.Error(t, err)
+}
+
+func TestProbabilistic(t *testing.T) {
+	proc, err := newSampleProcessor(common.MustNewConfigFrom(map[string]interface{}{"rate": 0.05}))
+	require.NoError(t, err)
+	p := proc.(*sample)
+
+	p.random = func() float64 { return 0.01 }
+	event, err := p.Run(&beat.Event{Fields: common.MapStr{"message": "a"}})
+	require.NoError(t, err)
+	require.NotNil(t, event)
+	assert.Equal(t, common.MapStr{"message": "a", "sample": common.MapStr{"rate": 0.05}}, event.Fields)
+
+	p.random = func() float64 { return 0.05 }
+	event, err = p.Run(&beat.Event{Fields: common.MapStr{"message": "a"}})
+	assert.NoError(t, err)
+	assert.Nil(t, event)
+}
+
+func TestKeepAll(t *testing.T) {
+	proc, err := newSampleProcessor(common.MustNewConfigFrom(map[string]interface{}{"rate": 1, "target_field": ""}))
+	require.NoError(t, err)
+	p := proc.(*sample)
+	p.random = func() float64 {
+		t.Fatal("random called with a rate of 1")
+		return 0
+	}
+
+	event, err := p.Run(&beat.Event{Fields: common.MapStr{"message": "a"}})
+	assert.NoError(t, err)
+	assert.Equal(t, common.MapStr{"message": "a"}, event.Fields)
+}
+
+func TestDeterministic(t *testing.T) {
+	proc, err := newSampleProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"rate":       0.1,
+		"key_fields": []string{"trace.id"},
+	}))
+	require.NoError(t, err)
+	p := proc.(*sample)
+	p.random = func() float64 {
+		t.Fatal("random called for events with key fields")
+		return 0
+	}
+
+	kept := 0
+	const traces = 10000
+	for i := 0; i < traces; i++ {
+		id := fmt.Sprintf("trace-%d", i)
+
+		var decisions []bool
+		for j := 0; j < 3; j++ {
+			event, err := p.Run(&beat.Event{Fields: common.MapStr{
+				"trace":   common.MapStr{"id": id},
+				"message": j,
+			}})
+			require.NoError(t, err)
+			decisions = append(decisions, event != nil)
+		}
+
+		assert.Equal(t, []bool{decisions[0], decisions[0], decisions[0]}, decisions, "trace %v", id)
+		if decisions[0] {
+			kept++
+		}
+	}
+	assert.InDelta(t, 0.1, float64(kept)/traces, 0.02)
+}
+
+func TestConcurrentRun(t *testing.T) {
+	p, err := newSampleProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"rate":       0.5,
+		"key_fields": []string{"trace.id"},
+	}))
+	require.NoError(t, err)
+
+	const (
+		workers = 8
+		traces  = 100
+	)
+
+	// all workers must take the same decisions
+	decisions := make([][]bool, workers)
+	var wg sync.WaitGroup
+	for i := range decisions {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < traces; j++ {
+				event, err := p.Run(&beat.Event{Fields: common.MapStr{
+					"trace": common.MapStr{"id": j},
+				}})
+				assert.NoError(t, err)
+				decisions[i] = append(decisions[i], event != nil)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for _, d := range decisions[1:] {
+		assert.Equal(t, decisions[0], d)
+	}
+}
+
+func TestDeterministicMissingKey(t *testing.T) {
+	proc, err := newSampleProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"rate":       0.5,
+		"key_fields": []string{"trace.id"},
+	}))
+	require.NoError(t, err)
+	p := proc.(*sample)
+
+	called := 0
+	p.random = func() float64 {
+		called++
+		return 0.1
+	}
+
+	event, err := p.Run(&beat.Event{Fields: common.MapStr{"message": "a"}})
+	assert.NoError(t, err)
+	assert.NotNil(t, event)
+	assert.Equal(t, 1, called)
+}
+
+func TestRateIsMultiplied(t *testing.T) {
+	proc, err := newSampleProcessor(common.MustNewConfigFrom(map[string]interface{}{"rate": 0.5}))
+	require.NoError(t, err)
+	p := proc.(*sample)
+	p.random = func() float64 { return 0 }
+
+	event, err := p.Run(&beat.Event{Fields: common.MapStr{
+		"sample": common.MapStr{"rate": 0.2},
+	}})
+	require.NoError(t, err)
+	v, err := event.GetValue("sample.rate")
+	require.NoError(t, err)
+	assert.InDelta(t, 0.1, v, 1e-9)
+}
+
+func TestWhenCondition(t *testing.T) {
+	cfg, err := common.NewConfigFrom(`
+processors:
+  - sample:
+      rate: 0.000000001
+      when.not.equals.log.level: error
+`)
+	require.NoError(t, err)
+
+	var config struct {
+		Processors processors.PluginConfig `config:"processors"`
+	}
+	require.NoError(t, cfg.Unpack(&config))
+	procs, err := processors.New(config.Processors)
+	require.NoError(t, err)
+
+	kept := 0
+	for i := 0; i < 100; i++ {
+		level := "info"
+		if i%10 == 0 {
+			level = "error"
+		}
+		event := procs.Run(&beat.Event{Fields: common.MapStr{
+			"log": common.MapStr{"level": level},
+		}})
+		if event != nil {
+			kept++
+			assert.Equal(t, "error", event.Fields["log"].(common.MapStr)["level"])
+			assert.NotContains(t, event.Fields, "sample")
+		}
+	}
+	assert.Equal(t, 10, kept)
+}
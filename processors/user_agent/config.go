@@ -0,0 +1,53 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package user_agent
+
+import (
+	"time"
+)
+
+type config struct {
+	// Field is the field containing the user agent string.
+	Field string `config:"field"`
+
+	// Target is the field the parsed user agent is written to. Use an empty
+	// string to write to the root of the event.
+	Target string `config:"target"`
+
+	// RegexFile is a uap-core regexes file replacing the embedded regexes.
+	RegexFile string `config:"regex_file"`
+
+	// ReloadPeriod is the interval to check the regex file for changes.
+	// Use 0 to disable reloading.
+	ReloadPeriod time.Duration `config:"reload.period"`
+
+	// CacheSize is the number of parsing results to keep. Use 0 to disable the cache.
+	CacheSize int `config:"cache.size" validate:"min=0"`
+
+	// IgnoreMissing skips events without the field instead of failing.
+	IgnoreMissing bool `config:"ignore_missing"`
+}
+
+func defaultConfig() config {
+	return config{
+		Field:        "user_agent.original",
+		Target:       "user_agent",
+		ReloadPeriod: time.Minute,
+		CacheSize:    1000,
+	}
+}
@@ -0,0 +1,257 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package user_agent
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/njcx/libbeat_v6/asset"
+	"github.com/njcx/libbeat_v6/common"
+)
+
+const other = "Other"
+
+// rawParser is an entry of a uap-core regexes file.
+type rawParser struct {
+	Regex     string `yaml:"regex"`
+	RegexFlag string `yaml:"regex_flag"`
+
+	FamilyReplacement string `yaml:"family_replacement"`
+	V1Replacement     string `yaml:"v1_replacement"`
+	V2Replacement     string `yaml:"v2_replacement"`
+	V3Replacement     string `yaml:"v3_replacement"`
+
+	OSReplacement   string `yaml:"os_replacement"`
+	OSV1Replacement string `yaml:"os_v1_replacement"`
+	OSV2Replacement string `yaml:"os_v2_replacement"`
+	OSV3Replacement string `yaml:"os_v3_replacement"`
+
+	DeviceReplacement string `yaml:"device_replacement"`
+}
+
+type rawRegexes struct {
+	UserAgentParsers []rawParser `yaml:"user_agent_parsers"`
+	OSParsers        []rawParser `yaml:"os_parsers"`
+	DeviceParsers    []rawParser `yaml:"device_parsers"`
+}
+
+// matcher is a compiled parser. The replacements and the default groups are
+// indexed in the order of the extracted values, e.g. family, major, minor and
+// patch.
+type matcher struct {
+	re           *regexp.Regexp
+	replacements []string
+	groups       []int
+}
+
+// match returns the values extracted from s. A value is taken from its
+// replacement if set, or from its default capture group otherwise.
+func (m *matcher) match(s string) ([]string, bool) {
+	groups := m.re.FindStringSubmatch(s)
+	if groups == nil {
+		return nil, false
+	}
+
+	values := make([]string, len(m.replacements))
+	for i, replacement := range m.replacements {
+		if replacement != "" {
+			values[i] = expand(replacement, groups)
+		} else if g := m.groups[i]; g > 0 && g < len(groups) {
+			values[i] = groups[g]
+		}
+	}
+	return values, true
+}
+
+// expand replaces the references $1 to $9 in replacement by the captured
+// groups.
+func expand(replacement string, groups []string) string {
+	if !strings.Contains(replacement, "$") {
+		return replacement
+	}
+	for i := 1; i <= 9; i++ {
+		value := ""
+		if i < len(groups) {
+			value = groups[i]
+		}
+		replacement = strings.Replace(replacement, "$"+strconv.Itoa(i), value, -1)
+	}
+	return strings.TrimSpace(replacement)
+}
+
+// parser extracts the browser, operating system and device from user agent
+// strings.
+type parser struct {
+	userAgents []*matcher
+	os         []*matcher
+	devices    []*matcher
+}
+
+// newParser compiles the regexes of a uap-core regexes file. Entries that
+// can not be compiled, e.g. because they use regular expression features not
+// supported by Go, are skipped and returned as warnings.
+func newParser(data []byte) (*parser, []error, error) {
+	var raw rawRegexes
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse user agent regexes: %v", err)
+	}
+	if len(raw.UserAgentParsers) == 0 && len(raw.OSParsers) == 0 && len(raw.DeviceParsers) == 0 {
+		return nil, nil, fmt.Errorf("no user agent regexes found")
+	}
+
+	var warnings []error
+	compile := func(section string, entries []rawParser, groups []int, replacements func(rawParser) []string) []*matcher {
+		matchers := make([]*matcher, 0, len(entries))
+		for i, entry := range entries {
+			expr := entry.Regex
+			if strings.Contains(entry.RegexFlag, "i") {
+				expr = "(?i)" + expr
+			}
+			re, err := regexp.Compile(expr)
+			if err != nil {
+				warnings = append(warnings, fmt.Errorf("skipping %s entry %d: %v", section, i, err))
+				continue
+			}
+			matchers = append(matchers, &matcher{re: re, replacements: replacements(entry), groups: groups})
+		}
+		return matchers
+	}
+
+	p := &parser{
+		userAgents: compile("user_agent_parsers", raw.UserAgentParsers, []int{1, 2, 3, 4}, func(e rawParser) []string {
+			return []string{e.FamilyReplacement, e.V1Replacement, e.V2Replacement, e.V3Replacement}
+		}),
+		os: compile("os_parsers", raw.OSParsers, []int{1, 2, 3, 4}, func(e rawParser) []string {
+			return []string{e.OSReplacement, e.OSV1Replacement, e.OSV2Replacement, e.OSV3Replacement}
+		}),
+		devices: compile("device_parsers", raw.DeviceParsers, []int{1}, func(e rawParser) []string {
+			return []string{e.DeviceReplacement}
+		}),
+	}
+	return p, warnings, nil
+}
+
+// loadParserFile reads a uap-core regexes file.
+func loadParserFile(path string) (*parser, []error, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	p, warnings, err := newParser(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%v in %v", err, path)
+	}
+	return p, warnings, nil
+}
+
+var (
+	defaultParserOnce sync.Once
+	defaultParser     *parser
+	defaultParserErr  error
+)
+
+// getDefaultParser returns the parser of the embedded regexes.
+func getDefaultParser() (*parser, error) {
+	defaultParserOnce.Do(func() {
+		var data []byte
+		data, defaultParserErr = asset.DecodeData(regexesAsset())
+		if defaultParserErr == nil {
+			defaultParser, _, defaultParserErr = newParser(data)
+		}
+	})
+	if defaultParserErr != nil {
+		return nil, fmt.Errorf("failed to load the embedded user agent regexes: %v", defaultParserErr)
+	}
+	return defaultParser, nil
+}
+
+// userAgent is the result of parsing a user agent string.
+type userAgent struct {
+	Name    string
+	Version []string
+
+	OS        string
+	OSVersion []string
+
+	Device string
+}
+
+// parse extracts the browser, operating system and device. The name and the
+// device are `Other` if no regex matches.
+func (p *parser) parse(s string) userAgent {
+	ua := userAgent{Name: other, OS: other, Device: other}
+
+	for _, m := range p.userAgents {
+		if values, ok := m.match(s); ok && values[0] != "" {
+			ua.Name, ua.Version = values[0], values[1:]
+			break
+		}
+	}
+	for _, m := range p.os {
+		if values, ok := m.match(s); ok && values[0] != "" {
+			ua.OS, ua.OSVersion = values[0], values[1:]
+			break
+		}
+	}
+	for _, m := range p.devices {
+		if values, ok := m.match(s); ok && values[0] != "" {
+			ua.Device = values[0]
+			break
+		}
+	}
+	return ua
+}
+
+// toMapStr returns the ECS user_agent fields.
+func (ua userAgent) toMapStr() common.MapStr {
+	fields := common.MapStr{
+		"name":   ua.Name,
+		"device": common.MapStr{"name": ua.Device},
+	}
+	if version := joinVersion(ua.Version); version != "" {
+		fields["version"] = version
+	}
+	if ua.OS != other {
+		os := common.MapStr{"name": ua.OS, "full": ua.OS}
+		if version := joinVersion(ua.OSVersion); version != "" {
+			os["version"] = version
+			os["full"] = ua.OS + " " + version
+		}
+		fields["os"] = os
+	}
+	return fields
+}
+
+// joinVersion joins the version components up to the first empty one.
+func joinVersion(parts []string) string {
+	var version []string
+	for _, part := range parts {
+		if part == "" {
+			break
+		}
+		version = append(version, part)
+	}
+	return strings.Join(version, ".")
+}
@@ -0,0 +1,172 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package user_agent
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/asset"
+	"github.com/njcx/libbeat_v6/common"
+)
+
+func TestRegexesAsset(t *testing.T) {
+	expected, err := ioutil.ReadFile("regexes/regexes.yaml")
+	require.NoError(t, err)
+
+	data, err := asset.DecodeData(regexesAsset())
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(data), "regexes.go is outdated")
+
+	_, warnings, err := newParser(data)
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+}
+
+func TestDefaultParser(t *testing.T) {
+	p, err := getDefaultParser()
+	require.NoError(t, err)
+
+	tests := []struct {
+		ua       string
+		expected common.MapStr
+	}{
+		{
+			ua: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			expected: common.MapStr{
+				"name":    "Chrome",
+				"version": "120.0.0",
+				"os":      common.MapStr{"name": "Windows", "version": "10", "full": "Windows 10"},
+				"device":  common.MapStr{"name": "Other"},
+			},
+		},
+		{
+			ua: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.2210.91",
+			expected: common.MapStr{
+				"name":    "Edge",
+				"version": "120.0.2210",
+				"os":      common.MapStr{"name": "Windows", "version": "10", "full": "Windows 10"},
+				"device":  common.MapStr{"name": "Other"},
+			},
+		},
+		{
+			ua: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15",
+			expected: common.MapStr{
+				"name":    "Safari",
+				"version": "17.1",
+				"os":      common.MapStr{"name": "Mac OS X", "version": "10.15.7", "full": "Mac OS X 10.15.7"},
+				"device":  common.MapStr{"name": "Mac"},
+			},
+		},
+		{
+			ua: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_1_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1.2 Mobile/15E148 Safari/604.1",
+			expected: common.MapStr{
+				"name":    "Mobile Safari",
+				"version": "17.1.2",
+				"os":      common.MapStr{"name": "iOS", "version": "17.1.2", "full": "iOS 17.1.2"},
+				"device":  common.MapStr{"name": "iPhone"},
+			},
+		},
+		{
+			ua: "Mozilla/5.0 (Linux; Android 14; Pixel 7 Pro) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.144 Mobile Safari/537.36",
+			expected: common.MapStr{
+				"name":    "Chrome Mobile",
+				"version": "120.0.6099",
+				"os":      common.MapStr{"name": "Android", "version": "14", "full": "Android 14"},
+				"device":  common.MapStr{"name": "Pixel 7 Pro"},
+			},
+		},
+		{
+			ua: "Mozilla/5.0 (Linux; Android 13; SM-S918B) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/23.0 Chrome/115.0.0.0 Mobile Safari/537.36",
+			expected: common.MapStr{
+				"name":    "Samsung Internet",
+				"version": "23.0",
+				"os":      common.MapStr{"name": "Android", "version": "13", "full": "Android 13"},
+				"device":  common.MapStr{"name": "Samsung SM-S918B"},
+			},
+		},
+		{
+			ua: "Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0",
+			expected: common.MapStr{
+				"name":    "Firefox",
+				"version": "121.0",
+				"os":      common.MapStr{"name": "Ubuntu", "full": "Ubuntu"},
+				"device":  common.MapStr{"name": "Other"},
+			},
+		},
+		{
+			ua: "Mozilla/5.0 (Windows NT 6.1; Trident/7.0; rv:11.0) like Gecko",
+			expected: common.MapStr{
+				"name":    "IE",
+				"version": "11.0",
+				"os":      common.MapStr{"name": "Windows", "version": "7", "full": "Windows 7"},
+				"device":  common.MapStr{"name": "Other"},
+			},
+		},
+		{
+			ua: "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			expected: common.MapStr{
+				"name":    "Googlebot",
+				"version": "2.1",
+				"device":  common.MapStr{"name": "Spider"},
+			},
+		},
+		{
+			ua: "curl/8.4.0",
+			expected: common.MapStr{
+				"name":    "curl",
+				"version": "8.4.0",
+				"device":  common.MapStr{"name": "Other"},
+			},
+		},
+		{
+			ua: "something unknown",
+			expected: common.MapStr{
+				"name":   "Other",
+				"device": common.MapStr{"name": "Other"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.ua, func(t *testing.T) {
+			assert.Equal(t, test.expected, p.parse(test.ua).toMapStr())
+		})
+	}
+}
+
+func TestParserFile(t *testing.T) {
+	p, warnings, err := loadParserFile("testdata/regexes.yaml")
+	require.NoError(t, err)
+
+	// lookbehinds are not supported by Go regular expressions
+	assert.Len(t, warnings, 1)
+
+	assert.Equal(t, common.MapStr{
+		"name":    "My MyAgent",
+		"version": "1.2",
+		"os":      common.MapStr{"name": "MyOS", "version": "3", "full": "MyOS 3"},
+		"device":  common.MapStr{"name": "MyDevice"},
+	}, p.parse("MyAgent/1.2 (MyOS 3; MyDevice)").toMapStr())
+
+	_, _, err = newParser([]byte("other: []"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,26 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Code generated from regexes/regexes.yaml - DO NOT EDIT.
+
+package user_agent
+
+// regexesAsset returns the user agent regexes of regexes/regexes.yaml,
+// encoded with asset.EncodeData.
+func regexesAsset() string {
+	return "eNqtWFtz2jgUfs+v0EwyE0xik2Qv3SYPDOTS0C4NE8ilC5QRtgBtbMsryYFs3f++R5ZIsCEOpfsAyLLOd75zlcQ2uhGEIzwmoUScjMmMCERDJCcEjRgPsERslD7FOLJdxgmKOPubuHJrG5UmUkbiuFIZUzmJh47LgkqM7QhzwKzMBSwHdUB+Dh4LkuJ9YGoq9jEHJDKLOBGCshCJp1DiGSpdnx9Z+8hn7AFzFoeeQDj00BC7D5yMCCehC2AY+IRMIhFHEeOSeM7WNsApfZqFUPQJdidIAGeFr0Qkp8RTZjLuEb6vraVcSAQGuxMajjVdgJrSUDjoJvSBHkxGPnaJtygxBnYRokJP4YD6TylT7UHfZ4AwBqB0nWasXj0CN0UHfBaxELwPWq41fKCekItD9GzpXHoKjgasnUMkGdp5r601nnXGDJFgSDxFBfiMqE9SJkEMNIc6AiHhGNyE8EhC2APm0dGTMpfKfeU3IuS1RqsJQSTYQ33QOlFQVCorWSw9nDp6CyLJB2nmDIyzj7cQ2kZ1JnWwXI6nPkzDrK1ZHqPd0gfGxj4ZMpk8j+xGADhJzRMga+vppEk8igFYAmcxnxwCWSVax9SLRUQhfMkX0EVmIJmcxe6D+qhx2495lNSiSOsagWOHkExkBoaH2J9QmXTAnfCkXv9JwwfiNUIlWZuA30WKQQIei4kaNj8eHqmFLSKxDxNWqXpcKfW8PTXoOenIqi6Orepu1vBuzf4L2/8e2O979qBfhrXd+rAPmN1Tt69dlXTbop9aZSncLqr039SR+vyy02kh16dp6ijf+3TIMaR51vlfS27M/eRuTGQSPckJC21O/okh7CJp6Wd4D7IQG1tVt60xE/agnsCdUErEvoTxqX7RYkIGOLyOQ0kDkuAZZSIJmUfsEYFSSgBrOp3aEQG1iiQlVrHbdnOEP+JHbETMmuxqnXKcTdNqH2IB2Q11dTrhLKBxsA+ZP1JtK50g2Yice5B18FVTX/SqrX7WoIdMnQ/4S8ECnELLsm+yoaqcNh5BKJxy6ap1XWTKq8hX4D6MNFouqQAyuWp14AP8NwfPobZxIOJwbNy6EayBQI1QFRyROQ1f8M+A65Kfhz0HfXP6M9A3p6/A3tJH7Hu0GHQxuXzYcTgL1xa4JNhT24zO1HXFet3SRb32ObmoDxq1ulVxylV4vt04iy9MozSVpVPP1FDdhzaZyz/1HrTu3eoNLcN6d9XabgX1s4tSRdrqtHddUGjAbKbKGJI6i3HKN81zo8DUJKDk2F3MNkWe800xF4zJ4J+g6WPPcvZKq8K74K+12N+R4S0l05wFBcipJWpQXWgj66hapQLa6tpJ/XYypw4zLszKVo9roccZ9RLNJengoU+kBZ3UrP+pcK00cA3glLDu6VlZ2mJeQluwixL4wZ61uioW0ZxyF+31NVgB5cxGsvum1vTUAznyicp1QdFNo3L3aWVemSBA6hprrAJzNFqlQK2By3fX/wN67p80QvONB53PIh+6F88q7HA4ZoXSqrzrOQdOmT8er1mJjfMc9Wa7cW6hH5DeYiJ7Yr6joQdbThbWTKI0ssoVCNpTFRX017nE5w46PACrDA/QluVg1j2/fTzMLTg8eB35957zy8bAfziHhchHmyMX4h5ujPuuEHdzF99SIfHr2L8B52T+fN/aWM19a7WO+a6re0B2iSlQq4t69hv3j93Vkvk9oHWDQEr3KGRV4aBtxpDROqEHczWDTP9eMnh5217ofIlqhVaRZGrzGREPkkX5c4Xi8nJFG/T3UPHesqTB7JtLDJvYhUl0b0ztDpxnpz6Pl1w5F0pgQEPJxOQ1w+Yr86fgIVzJ4uSCeIzj5IwMKQ6TUxABO9e9WmZ3Rk5IvX2WwCkuVL+fiYSffKzhIh3PVHZ55JG65If+GSi8EJsL/Yp7vHGMUZjbEVJZE/h0W1wje7JhyHh/pRJYNNegCyB38CuX2k1bpRbkVX+vkK65NO3k2qTCaNEZ8dU28JKkAFaupltDPaa+l/Qsa1muenx5U7s7bySXMZ4Smpb1oF/N/B1RzEoLriR1TbyAdr+eWP1yNWlS9Dzsed9+/f5i8xLHV1TdU8yay6qMX4G65/TLJ6qbdIE7wPe/HX3vKoMWnk+gx5RSJnsL3qlobla1Zwik4IORj8eqPexu/QdD8msd"
+}
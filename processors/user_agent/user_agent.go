@@ -0,0 +1,182 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package user_agent
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/atomic"
+	"github.com/njcx/libbeat_v6/common/file"
+	"github.com/njcx/libbeat_v6/common/lru"
+	"github.com/njcx/libbeat_v6/logp"
+	"github.com/njcx/libbeat_v6/monitoring"
+	"github.com/njcx/libbeat_v6/paths"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+const processorName = "user_agent"
+
+var instanceID atomic.Uint32
+
+func init() {
+	processors.RegisterPlugin(processorName, newUserAgentProcessor)
+}
+
+type processor struct {
+	config   config
+	cache    *lru.Cache
+	log      *logp.Logger
+	reloader *file.Reloader // nil if the embedded regexes are used
+
+	mu     sync.RWMutex
+	parser *parser
+
+	metricsName string
+	reloads     *monitoring.Int
+}
+
+func newUserAgentProcessor(cfg *common.Config) (processors.Processor, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, errors.Wrapf(err, "fail to unpack the %v configuration", processorName)
+	}
+
+	var id = int(instanceID.Inc())
+	p := &processor{
+		config: c,
+		log:    logp.NewLogger(processorName).With("instance_id", id),
+	}
+
+	if c.RegexFile == "" {
+		parser, err := getDefaultParser()
+		if err != nil {
+			return nil, err
+		}
+		p.parser = parser
+	} else {
+		p.config.RegexFile = paths.Resolve(paths.Config, c.RegexFile)
+		p.reloader = file.NewReloader(p.config.RegexFile)
+		if err := p.loadRegexFile(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Metrics (each processor instance has a unique ID).
+	p.metricsName = processorName + "." + strconv.Itoa(id)
+	metrics := monitoring.Default.NewRegistry(p.metricsName, monitoring.DoNotReport)
+	p.cache = lru.New(metrics.NewRegistry("cache"), c.CacheSize)
+	p.reloads = monitoring.NewInt(metrics, "reloads")
+
+	if p.reloader != nil {
+		p.reloader.Start(c.ReloadPeriod, p.reload)
+	}
+	return p, nil
+}
+
+func (p *processor) Run(event *beat.Event) (*beat.Event, error) {
+	v, err := event.GetValue(p.config.Field)
+	if err != nil {
+		if p.config.IgnoreMissing && errors.Cause(err) == common.ErrKeyNotFound {
+			return event, nil
+		}
+		return event, errors.Wrapf(err, "failed to get the user agent from %v", p.config.Field)
+	}
+
+	str, ok := v.(string)
+	if !ok {
+		return event, fmt.Errorf("field %v is not a string, value: `%v`", p.config.Field, v)
+	}
+	if str == "" {
+		return event, nil
+	}
+
+	info, found := p.cache.Get(str)
+	if !found {
+		gen := p.cache.Generation()
+		p.mu.RLock()
+		info = p.parser.parse(str).toMapStr()
+		p.mu.RUnlock()
+		p.cache.Set(str, info, gen)
+	}
+
+	prefix := ""
+	if p.config.Target != "" {
+		prefix = p.config.Target + "."
+	}
+	for key, value := range info {
+		// Cached results are shared, give every event its own copy.
+		if m, ok := value.(common.MapStr); ok {
+			value = m.Clone()
+		}
+		if _, err := event.PutValue(prefix+key, value); err != nil {
+			return event, err
+		}
+	}
+	return event, nil
+}
+
+// loadRegexFile reads the regex file and replaces the parser. The file is
+// parsed outside of the lock, events only wait for the swap.
+func (p *processor) loadRegexFile() error {
+	parser, warnings, err := loadParserFile(p.config.RegexFile)
+	if err != nil {
+		return err
+	}
+	for _, w := range warnings {
+		p.log.Warnf("Invalid regex in %v: %v", p.config.RegexFile, w)
+	}
+
+	p.mu.Lock()
+	p.parser = parser
+	p.mu.Unlock()
+	return nil
+}
+
+// reload reads the regex file after it changed. It is called by the reloader,
+// events are parsed with the previous regexes until the new ones are swapped
+// in. Cached results are discarded after a reload.
+func (p *processor) reload() {
+	if err := p.loadRegexFile(); err != nil {
+		p.log.Errorf("Failed to reload user agent regexes, keeping the previous ones: %v", err)
+		return
+	}
+	p.log.Infof("Reloaded user agent regexes %v", p.config.RegexFile)
+	p.reloads.Inc()
+	p.cache.Clear()
+}
+
+// Close stops reloading the regex file and removes the metrics of the
+// processor.
+func (p *processor) Close() error {
+	if p.reloader != nil {
+		p.reloader.Close()
+	}
+	monitoring.Default.Remove(p.metricsName)
+	return nil
+}
+
+func (p *processor) String() string {
+	return fmt.Sprintf("user_agent=[field=%v, target=%v, regex_file=%v]",
+		p.config.Field, p.config.Target, p.config.RegexFile)
+}
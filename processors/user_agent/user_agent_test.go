@@ -0,0 +1,231 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package user_agent
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/common/file/filetest"
+	"github.com/njcx/libbeat_v6/monitoring"
+	"github.com/njcx/libbeat_v6/processors"
+)
+
+const chromeUA = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36"
+
+const curlRegexes = "user_agent_parsers:\n  - regex: '(curl)/(\\d+)'\n"
+
+func TestProcessor(t *testing.T) {
+	proc, err := newUserAgentProcessor(common.MustNewConfigFrom(map[string]interface{}{}))
+	require.NoError(t, err)
+	p := proc.(*processor)
+	defer p.Close()
+
+	for i := 0; i < 2; i++ {
+		event, err := p.Run(&beat.Event{Fields: common.MapStr{
+			"user_agent": common.MapStr{"original": chromeUA},
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, common.MapStr{
+			"user_agent": common.MapStr{
+				"original": chromeUA,
+				"name":     "Chrome",
+				"version":  "120.0.0",
+				"os":       common.MapStr{"name": "Windows", "version": "10", "full": "Windows 10"},
+				"device":   common.MapStr{"name": "Other"},
+			},
+		}, event.Fields)
+	}
+
+	metrics := monitoring.Default.GetRegistry(p.metricsName)
+	assert.Equal(t, int64(1), metrics.Get("cache.hits").(*monitoring.Int).Get())
+	assert.Equal(t, int64(1), metrics.Get("cache.misses").(*monitoring.Int).Get())
+}
+
+func TestProcessorTarget(t *testing.T) {
+	p, err := newUserAgentProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"field":  "agent",
+		"target": "",
+	}))
+	require.NoError(t, err)
+	defer processors.Close(p)
+
+	event, err := p.Run(&beat.Event{Fields: common.MapStr{"agent": "curl/8.4.0"}})
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{
+		"agent":   "curl/8.4.0",
+		"name":    "curl",
+		"version": "8.4.0",
+		"device":  common.MapStr{"name": "Other"},
+	}, event.Fields)
+}
+
+func TestProcessorInvalidField(t *testing.T) {
+	p, err := newUserAgentProcessor(common.MustNewConfigFrom(map[string]interface{}{}))
+	require.NoError(t, err)
+	defer processors.Close(p)
+
+	_, err = p.Run(&beat.Event{Fields: common.MapStr{}})
+	assert.Error(t, err)
+
+	_, err = p.Run(&beat.Event{Fields: common.MapStr{"user_agent": common.MapStr{"original": 1}}})
+	assert.Error(t, err)
+
+	p, err = newUserAgentProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"ignore_missing": true,
+	}))
+	require.NoError(t, err)
+	defer processors.Close(p)
+
+	event, err := p.Run(&beat.Event{Fields: common.MapStr{}})
+	assert.NoError(t, err)
+	assert.Equal(t, common.MapStr{}, event.Fields)
+}
+
+func TestProcessorReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "user_agent")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "regexes.yaml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(curlRegexes), 0600))
+
+	proc, err := newUserAgentProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"field":         "agent",
+		"target":        "ua",
+		"regex_file":    file,
+		"reload.period": "10ms",
+	}))
+	require.NoError(t, err)
+	p := proc.(*processor)
+	defer p.Close()
+
+	assert.Equal(t, "curl", parseName(t, p, "curl/8.4.0"))
+
+	filetest.Replace(t, file, []byte(curlRegexes+"    family_replacement: 'cURL'\n"))
+	filetest.WaitFor(t, func() bool { return p.reloads.Get() == 1 })
+	assert.Equal(t, "cURL", parseName(t, p, "curl/8.4.0"))
+
+	// an invalid file keeps the previous regexes
+	filetest.Replace(t, file, []byte("user_agent_parsers: ["))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int64(1), p.reloads.Get())
+	assert.Equal(t, "cURL", parseName(t, p, "curl/8.4.0"))
+}
+
+func TestProcessorConcurrentReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "user_agent")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "regexes.yaml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(curlRegexes), 0600))
+
+	proc, err := newUserAgentProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"field":         "agent",
+		"target":        "ua",
+		"regex_file":    file,
+		"reload.period": "1ms",
+		"cache.size":    1,
+	}))
+	require.NoError(t, err)
+	p := proc.(*processor)
+	defer p.Close()
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				event, err := p.Run(&beat.Event{Fields: common.MapStr{"agent": "curl/8.4.0"}})
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if _, err := event.GetValue("ua.name"); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+
+	for i := 1; i <= 3; i++ {
+		filetest.Replace(t, file, []byte(fmt.Sprintf("%v    family_replacement: 'curl-%d'\n", curlRegexes, i)))
+		reloads := int64(i)
+		filetest.WaitFor(t, func() bool { return p.reloads.Get() == reloads })
+	}
+	close(done)
+	wg.Wait()
+
+	// results of the previous regexes are not cached after a reload
+	assert.Equal(t, "curl-3", parseName(t, p, "curl/8.4.0"))
+}
+
+func TestProcessorClose(t *testing.T) {
+	dir, err := ioutil.TempDir("", "user_agent")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "regexes.yaml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(curlRegexes), 0600))
+
+	proc, err := newUserAgentProcessor(common.MustNewConfigFrom(map[string]interface{}{
+		"field":         "agent",
+		"target":        "ua",
+		"regex_file":    file,
+		"reload.period": "1ms",
+	}))
+	require.NoError(t, err)
+	p := proc.(*processor)
+	require.NotNil(t, monitoring.Default.GetRegistry(p.metricsName))
+
+	require.NoError(t, processors.Close(proc))
+	require.NoError(t, processors.Close(proc))
+	assert.Nil(t, monitoring.Default.GetRegistry(p.metricsName))
+
+	// the regexes are not reloaded after Close
+	filetest.Replace(t, file, []byte(curlRegexes+"    family_replacement: 'cURL'\n"))
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, "curl", parseName(t, p, "curl/8.4.0"))
+}
+
+func parseName(t *testing.T, p processors.Processor, agent string) interface{} {
+	event, err := p.Run(&beat.Event{Fields: common.MapStr{"agent": agent}})
+	require.NoError(t, err)
+	name, _ := event.GetValue("ua.name")
+	return name
+}
@@ -38,6 +38,10 @@ type client struct {
 	mutex      sync.Mutex
 	acker      acker
 
+	// multi is set if the processors can return multiple events for one
+	// published event.
+	multi *multiEventACK
+
 	eventFlags   publisher.EventFlags
 	canDrop      bool
 	reportEvents bool
@@ -78,6 +82,11 @@ func (c *client) publish(e beat.Event) {
 		return
 	}
 
+	if c.multi != nil {
+		c.publishMulti(e)
+		return
+	}
+
 	if c.processors != nil {
 		var err error
 
@@ -106,7 +115,67 @@ func (c *client) publish(e beat.Event) {
 		return
 	}
 
-	e = *event
+	c.send(*event)
+}
+
+// publishMulti runs the processors on e if they contain multi-event
+// processors. The events returned are ACKed as one event: the Private field of
+// e is reported to the ACK handlers once all events have been ACKed.
+func (c *client) publishMulti(e beat.Event) {
+	log := c.pipeline.logger
+	private := e.Private
+
+	events, err := c.processors.(beat.MultiProcessor).RunMulti(&e)
+	if err != nil {
+		log.Errorf("Failed to publish event: %v", err)
+	}
+
+	if len(events) == 0 {
+		// Register the event before adding it to the acker, as dropped events
+		// can be ACKed right away.
+		pending := c.multi.add(1, private)
+		if !c.acker.addEvent(e, false) {
+			c.multi.remove(pending)
+			c.onDroppedOnPublish(e)
+			return
+		}
+		c.onFilteredOut(e)
+		return
+	}
+
+	// Published events are ACKed by the queue only, so the events can be
+	// registered after they have been added to the acker. If the client is
+	// closing down, only the events added are sent, the others are reported
+	// as dropped.
+	added := 0
+	for _, event := range events {
+		event.Private = nil
+		if !c.acker.addEvent(*event, true) {
+			break
+		}
+		added++
+	}
+	if added > 0 {
+		c.multi.add(added, private)
+	}
+
+	for i, event := range events {
+		if i > 0 {
+			// every additional event is accounted for in the pipeline metrics
+			c.onNewEvent()
+		}
+		if i >= added {
+			c.onDroppedOnPublish(*event)
+		}
+	}
+
+	for _, event := range events[:added] {
+		c.send(*event)
+	}
+}
+
+// send pushes a processed event to the queue.
+func (c *client) send(e beat.Event) {
 	pubEvent := publisher.Event{
 		Content: e,
 		Flags:   c.eventFlags,
@@ -18,6 +18,7 @@
 package pipeline
 
 import (
+	"sync"
 	"time"
 
 	"github.com/njcx/libbeat_v6/beat"
@@ -33,6 +34,7 @@ func (p *Pipeline) makeACKer(
 	canDrop bool,
 	cfg *beat.ClientConfig,
 	waitClose time.Duration,
+	multi *multiEventACK,
 ) acker {
 	var (
 		bld   = p.ackBuilder
@@ -42,17 +44,17 @@ func (p *Pipeline) makeACKer(
 	sema := p.eventSema
 	switch {
 	case cfg.ACKCount != nil:
-		acker = bld.createCountACKer(canDrop, sema, cfg.ACKCount)
+		acker = bld.createCountACKer(canDrop, sema, multi, cfg.ACKCount)
 	case cfg.ACKEvents != nil:
-		acker = bld.createEventACKer(canDrop, sema, cfg.ACKEvents)
+		acker = bld.createEventACKer(canDrop, sema, multi, cfg.ACKEvents)
 	case cfg.ACKLastEvent != nil:
 		cb := lastEventACK(cfg.ACKLastEvent)
-		acker = bld.createEventACKer(canDrop, sema, cb)
+		acker = bld.createEventACKer(canDrop, sema, multi, cb)
 	default:
 		if waitClose <= 0 {
-			return bld.createPipelineACKer(canDrop, sema)
+			return bld.createPipelineACKer(canDrop, sema, multi)
 		}
-		acker = bld.createCountACKer(canDrop, sema, func(_ int) {})
+		acker = bld.createCountACKer(canDrop, sema, multi, func(_ int) {})
 	}
 
 	if waitClose <= 0 {
@@ -114,3 +116,86 @@ func buildClientEventACK(
 	guard.lift(newEventACK(pipeline, canDrop, sema, mk(guard)))
 	return guard
 }
+
+// multiEventACK folds the ACKs of the events returned by multi-event
+// processors into one ACK for the event published by the beat. Events are
+// ACKed in order, so the published events are kept in a FIFO, together with
+// the number of events the processors returned for them.
+// The client and pipeline ACK handlers both receive the folded ACKs.
+type multiEventACK struct {
+	mutex   sync.Mutex
+	pending []*multiEvent
+	acked   int // number of ACKed events of pending[0]
+}
+
+type multiEvent struct {
+	events  int
+	private interface{}
+}
+
+// add registers a published event for which n events are added to the
+// acker. The returned entry can be removed again, if the events could not be
+// added to the acker.
+func (m *multiEventACK) add(n int, private interface{}) *multiEvent {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	e := &multiEvent{events: n, private: private}
+	m.pending = append(m.pending, e)
+	return e
+}
+
+// remove unregisters an event none of whose events have been added to the
+// acker.
+func (m *multiEventACK) remove(e *multiEvent) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for i, pending := range m.pending {
+		if pending == e {
+			m.pending = append(m.pending[:i], m.pending[i+1:]...)
+			return
+		}
+	}
+}
+
+// ack consumes n ACKed events. It returns the Private fields of the published
+// events for which all events have been ACKed.
+func (m *multiEventACK) ack(n int) []interface{} {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var data []interface{}
+	for n > 0 && len(m.pending) > 0 {
+		missing := m.pending[0].events - m.acked
+		if n < missing {
+			m.acked += n
+			break
+		}
+
+		n -= missing
+		m.acked = 0
+		data = append(data, m.pending[0].private)
+		m.pending[0] = nil
+		m.pending = m.pending[1:]
+	}
+	return data
+}
+
+// foldCount returns the number of published events completed by an ACK of
+// total events. total is returned as is if m is nil.
+func (m *multiEventACK) foldCount(total int) int {
+	if m == nil {
+		return total
+	}
+	return len(m.ack(total))
+}
+
+// foldEvents returns the Private fields of the published events completed by
+// an ACK of the given events. data is returned as is if m is nil.
+func (m *multiEventACK) foldEvents(data []interface{}) []interface{} {
+	if m == nil {
+		return data
+	}
+	return m.ack(len(data))
+}
@@ -0,0 +1,152 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common/atomic"
+)
+
+func TestMultiEventACK(t *testing.T) {
+	t.Run("events", func(t *testing.T) {
+		multi := &multiEventACK{}
+		multi.add(3, "a")
+		multi.add(1, "b")
+		multi.add(2, "c")
+
+		assert.Empty(t, multi.foldEvents(make([]interface{}, 2)))
+		assert.Equal(t, []interface{}{"a", "b"}, multi.foldEvents(make([]interface{}, 2)))
+		assert.Equal(t, []interface{}{"c"}, multi.foldEvents(make([]interface{}, 2)))
+	})
+
+	t.Run("count", func(t *testing.T) {
+		multi := &multiEventACK{}
+		multi.add(2, nil)
+		multi.add(2, nil)
+		multi.add(1, nil)
+
+		assert.Equal(t, 0, multi.foldCount(1))
+		assert.Equal(t, 3, multi.foldCount(4))
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		multi := &multiEventACK{}
+		multi.add(1, "a")
+		dropped := multi.add(1, "b")
+		multi.add(2, "c")
+		multi.remove(dropped)
+
+		assert.Equal(t, []interface{}{"a", "c"}, multi.foldEvents(make([]interface{}, 3)))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		var multi *multiEventACK
+		assert.Equal(t, 3, multi.foldCount(3))
+		assert.Equal(t, []interface{}{"a"}, multi.foldEvents([]interface{}{"a"}))
+	})
+}
+
+func TestMultiEventPipelineACK(t *testing.T) {
+	type counts struct{ total, acked int }
+
+	// addSplitEvents adds two published events, split into 3 and 2 events.
+	addSplitEvents := func(acker acker, multi *multiEventACK) {
+		for i, n := range []int{3, 2} {
+			for j := 0; j < n; j++ {
+				acker.addEvent(beat.Event{}, true)
+			}
+			multi.add(n, i)
+		}
+	}
+
+	t.Run("count", func(t *testing.T) {
+		pipelineACKs := make(chan counts, 10)
+		clientACKs := make(chan int, 10)
+
+		p := &Pipeline{ackActive: atomic.MakeBool(true), eventSema: newSema(10)}
+		p.ackBuilder = &pipelineCountACK{
+			pipeline: p,
+			cb:       func(total, acked int) { pipelineACKs <- counts{total, acked} },
+		}
+
+		multi := &multiEventACK{}
+		cfg := beat.ClientConfig{ACKCount: func(n int) { clientACKs <- n }}
+		acker := p.makeACKer(true, &cfg, 0, multi)
+		defer acker.close()
+		addSplitEvents(acker, multi)
+
+		// the queue ACKs are passed as is, the events are folded
+		waitPipeline := func() counts {
+			select {
+			case c := <-pipelineACKs:
+				return c
+			case <-time.After(5 * time.Second):
+				t.Fatal("timeout waiting for pipeline ACK")
+				return counts{}
+			}
+		}
+
+		acker.ackEvents(2)
+		assert.Equal(t, counts{0, 2}, waitPipeline())
+		acker.ackEvents(3)
+		assert.Equal(t, counts{2, 3}, waitPipeline())
+
+		select {
+		case n := <-clientACKs:
+			assert.Equal(t, 2, n)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for client ACK")
+		}
+	})
+
+	t.Run("events", func(t *testing.T) {
+		pipelineACKs := make(chan []interface{}, 10)
+
+		p := &Pipeline{ackActive: atomic.MakeBool(true), eventSema: newSema(10)}
+		p.ackBuilder = &pipelineEventsACK{
+			pipeline: p,
+			cb:       func(data []interface{}, acked int) { pipelineACKs <- data },
+		}
+
+		// client without ACK handler
+		multi := &multiEventACK{}
+		acker := p.makeACKer(true, &beat.ClientConfig{}, 0, multi)
+		defer acker.close()
+		addSplitEvents(acker, multi)
+
+		waitPipeline := func() []interface{} {
+			select {
+			case data := <-pipelineACKs:
+				return data
+			case <-time.After(5 * time.Second):
+				t.Fatal("timeout waiting for pipeline ACK")
+				return nil
+			}
+		}
+
+		acker.ackEvents(4)
+		assert.Equal(t, []interface{}{0}, waitPipeline())
+		acker.ackEvents(1)
+		assert.Equal(t, []interface{}{1}, waitPipeline())
+	})
+}
@@ -51,6 +51,8 @@ type outputGroup struct {
 
 	batchSize  int
 	timeToLive int // event lifetime
+
+	onClose func() // release resources shared by the outputs
 }
 
 type workQueue chan *Batch
@@ -91,9 +93,7 @@ func (c *outputController) Close() error {
 	c.consumer.sigPause()
 
 	if c.out != nil {
-		for _, out := range c.out.outputs {
-			out.Close()
-		}
+		c.out.close()
 		close(c.out.workQueue)
 	}
 
@@ -116,6 +116,7 @@ func (c *outputController) Set(outGrp outputs.Group) {
 		outputs:    worker,
 		timeToLive: outGrp.Retry + 1,
 		batchSize:  outGrp.BatchSize,
+		onClose:    outGrp.OnClose,
 	}
 
 	// update consumer and retryer
@@ -133,9 +134,7 @@ func (c *outputController) Set(outGrp outputs.Group) {
 
 	// close old group, so events are send to new workQueue via retryer
 	if c.out != nil {
-		for _, w := range c.out.outputs {
-			w.Close()
-		}
+		c.out.close()
 	}
 
 	c.out = grp
@@ -146,6 +145,16 @@ func (c *outputController) Set(outGrp outputs.Group) {
 	c.observer.updateOutputGroup()
 }
 
+// close stops all workers of the group, closing their clients.
+func (g *outputGroup) close() {
+	for _, w := range g.outputs {
+		w.Close()
+	}
+	if g.onClose != nil {
+		g.onClose()
+	}
+}
+
 func makeWorkQueue() workQueue {
 	return workQueue(make(chan *Batch, 0))
 }
@@ -70,6 +70,7 @@ func Load(
 	if err != nil {
 		return nil, fmt.Errorf("error initializing processors: %v", err)
 	}
+	processors.SetBeatInfo(beatInfo)
 
 	name := beatInfo.Name
 	settings := Settings{
@@ -94,16 +95,19 @@ func Load(
 
 	queueBuilder, err := createQueueBuilder(config.Queue, monitors)
 	if err != nil {
+		processors.Close()
 		return nil, err
 	}
 
 	out, err := loadOutput(beatInfo, monitors, outcfg)
 	if err != nil {
+		processors.Close()
 		return nil, err
 	}
 
 	p, err := New(beatInfo, monitors, monitors.Metrics, queueBuilder, out, settings)
 	if err != nil {
+		processors.Close()
 		return nil, err
 	}
 
@@ -287,6 +287,11 @@ func (p *Pipeline) Close() error {
 		log.Error("pipeline queue shutdown error: ", err)
 	}
 
+	// release resources held by the global processors
+	if err := processors.Close(p.processors.processors); err != nil {
+		log.Error("pipeline processors shutdown error: ", err)
+	}
+
 	p.observer.cleanup()
 	return nil
 }
@@ -336,7 +341,15 @@ func (p *Pipeline) ConnectWith(cfg beat.ClientConfig) (beat.Client, error) {
 	}
 
 	processors := newProcessorPipeline(p.beatInfo, p.processors, cfg)
-	acker := p.makeACKer(processors != nil, &cfg, waitClose)
+
+	// ACKs of events split by multi-event processors are folded into one
+	// ACK of the published event.
+	var multi *multiEventACK
+	if prog, ok := processors.(*program); ok && prog.isMulti() {
+		multi = &multiEventACK{}
+	}
+
+	acker := p.makeACKer(processors != nil, &cfg, waitClose, multi)
 	producerCfg := queue.ProducerConfig{
 		// Cancel events from queue if acker is configured
 		// and no pipeline-wide ACK handler is registered.
@@ -368,6 +381,7 @@ func (p *Pipeline) ConnectWith(cfg beat.ClientConfig) (beat.Client, error) {
 		processors:   processors,
 		producer:     producer,
 		acker:        acker,
+		multi:        multi,
 		eventFlags:   eventFlags,
 		canDrop:      canDrop,
 		reportEvents: reportEvents,
@@ -24,23 +24,29 @@ import (
 )
 
 type ackBuilder interface {
-	createPipelineACKer(canDrop bool, sema *sema) acker
-	createCountACKer(canDrop bool, sema *sema, fn func(int)) acker
-	createEventACKer(canDrop bool, sema *sema, fn func([]interface{})) acker
+	createPipelineACKer(canDrop bool, sema *sema, multi *multiEventACK) acker
+	createCountACKer(canDrop bool, sema *sema, multi *multiEventACK, fn func(int)) acker
+	createEventACKer(canDrop bool, sema *sema, multi *multiEventACK, fn func([]interface{})) acker
 }
 
 type pipelineEmptyACK struct {
 	pipeline *Pipeline
 }
 
-func (b *pipelineEmptyACK) createPipelineACKer(canDrop bool, sema *sema) acker {
+func (b *pipelineEmptyACK) createPipelineACKer(canDrop bool, sema *sema, multi *multiEventACK) acker {
 	return nilACKer
 }
 
-func (b *pipelineEmptyACK) createCountACKer(canDrop bool, sema *sema, fn func(int)) acker {
+func (b *pipelineEmptyACK) createCountACKer(
+	canDrop bool,
+	sema *sema,
+	multi *multiEventACK,
+	fn func(int),
+) acker {
 	return buildClientCountACK(b.pipeline, canDrop, sema, func(guard *clientACKer) func(int, int) {
 		return func(total, acked int) {
-			if guard.Active() {
+			total = multi.foldCount(total)
+			if total > 0 && guard.Active() {
 				fn(total)
 			}
 		}
@@ -50,31 +56,48 @@ func (b *pipelineEmptyACK) createCountACKer(canDrop bool, sema *sema, fn func(in
 func (b *pipelineEmptyACK) createEventACKer(
 	canDrop bool,
 	sema *sema,
+	multi *multiEventACK,
 	fn func([]interface{}),
 ) acker {
 	return buildClientEventACK(b.pipeline, canDrop, sema, func(guard *clientACKer) func([]interface{}, int) {
 		return func(events []interface{}, acked int) {
-			if guard.Active() {
+			events = multi.foldEvents(events)
+			if len(events) > 0 && guard.Active() {
 				fn(events)
 			}
 		}
 	})
 }
 
+// The pipeline wide ACK handlers receive the number of events ACKed by the
+// queue as is, as the pipelineEventCB matches them against the queue ACKs.
+// Only the events reported are folded.
+
 type pipelineCountACK struct {
 	pipeline *Pipeline
 	cb       func(int, int)
 }
 
-func (b *pipelineCountACK) createPipelineACKer(canDrop bool, sema *sema) acker {
-	return makeCountACK(b.pipeline, canDrop, sema, b.cb)
+func (b *pipelineCountACK) createPipelineACKer(canDrop bool, sema *sema, multi *multiEventACK) acker {
+	if multi == nil {
+		return makeCountACK(b.pipeline, canDrop, sema, b.cb)
+	}
+	return makeCountACK(b.pipeline, canDrop, sema, func(total, acked int) {
+		b.report(multi.foldCount(total), acked)
+	})
 }
 
-func (b *pipelineCountACK) createCountACKer(canDrop bool, sema *sema, fn func(int)) acker {
+func (b *pipelineCountACK) createCountACKer(
+	canDrop bool,
+	sema *sema,
+	multi *multiEventACK,
+	fn func(int),
+) acker {
 	return buildClientCountACK(b.pipeline, canDrop, sema, func(guard *clientACKer) func(int, int) {
 		return func(total, acked int) {
-			b.cb(total, acked)
-			if guard.Active() {
+			total = multi.foldCount(total)
+			b.report(total, acked)
+			if total > 0 && guard.Active() {
 				fn(total)
 			}
 		}
@@ -84,49 +107,80 @@ func (b *pipelineCountACK) createCountACKer(canDrop bool, sema *sema, fn func(in
 func (b *pipelineCountACK) createEventACKer(
 	canDrop bool,
 	sema *sema,
+	multi *multiEventACK,
 	fn func([]interface{}),
 ) acker {
 	return buildClientEventACK(b.pipeline, canDrop, sema, func(guard *clientACKer) func([]interface{}, int) {
 		return func(data []interface{}, acked int) {
-			b.cb(len(data), acked)
-			if guard.Active() {
+			data = multi.foldEvents(data)
+			b.report(len(data), acked)
+			if len(data) > 0 && guard.Active() {
 				fn(data)
 			}
 		}
 	})
 }
 
+func (b *pipelineCountACK) report(total, acked int) {
+	if total > 0 || acked > 0 {
+		b.cb(total, acked)
+	}
+}
+
 type pipelineEventsACK struct {
 	pipeline *Pipeline
 	cb       func([]interface{}, int)
 }
 
-func (b *pipelineEventsACK) createPipelineACKer(canDrop bool, sema *sema) acker {
-	return newEventACK(b.pipeline, canDrop, sema, b.cb)
+func (b *pipelineEventsACK) createPipelineACKer(canDrop bool, sema *sema, multi *multiEventACK) acker {
+	if multi == nil {
+		return newEventACK(b.pipeline, canDrop, sema, b.cb)
+	}
+	return newEventACK(b.pipeline, canDrop, sema, func(data []interface{}, acked int) {
+		b.report(multi.foldEvents(data), acked)
+	})
 }
 
-func (b *pipelineEventsACK) createCountACKer(canDrop bool, sema *sema, fn func(int)) acker {
+func (b *pipelineEventsACK) createCountACKer(
+	canDrop bool,
+	sema *sema,
+	multi *multiEventACK,
+	fn func(int),
+) acker {
 	return buildClientEventACK(b.pipeline, canDrop, sema, func(guard *clientACKer) func([]interface{}, int) {
 		return func(data []interface{}, acked int) {
-			b.cb(data, acked)
-			if guard.Active() {
+			data = multi.foldEvents(data)
+			b.report(data, acked)
+			if len(data) > 0 && guard.Active() {
 				fn(len(data))
 			}
 		}
 	})
 }
 
-func (b *pipelineEventsACK) createEventACKer(canDrop bool, sema *sema, fn func([]interface{})) acker {
+func (b *pipelineEventsACK) createEventACKer(
+	canDrop bool,
+	sema *sema,
+	multi *multiEventACK,
+	fn func([]interface{}),
+) acker {
 	return buildClientEventACK(b.pipeline, canDrop, sema, func(guard *clientACKer) func([]interface{}, int) {
 		return func(data []interface{}, acked int) {
-			b.cb(data, acked)
-			if guard.Active() {
+			data = multi.foldEvents(data)
+			b.report(data, acked)
+			if len(data) > 0 && guard.Active() {
 				fn(data)
 			}
 		}
 	})
 }
 
+func (b *pipelineEventsACK) report(data []interface{}, acked int) {
+	if len(data) > 0 || acked > 0 {
+		b.cb(data, acked)
+	}
+}
+
 // pipelineEventCB internally handles active ACKs in the pipeline.
 // It receives ACK events from the queue and the individual clients.
 // Once the queue returns an ACK to the pipelineEventCB, the worker loop will collect
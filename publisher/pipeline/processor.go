@@ -67,7 +67,7 @@ func newProcessorPipeline(
 
 		// client fields and metadata
 		clientMeta      = config.Meta
-		localProcessors = makeClientProcessors(config)
+		localProcessors = makeClientProcessors(info, config)
 	)
 
 	needsCopy := global.alwaysCopy || localProcessors != nil || global.processors != nil
@@ -183,6 +183,50 @@ func (p *program) Run(event *beat.Event) (*beat.Event, error) {
 	return event, nil
 }
 
+// RunMulti applies the processors like Run, but multi-event processors can
+// return multiple events. The remaining processors are applied to each of
+// them. Dropped events are not returned.
+func (p *program) RunMulti(event *beat.Event) ([]*beat.Event, error) {
+	if p == nil {
+		return []*beat.Event{event}, nil
+	}
+	return processors.RunMultiList(p.list, event)
+}
+
+// Close closes all processors of the program. Only the global program owns its
+// processors, client programs must not be closed.
+func (p *program) Close() error {
+	if p == nil {
+		return nil
+	}
+
+	var err error
+	for _, sub := range p.list {
+		if e := processors.Close(sub); e != nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// isMulti returns true if the program contains a multi-event processor.
+func (p *program) isMulti() bool {
+	if p == nil {
+		return false
+	}
+	for _, sub := range p.list {
+		switch sub := sub.(type) {
+		case *program:
+			if sub.isMulti() {
+				return true
+			}
+		case beat.MultiProcessor:
+			return true
+		}
+	}
+	return false
+}
+
 func newProcessor(name string, fn func(*beat.Event) (*beat.Event, error)) *processorFn {
 	return &processorFn{name: name, fn: fn}
 }
@@ -311,15 +355,20 @@ func debugPrintProcessor(info beat.Info) *processorFn {
 	})
 }
 
-func makeClientProcessors(config beat.ClientConfig) processors.Processor {
+func makeClientProcessors(info beat.Info, config beat.ClientConfig) processors.Processor {
 	procs := config.Processor
 	if procs == nil || len(procs.All()) == 0 {
 		return nil
 	}
 
+	list := procs.All()
+	for _, p := range list {
+		processors.SetBeatInfo(p, info)
+	}
+
 	return &program{
 		title: "client",
-		list:  procs.All(),
+		list:  list,
 	}
 }
 
@@ -26,6 +26,7 @@ import (
 
 	"github.com/njcx/libbeat_v6/beat"
 	"github.com/njcx/libbeat_v6/common"
+	"github.com/njcx/libbeat_v6/processors"
 )
 
 func TestProcessors(t *testing.T) {
@@ -353,3 +354,67 @@ func TestProcessors(t *testing.T) {
 		})
 	}
 }
+
+type duplicateProcessor struct{}
+
+func (duplicateProcessor) String() string { return "duplicate" }
+
+func (duplicateProcessor) Run(event *beat.Event) (*beat.Event, error) { return event, nil }
+
+func (duplicateProcessor) RunMulti(event *beat.Event) ([]*beat.Event, error) {
+	var events []*beat.Event
+	for i := 1; i <= 2; i++ {
+		fields := event.Fields.Clone()
+		fields["copy"] = i
+		events = append(events, &beat.Event{Timestamp: event.Timestamp, Fields: fields})
+	}
+	return events, nil
+}
+
+func TestProgramRunMulti(t *testing.T) {
+	global := pipelineProcessors{processors: &program{
+		title: "global",
+		list:  []beat.Processor{duplicateProcessor{}},
+	}}
+
+	prog := newProcessorPipeline(beat.Info{}, global, beat.ClientConfig{
+		Fields: common.MapStr{"local": 1},
+	}).(*program)
+	assert.True(t, prog.isMulti())
+
+	events, err := prog.RunMulti(&beat.Event{
+		Timestamp: time.Now(),
+		Fields:    common.MapStr{"value": "abc"},
+	})
+	assert.NoError(t, err)
+	if !assert.Len(t, events, 2) {
+		return
+	}
+	assert.Equal(t, common.MapStr{"value": "abc", "local": 1, "copy": 1}, events[0].Fields)
+	assert.Equal(t, common.MapStr{"value": "abc", "local": 1, "copy": 2}, events[1].Fields)
+
+	single := newProcessorPipeline(beat.Info{}, pipelineProcessors{}, beat.ClientConfig{
+		Fields: common.MapStr{"local": 1},
+	}).(*program)
+	assert.False(t, single.isMulti())
+}
+
+type infoProcessor struct {
+	info beat.Info
+}
+
+func (p *infoProcessor) String() string { return "info" }
+
+func (p *infoProcessor) Run(event *beat.Event) (*beat.Event, error) { return event, nil }
+
+func (p *infoProcessor) SetBeatInfo(info beat.Info) { p.info = info }
+
+func TestClientProcessorsBeatInfo(t *testing.T) {
+	info := beat.Info{Beat: "test", Version: "0.1"}
+	local := &infoProcessor{}
+
+	newProcessorPipeline(info, pipelineProcessors{}, beat.ClientConfig{
+		Processor: &processors.Processors{List: []processors.Processor{local}},
+	})
+	assert.Equal(t, info, local.info)
+}
@@ -30,7 +30,19 @@ type TemplateConfig struct {
 		Name    string `config:"name"`
 	} `config:"json"`
 	AppendFields common.Fields    `config:"append_fields"`
+	Overlays     []Overlay        `config:"overlays"`
 	Overwrite    bool             `config:"overwrite"`
+	Diff         bool             `config:"diff"`
+	Settings     TemplateSettings `config:"settings"`
+}
+
+// Overlay adds the fields and settings of a module to the base template. The
+// fields are merged with the base fields, the settings of the base template take
+// precedence over the settings of the overlays.
+type Overlay struct {
+	Name         string           `config:"name"`
+	Fields       string           `config:"fields"`
+	AppendFields common.Fields    `config:"append_fields"`
 	Settings     TemplateSettings `config:"settings"`
 }
 
@@ -0,0 +1,130 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Difference is a setting or mapping of the template in Elasticsearch that
+// differs from the generated template.
+type Difference struct {
+	Path     string      // Path of the setting, e.g. settings.index.refresh_interval.
+	Expected interface{} // Value in the generated template, nil if missing.
+	Actual   interface{} // Value in the template in Elasticsearch, nil if missing.
+}
+
+func (d Difference) String() string {
+	switch {
+	case d.Actual == nil:
+		return fmt.Sprintf("%s: missing, expected %v", d.Path, d.Expected)
+	case d.Expected == nil:
+		return fmt.Sprintf("%s: unexpected value %v", d.Path, d.Actual)
+	default:
+		return fmt.Sprintf("%s: expected %v, found %v", d.Path, d.Expected, d.Actual)
+	}
+}
+
+// DiffTemplates compares the generated template with the template returned by
+// Elasticsearch and returns the differences, sorted by path. Elasticsearch
+// returns all index settings as strings, so settings are compared by their
+// string representation.
+func DiffTemplates(expected, actual map[string]interface{}) []Difference {
+	want := flatten(normalizeJSON(expected))
+	have := flatten(normalizeJSON(actual))
+
+	var diffs []Difference
+	for path, w := range want {
+		h, found := have[path]
+		if !found {
+			diffs = append(diffs, Difference{Path: path, Expected: w})
+			continue
+		}
+		if strings.HasPrefix(path, "settings.") {
+			w, h = settingString(w), settingString(h)
+		}
+		if !reflect.DeepEqual(w, h) {
+			diffs = append(diffs, Difference{Path: path, Expected: w, Actual: h})
+		}
+	}
+	for path, h := range have {
+		if _, found := want[path]; !found {
+			diffs = append(diffs, Difference{Path: path, Actual: h})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Path < diffs[j].Path })
+	return diffs
+}
+
+// normalizeJSON converts the value to the types returned by encoding/json.
+func normalizeJSON(v interface{}) interface{} {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return v
+	}
+	return normalized
+}
+
+// flatten returns the leaves of the value by their dotted path. Arrays are
+// leaves, empty objects are ignored.
+func flatten(v interface{}) map[string]interface{} {
+	leaves := map[string]interface{}{}
+	var walk func(prefix string, v interface{})
+	walk = func(prefix string, v interface{}) {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			if prefix != "" {
+				leaves[prefix] = v
+			}
+			return
+		}
+		for k, child := range m {
+			path := k
+			if prefix != "" {
+				path = prefix + "." + k
+			}
+			walk(path, child)
+		}
+	}
+	walk("", v)
+	return leaves
+}
+
+func settingString(v interface{}) interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		strs := make([]interface{}, len(v))
+		for i, elem := range v {
+			strs[i] = fmt.Sprint(elem)
+		}
+		return strs
+	case nil:
+		return nil
+	default:
+		return fmt.Sprint(v)
+	}
+}
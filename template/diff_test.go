@@ -0,0 +1,219 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v6/beat"
+	"github.com/njcx/libbeat_v6/common"
+)
+
+// mockClient stores the loaded templates and returns them like Elasticsearch,
+// with all index settings as strings.
+type mockClient struct {
+	version   common.Version
+	templates map[string]map[string]interface{}
+	params    []map[string]string
+}
+
+func newMockClient(version string) *mockClient {
+	return &mockClient{
+		version:   *common.MustNewVersion(version),
+		templates: map[string]map[string]interface{}{},
+	}
+}
+
+func (c *mockClient) Request(method, path string, pipeline string, params map[string]string, body interface{}) (int, []byte, error) {
+	c.params = append(c.params, params)
+
+	var name string
+	if _, err := fmt.Sscanf(path, "/_template/%s", &name); err != nil {
+		return 400, nil, fmt.Errorf("unexpected path %s", path)
+	}
+
+	switch method {
+	case "PUT":
+		var template map[string]interface{}
+		data, _ := json.Marshal(body)
+		json.Unmarshal(data, &template)
+		if settings, ok := template["settings"]; ok {
+			template["settings"] = stringifySettings(settings)
+		}
+		c.templates[name] = template
+		return 200, []byte(`{"acknowledged": true}`), nil
+	case "HEAD", "GET":
+		template, found := c.templates[name]
+		if !found {
+			return 404, []byte("{}"), fmt.Errorf("404 Not Found")
+		}
+		data, _ := json.Marshal(map[string]interface{}{name: template})
+		return 200, data, nil
+	}
+	return 405, nil, fmt.Errorf("unexpected method %s", method)
+}
+
+func (c *mockClient) GetVersion() common.Version {
+	return c.version
+}
+
+func stringifySettings(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			v[k] = stringifySettings(child)
+		}
+		return v
+	case []interface{}:
+		for i, child := range v {
+			v[i] = stringifySettings(child)
+		}
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func newTestLoader(t *testing.T, client ESClient, config map[string]interface{}) *Loader {
+	fields, err := filepath.Abs("testdata/fields.yml")
+	require.NoError(t, err)
+
+	cfg := common.MustNewConfigFrom(map[string]interface{}{"fields": fields})
+	require.NoError(t, cfg.Merge(config))
+
+	loader, err := NewLoader(cfg, client, beat.Info{Beat: "testbeat", IndexPrefix: "testbeat", Version: "7.0.0"}, nil)
+	require.NoError(t, err)
+	return loader
+}
+
+func TestLoaderDiff(t *testing.T) {
+	for _, version := range []string{"6.6.0", "6.7.0", "7.0.0"} {
+		client := newMockClient(version)
+
+		loader := newTestLoader(t, client, nil)
+		_, err := loader.Diff()
+		assert.Error(t, err, "template does not exist")
+
+		require.NoError(t, loader.Load(), version)
+		diffs, err := loader.Diff()
+		require.NoError(t, err, version)
+		assert.Empty(t, diffs, version)
+
+		// The template is not replaced without overwrite, the diff reports the
+		// modified settings.
+		loader = newTestLoader(t, client, map[string]interface{}{
+			"diff":                            true,
+			"settings.index.refresh_interval": "30s",
+		})
+		require.NoError(t, loader.Load(), version)
+		diffs, err = loader.Diff()
+		require.NoError(t, err, version)
+		assert.Equal(t, []Difference{
+			{Path: "settings.index.refresh_interval", Expected: "30s", Actual: "5s"},
+		}, diffs, version)
+
+		loader = newTestLoader(t, client, map[string]interface{}{
+			"overwrite":                       true,
+			"settings.index.refresh_interval": "30s",
+		})
+		require.NoError(t, loader.Load(), version)
+		diffs, err = loader.Diff()
+		require.NoError(t, err, version)
+		assert.Empty(t, diffs, version)
+	}
+}
+
+func TestLoaderVersionParams(t *testing.T) {
+	for version, expected := range map[string]map[string]string{
+		"6.6.0": nil,
+		"6.7.0": {"include_type_name": "true"},
+		"6.8.1": {"include_type_name": "true"},
+		"7.0.0": nil,
+	} {
+		client := newMockClient(version)
+		require.NoError(t, newTestLoader(t, client, nil).Load())
+
+		put := client.params[len(client.params)-1]
+		assert.Equal(t, expected, put, version)
+	}
+}
+
+func TestDiffTemplates(t *testing.T) {
+	expected := map[string]interface{}{
+		"order": 1,
+		"settings": common.MapStr{
+			"index": common.MapStr{
+				"refresh_interval":           "5s",
+				"mapping.total_fields.limit": 10000,
+				"query.default_field":        []string{"message", "fields.*"},
+			},
+		},
+		"mappings": common.MapStr{
+			"date_detection": false,
+			"properties": common.MapStr{
+				"message": common.MapStr{"type": "text"},
+			},
+		},
+	}
+	actual := map[string]interface{}{
+		"order": 1,
+		"settings": map[string]interface{}{
+			"index": map[string]interface{}{
+				"refresh_interval": "5s",
+				"mapping": map[string]interface{}{
+					"total_fields": map[string]interface{}{"limit": "10000"},
+				},
+				"query": map[string]interface{}{
+					"default_field": []interface{}{"message", "fields.*"},
+				},
+			},
+		},
+		"mappings": map[string]interface{}{
+			"date_detection": false,
+			"properties": map[string]interface{}{
+				"message": map[string]interface{}{"type": "text"},
+			},
+		},
+		"aliases": map[string]interface{}{},
+	}
+	assert.Empty(t, DiffTemplates(expected, actual))
+
+	actual["order"] = 2
+	actual["mappings"].(map[string]interface{})["properties"] = map[string]interface{}{
+		"message": map[string]interface{}{"type": "keyword"},
+		"custom":  map[string]interface{}{"type": "long"},
+	}
+	delete(actual["settings"].(map[string]interface{})["index"].(map[string]interface{}), "refresh_interval")
+
+	diffs := DiffTemplates(expected, actual)
+	assert.Equal(t, []Difference{
+		{Path: "mappings.properties.custom.type", Actual: "long"},
+		{Path: "mappings.properties.message.type", Expected: "text", Actual: "keyword"},
+		{Path: "order", Expected: float64(1), Actual: float64(2)},
+		{Path: "settings.index.refresh_interval", Expected: "5s"},
+	}, diffs)
+	assert.Equal(t, "settings.index.refresh_interval: missing, expected 5s", diffs[3].String())
+}
@@ -64,15 +64,11 @@ func NewLoader(cfg *common.Config, client ESClient, beatInfo beat.Info, fields [
 // In case the template is not already loaded or overwriting is enabled, the
 // template is written to index
 func (l *Loader) Load() error {
-	tmpl, err := New(l.beatInfo.Version, l.beatInfo.IndexPrefix, l.client.GetVersion(), l.config)
+	templateName, err := l.templateName()
 	if err != nil {
-		return fmt.Errorf("error creating template instance: %v", err)
+		return err
 	}
 
-	templateName := tmpl.GetName()
-	if l.config.JSON.Enabled {
-		templateName = l.config.JSON.Name
-	}
 	// Check if template already exist or should be overwritten
 	exists := l.CheckTemplate(templateName)
 	if !exists || l.config.Overwrite {
@@ -83,40 +79,9 @@ func (l *Loader) Load() error {
 			logp.Info("Existing template will be overwritten, as overwrite is enabled.")
 		}
 
-		var template map[string]interface{}
-		if l.config.JSON.Enabled {
-			jsonPath := paths.Resolve(paths.Config, l.config.JSON.Path)
-			if _, err := os.Stat(jsonPath); err != nil {
-				return fmt.Errorf("error checking for json template: %s", err)
-			}
-
-			logp.Info("Loading json template from file %s", jsonPath)
-
-			content, err := ioutil.ReadFile(jsonPath)
-			if err != nil {
-				return fmt.Errorf("error reading file. Path: %s, Error: %s", jsonPath, err)
-
-			}
-			err = json.Unmarshal(content, &template)
-			if err != nil {
-				return fmt.Errorf("could not unmarshal json template: %s", err)
-			}
-			// Load fields from path
-		} else if l.config.Fields != "" {
-			logp.Debug("template", "Load fields.yml from file: %s", l.config.Fields)
-
-			fieldsPath := paths.Resolve(paths.Config, l.config.Fields)
-
-			template, err = tmpl.LoadFile(fieldsPath)
-			if err != nil {
-				return fmt.Errorf("error creating template from file %s: %v", fieldsPath, err)
-			}
-		} else {
-			logp.Debug("template", "Load default fields.yml")
-			template, err = tmpl.LoadBytes(l.fields)
-			if err != nil {
-				return fmt.Errorf("error creating template: %v", err)
-			}
+		_, template, err := l.buildTemplate()
+		if err != nil {
+			return err
 		}
 
 		err = l.LoadTemplate(templateName, template)
@@ -126,11 +91,124 @@ func (l *Loader) Load() error {
 
 	} else {
 		logp.Info("Template already exists and will not be overwritten.")
+		if l.config.Diff {
+			l.logDiff()
+		}
 	}
 
 	return nil
 }
 
+// Diff compares the template generated from the configuration with the
+// template in Elasticsearch. It returns an error if the template does not exist
+// in Elasticsearch.
+func (l *Loader) Diff() ([]Difference, error) {
+	templateName, template, err := l.buildTemplate()
+	if err != nil {
+		return nil, err
+	}
+
+	params := esVersionParams(l.client.GetVersion())
+	status, body, err := l.client.Request("GET", "/_template/"+templateName, "", params, nil)
+	if status == 404 {
+		return nil, fmt.Errorf("template %s does not exist", templateName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read template %s: %v", templateName, err)
+	}
+
+	var response map[string]map[string]interface{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("couldn't parse template %s: %v", templateName, err)
+	}
+
+	return DiffTemplates(template, response[templateName]), nil
+}
+
+func (l *Loader) logDiff() {
+	diffs, err := l.Diff()
+	if err != nil {
+		logp.Warn("Failed to compare the template with the template in Elasticsearch: %v", err)
+		return
+	}
+	if len(diffs) == 0 {
+		logp.Info("Template in Elasticsearch matches the configured template.")
+		return
+	}
+
+	logp.Warn("Template in Elasticsearch differs from the configured template in %d settings. "+
+		"Set setup.template.overwrite to replace it.", len(diffs))
+	for _, d := range diffs {
+		logp.Warn("Template difference: %s", d)
+	}
+}
+
+func (l *Loader) templateName() (string, error) {
+	if l.config.JSON.Enabled {
+		return l.config.JSON.Name, nil
+	}
+
+	tmpl, err := New(l.beatInfo.Version, l.beatInfo.IndexPrefix, l.client.GetVersion(), l.config)
+	if err != nil {
+		return "", fmt.Errorf("error creating template instance: %v", err)
+	}
+	return tmpl.GetName(), nil
+}
+
+// buildTemplate returns the name and the template, either read from the JSON
+// file or generated from the fields.
+func (l *Loader) buildTemplate() (string, map[string]interface{}, error) {
+	tmpl, err := New(l.beatInfo.Version, l.beatInfo.IndexPrefix, l.client.GetVersion(), l.config)
+	if err != nil {
+		return "", nil, fmt.Errorf("error creating template instance: %v", err)
+	}
+
+	var template map[string]interface{}
+	if l.config.JSON.Enabled {
+		jsonPath := paths.Resolve(paths.Config, l.config.JSON.Path)
+		if _, err := os.Stat(jsonPath); err != nil {
+			return "", nil, fmt.Errorf("error checking for json template: %s", err)
+		}
+
+		logp.Info("Loading json template from file %s", jsonPath)
+
+		content, err := ioutil.ReadFile(jsonPath)
+		if err != nil {
+			return "", nil, fmt.Errorf("error reading file. Path: %s, Error: %s", jsonPath, err)
+
+		}
+		err = json.Unmarshal(content, &template)
+		if err != nil {
+			return "", nil, fmt.Errorf("could not unmarshal json template: %s", err)
+		}
+
+		// JSON templates can be written for another Elasticsearch version.
+		if err := adaptMappings(l.client.GetVersion(), template); err != nil {
+			return "", nil, fmt.Errorf("invalid json template: %v", err)
+		}
+		return l.config.JSON.Name, template, nil
+	}
+
+	// Load fields from path
+	if l.config.Fields != "" {
+		logp.Debug("template", "Load fields.yml from file: %s", l.config.Fields)
+
+		fieldsPath := paths.Resolve(paths.Config, l.config.Fields)
+
+		template, err = tmpl.LoadFile(fieldsPath)
+		if err != nil {
+			return "", nil, fmt.Errorf("error creating template from file %s: %v", fieldsPath, err)
+		}
+	} else {
+		logp.Debug("template", "Load default fields.yml")
+		template, err = tmpl.LoadBytes(l.fields)
+		if err != nil {
+			return "", nil, fmt.Errorf("error creating template: %v", err)
+		}
+	}
+	return tmpl.GetName(), template, nil
+}
+
 // LoadTemplate loads a template into Elasticsearch overwriting the existing
 // template if it exists. If you wish to not overwrite an existing template
 // then use CheckTemplate prior to calling this method.
@@ -165,8 +243,11 @@ func loadJSON(client ESClient, path string, json map[string]interface{}) ([]byte
 	return body, nil
 }
 
+// esVersionParams returns the parameters of the template requests. Templates
+// are typed for Elasticsearch 6.x, 6.7 and newer require include_type_name to
+// be set to accept and return typed mappings without deprecation warnings.
 func esVersionParams(ver common.Version) map[string]string {
-	if ver.Major == 6 && ver.Minor == 7 {
+	if ver.Major == 6 && ver.Minor >= 7 {
 		return map[string]string{
 			"include_type_name": "true",
 		}
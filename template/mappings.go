@@ -0,0 +1,102 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package template
+
+import (
+	"fmt"
+
+	"github.com/njcx/libbeat_v6/common"
+)
+
+// mappingKeys are the top-level keys of typeless mappings. Typed mappings
+// contain the document type as only top-level key instead.
+var mappingKeys = map[string]struct{}{
+	"_all":                 {},
+	"_field_names":         {},
+	"_meta":                {},
+	"_routing":             {},
+	"_source":              {},
+	"date_detection":       {},
+	"dynamic":              {},
+	"dynamic_date_formats": {},
+	"dynamic_templates":    {},
+	"numeric_detection":    {},
+	"properties":           {},
+}
+
+// docType returns the document type used in the mappings of the given
+// Elasticsearch version. Elasticsearch 7.0 and newer use typeless mappings.
+func docType(ver common.Version) string {
+	switch {
+	case ver.Major < 6:
+		return "_default_"
+	case ver.Major == 6:
+		return "doc"
+	default:
+		return ""
+	}
+}
+
+// isTypeless returns true if the mappings are not wrapped in a document type.
+func isTypeless(mappings map[string]interface{}) bool {
+	if len(mappings) != 1 {
+		return true
+	}
+	for key := range mappings {
+		_, found := mappingKeys[key]
+		return found
+	}
+	return true
+}
+
+// adaptMappings converts the mappings of the template to the format supported
+// by the Elasticsearch version. Typed mappings are converted to typeless
+// mappings for Elasticsearch 7.0 and newer, typeless mappings are wrapped in the
+// document type for older versions. This allows loading a JSON template written
+// for another Elasticsearch version.
+func adaptMappings(ver common.Version, template map[string]interface{}) error {
+	raw, found := template["mappings"]
+	if !found {
+		return nil
+	}
+
+	var mappings map[string]interface{}
+	switch m := raw.(type) {
+	case map[string]interface{}:
+		mappings = m
+	case common.MapStr:
+		mappings = m
+	default:
+		return fmt.Errorf("mappings must be an object, found %T", raw)
+	}
+	if len(mappings) == 0 {
+		return nil
+	}
+
+	typ := docType(ver)
+	typeless := isTypeless(mappings)
+	switch {
+	case typ == "" && !typeless:
+		for _, mapping := range mappings {
+			template["mappings"] = mapping
+		}
+	case typ != "" && typeless:
+		template["mappings"] = map[string]interface{}{typ: mappings}
+	}
+	return nil
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/njcx/libbeat_v6/common"
 	"github.com/njcx/libbeat_v6/common/cfgwarn"
 	"github.com/njcx/libbeat_v6/common/fmtstr"
+	"github.com/njcx/libbeat_v6/paths"
 )
 
 var (
@@ -119,7 +120,11 @@ func (t *Template) load(fields common.Fields) (common.MapStr, error) {
 	dynamicTemplates = nil
 	defaultFields = nil
 
-	var err error
+	fields, err := t.compose(fields)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(t.config.AppendFields) > 0 {
 		cfgwarn.Experimental("append_fields is used.")
 		fields, err = common.ConcatFields(fields, t.config.AppendFields)
@@ -139,6 +144,30 @@ func (t *Template) load(fields common.Fields) (common.MapStr, error) {
 	return output, nil
 }
 
+// compose merges the fields of the overlays into the base fields. Fields
+// defined by more than one overlay are rejected.
+func (t *Template) compose(fields common.Fields) (common.Fields, error) {
+	for _, overlay := range t.config.Overlays {
+		overlayFields := overlay.AppendFields
+		if overlay.Fields != "" {
+			path := paths.Resolve(paths.Config, overlay.Fields)
+			loaded, err := common.LoadFieldsYaml(path)
+			if err != nil {
+				return nil, fmt.Errorf("error loading fields of overlay %s from %s: %v", overlay.Name, path, err)
+			}
+			if overlayFields, err = common.ConcatFields(loaded, overlayFields); err != nil {
+				return nil, fmt.Errorf("error adding fields to overlay %s: %v", overlay.Name, err)
+			}
+		}
+
+		var err error
+		if fields, err = common.ConcatFields(fields, overlayFields); err != nil {
+			return nil, fmt.Errorf("error merging overlay %s: %v", overlay.Name, err)
+		}
+	}
+	return fields, nil
+}
+
 // LoadFile loads the the template from the given file path
 func (t *Template) LoadFile(file string) (common.MapStr, error) {
 	fields, err := common.LoadFieldsYaml(file)
@@ -173,6 +202,7 @@ func (t *Template) GetPattern() string {
 // The default values are taken from the default variable.
 func (t *Template) Generate(properties common.MapStr, dynamicTemplates []common.MapStr) common.MapStr {
 	keyPattern, patterns := buildPatternSettings(t.esVersion, t.GetPattern())
+	settings := t.settings()
 
 	return common.MapStr{
 		keyPattern: patterns,
@@ -181,7 +211,7 @@ func (t *Template) Generate(properties common.MapStr, dynamicTemplates []common.
 			t.beatVersion, t.esVersion,
 			properties,
 			append(dynamicTemplates, buildDynTmpl(t.esVersion)),
-			common.MapStr(t.config.Settings.Source),
+			common.MapStr(settings.Source),
 		),
 
 		"order": 1,
@@ -189,12 +219,28 @@ func (t *Template) Generate(properties common.MapStr, dynamicTemplates []common.
 		"settings": common.MapStr{
 			"index": buildIdxSettings(
 				t.esVersion,
-				t.config.Settings.Index,
+				settings.Index,
 			),
 		},
 	}
 }
 
+// settings merges the settings of the overlays with the configured settings.
+func (t *Template) settings() TemplateSettings {
+	if len(t.config.Overlays) == 0 {
+		return t.config.Settings
+	}
+
+	index, source := common.MapStr{}, common.MapStr{}
+	for _, overlay := range t.config.Overlays {
+		index.DeepUpdate(overlay.Settings.Index)
+		source.DeepUpdate(overlay.Settings.Source)
+	}
+	index.DeepUpdate(t.config.Settings.Index)
+	source.DeepUpdate(t.config.Settings.Source)
+	return TemplateSettings{Index: index, Source: source}
+}
+
 func buildPatternSettings(ver common.Version, pattern string) (string, interface{}) {
 	if ver.Major < 6 {
 		return "template", pattern
@@ -221,23 +267,15 @@ func buildMappings(
 		mapping["_source"] = source
 	}
 
-	major := esVersion.Major
-	switch {
-	case major == 2:
+	if esVersion.Major == 2 {
 		mapping.Put("_all.norms.enabled", false)
+	}
+
+	// keep typeless structure for Elasticsearch 7.0 and newer
+	if typ := docType(esVersion); typ != "" {
 		mapping = common.MapStr{
-			"_default_": mapping,
-		}
-	case major < 6:
-		mapping = common.MapStr{
-			"_default_": mapping,
-		}
-	case major == 6:
-		mapping = common.MapStr{
-			"doc": mapping,
+			typ: mapping,
 		}
-	case major >= 7:
-		// keep typeless structure
 	}
 
 	return mapping
@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/njcx/libbeat_v6/common"
 )
@@ -76,3 +77,108 @@ func TestNumberOfRoutingShardsOverwrite(t *testing.T) {
 
 	assert.Equal(t, 5, shards.(int))
 }
+
+func TestOverlays(t *testing.T) {
+	config := TemplateConfig{
+		Settings: TemplateSettings{
+			Index: map[string]interface{}{"refresh_interval": "10s"},
+		},
+		Overlays: []Overlay{
+			{
+				Name:         "apache",
+				AppendFields: common.Fields{{Name: "apache.status", Type: "long"}},
+				Settings: TemplateSettings{
+					Index: map[string]interface{}{"refresh_interval": "1s", "codec": "best_compression"},
+				},
+			},
+			{
+				Name:   "test",
+				Fields: "testdata/fields.yml",
+			},
+		},
+	}
+
+	template, err := New("7.0.0", "testbeat", *common.MustNewVersion("7.0.0"), config)
+	require.NoError(t, err)
+
+	data, err := template.load(common.Fields{{Name: "message", Type: "text"}})
+	require.NoError(t, err)
+
+	for _, field := range []string{"message", "apache.properties.status", "keyword"} {
+		_, err := data.GetValue("mappings.properties." + field)
+		assert.NoError(t, err, field)
+	}
+
+	// Settings of the base template take precedence.
+	refresh, _ := data.GetValue("settings.index.refresh_interval")
+	assert.Equal(t, "10s", refresh)
+	codec, _ := data.GetValue("settings.index.codec")
+	assert.Equal(t, "best_compression", codec)
+
+	// Overlays can not redefine fields.
+	config.Overlays = append(config.Overlays, Overlay{
+		Name:         "conflict",
+		AppendFields: common.Fields{{Name: "message", Type: "keyword"}},
+	})
+	template, err = New("7.0.0", "testbeat", *common.MustNewVersion("7.0.0"), config)
+	require.NoError(t, err)
+	_, err = template.load(common.Fields{{Name: "message", Type: "text"}})
+	assert.Error(t, err)
+}
+
+func TestTypelessMappings(t *testing.T) {
+	for version, path := range map[string]string{
+		"5.6.0": "mappings._default_.properties",
+		"6.8.0": "mappings.doc.properties",
+		"7.0.0": "mappings.properties",
+	} {
+		template, err := New("7.0.0", "testbeat", *common.MustNewVersion(version), TemplateConfig{})
+		require.NoError(t, err)
+
+		data, err := template.LoadFile("testdata/fields.yml")
+		require.NoError(t, err)
+
+		_, err = data.GetValue(path)
+		assert.NoError(t, err, version)
+	}
+}
+
+func TestAdaptMappings(t *testing.T) {
+	typed := func() map[string]interface{} {
+		return map[string]interface{}{
+			"mappings": map[string]interface{}{
+				"doc": map[string]interface{}{
+					"properties": map[string]interface{}{"message": map[string]interface{}{"type": "text"}},
+				},
+			},
+		}
+	}
+	typeless := func() map[string]interface{} {
+		return map[string]interface{}{
+			"mappings": map[string]interface{}{
+				"properties": map[string]interface{}{"message": map[string]interface{}{"type": "text"}},
+			},
+		}
+	}
+
+	cases := []struct {
+		version           string
+		template, adapted map[string]interface{}
+	}{
+		{"6.8.0", typed(), typed()},
+		{"6.8.0", typeless(), typed()},
+		{"7.0.0", typed(), typeless()},
+		{"7.0.0", typeless(), typeless()},
+		{"7.0.0", map[string]interface{}{}, map[string]interface{}{}},
+	}
+
+	for _, test := range cases {
+		err := adaptMappings(*common.MustNewVersion(test.version), test.template)
+		if assert.NoError(t, err, test.version) {
+			assert.Equal(t, test.adapted, test.template, test.version)
+		}
+	}
+
+	err := adaptMappings(*common.MustNewVersion("7.0.0"), map[string]interface{}{"mappings": "doc"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,170 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package testing
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+)
+
+// Status of a test or a check reported to a JSONDriver.
+const (
+	StatusOK    = "ok"
+	StatusInfo  = "info"
+	StatusWarn  = "warn"
+	StatusError = "error"
+)
+
+// Result is the outcome of a test or of a single check. Tests contain the
+// results of their checks and sub-tests.
+type Result struct {
+	Name    string    `json:"name,omitempty"`
+	Status  string    `json:"status"`
+	Value   string    `json:"value,omitempty"`
+	Message string    `json:"message,omitempty"`
+	Result  string    `json:"result,omitempty"`
+	Checks  []*Result `json:"checks,omitempty"`
+}
+
+// JSONDriver collects the test results and writes them as a single JSON
+// document, so that the results can be processed by other tools.
+type JSONDriver struct {
+	result *Result
+	report *jsonReport
+}
+
+type jsonReport struct {
+	mu      sync.Mutex
+	w       io.Writer
+	root    *Result
+	killer  func()
+	flushed bool
+}
+
+// NewJSONDriver initializes and returns a new JSON driver writing to the given
+// writer. The results are written on Flush or on the first fatal error.
+func NewJSONDriver(w io.Writer) *JSONDriver {
+	var d *JSONDriver
+	d = NewJSONDriverWithKiller(w, func() {
+		d.Flush()
+		os.Exit(1)
+	})
+	return d
+}
+
+// NewJSONDriverWithKiller initializes and returns a new JSON driver.
+// Killer function will be called on fatal errors.
+func NewJSONDriverWithKiller(w io.Writer, killer func()) *JSONDriver {
+	root := &Result{}
+	return &JSONDriver{
+		result: root,
+		report: &jsonReport{w: w, root: root, killer: killer},
+	}
+}
+
+func (d *JSONDriver) Run(name string, f func(Driver)) {
+	child := &Result{Name: name}
+	d.add(child)
+	f(&JSONDriver{result: child, report: d.report})
+}
+
+func (d *JSONDriver) Info(field, value string) {
+	d.add(&Result{Name: field, Status: StatusInfo, Value: value})
+}
+
+func (d *JSONDriver) Warn(field, reason string) {
+	d.add(&Result{Name: field, Status: StatusWarn, Message: reason})
+}
+
+func (d *JSONDriver) Error(field string, err error) {
+	if err == nil {
+		d.add(&Result{Name: field, Status: StatusOK})
+		return
+	}
+	d.add(&Result{Name: field, Status: StatusError, Message: err.Error()})
+}
+
+func (d *JSONDriver) Fatal(field string, err error) {
+	d.Error(field, err)
+	if err != nil {
+		d.report.killer()
+	}
+}
+
+func (d *JSONDriver) Result(data string) {
+	d.report.mu.Lock()
+	defer d.report.mu.Unlock()
+	d.result.Result = data
+}
+
+// Report returns the collected results. The status of each test is the worst
+// status of its checks.
+func (d *JSONDriver) Report() *Result {
+	d.report.mu.Lock()
+	defer d.report.mu.Unlock()
+	updateStatus(d.report.root)
+	return d.report.root
+}
+
+// Flush writes the collected results. Only the first call writes the
+// results.
+func (d *JSONDriver) Flush() error {
+	report := d.Report()
+
+	d.report.mu.Lock()
+	defer d.report.mu.Unlock()
+	if d.report.flushed {
+		return nil
+	}
+	d.report.flushed = true
+
+	enc := json.NewEncoder(d.report.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+func (d *JSONDriver) add(r *Result) {
+	d.report.mu.Lock()
+	defer d.report.mu.Unlock()
+	d.result.Checks = append(d.result.Checks, r)
+}
+
+func updateStatus(r *Result) string {
+	if len(r.Checks) == 0 {
+		if r.Status == "" {
+			r.Status = StatusOK
+		}
+		return r.Status
+	}
+
+	status := StatusOK
+	for _, check := range r.Checks {
+		switch updateStatus(check) {
+		case StatusError:
+			status = StatusError
+		case StatusWarn:
+			if status != StatusError {
+				status = StatusWarn
+			}
+		}
+	}
+	r.Status = status
+	return status
+}
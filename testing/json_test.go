@@ -0,0 +1,67 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package testing
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONDriver(t *testing.T) {
+	var buf bytes.Buffer
+	killed := false
+	d := NewJSONDriverWithKiller(&buf, func() { killed = true })
+
+	d.Run("output", func(d Driver) {
+		d.Info("version", "1.0")
+		d.Error("connection", nil)
+		d.Run("TLS", func(d Driver) {
+			d.Warn("security", "verification disabled")
+		})
+		d.Result("done")
+	})
+	d.Run("other", func(d Driver) {
+		d.Fatal("dial up", errors.New("connection refused"))
+	})
+	assert.True(t, killed)
+
+	require.NoError(t, d.Flush())
+	require.NoError(t, d.Flush())
+
+	var report Result
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &report))
+	assert.Equal(t, StatusError, report.Status)
+	require.Len(t, report.Checks, 2)
+
+	output := report.Checks[0]
+	assert.Equal(t, "output", output.Name)
+	assert.Equal(t, StatusWarn, output.Status)
+	assert.Equal(t, "done", output.Result)
+	assert.Equal(t, &Result{Name: "version", Status: StatusInfo, Value: "1.0"}, output.Checks[0])
+	assert.Equal(t, &Result{Name: "connection", Status: StatusOK}, output.Checks[1])
+	assert.Equal(t, StatusWarn, output.Checks[2].Status)
+
+	other := report.Checks[1]
+	assert.Equal(t, StatusError, other.Status)
+	assert.Equal(t, "connection refused", other.Checks[0].Message)
+}